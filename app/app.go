@@ -31,13 +31,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/gorilla/mux"
 	"github.com/rakyll/statik/fs"
+	"github.com/spf13/cast"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	odinmint "github.com/GeoDB-Limited/odin-core/x/mint"
+	odinmintclient "github.com/GeoDB-Limited/odin-core/x/mint/client"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server/api"
@@ -136,6 +139,7 @@ var (
 		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
 			govclient.NewProposalHandler(ibcclientclient.NewCmdSubmitUpdateClientProposal, ibchelpers.EmptyRestHandler),
 			govclient.NewProposalHandler(ibcclientclient.NewCmdSubmitUpgradeProposal, ibchelpers.EmptyRestHandler),
+			odinmintclient.ProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -225,6 +229,10 @@ type OdinApp struct {
 	// List of hooks
 	hooks []Hook
 
+	// cms is the commit multi store of the BaseApp, set once the latest version is loaded. It allows hooks to read
+	// committed state.
+	cms sdk.CommitMultiStore
+
 	// the configurator
 	configurator module.Configurator
 }
@@ -286,6 +294,10 @@ func NewOdinApp(
 		tkeys:             tkeys,
 		memKeys:           memKeys,
 	}
+	app.SetStoreLoader(func(ms sdk.CommitMultiStore) error {
+		app.cms = ms
+		return baseapp.DefaultStoreLoader(ms)
+	})
 	owasmVM, err := owasm.NewVm(owasmCacheSize)
 	if err != nil {
 		panic(err)
@@ -347,7 +359,8 @@ func NewOdinApp(
 			} else if bytes.Equal(pair.Key, odinminttypes.KeyMaxAllowedMintVolume) {
 				pz.MaxAllowedMintVolume = sdk.Coins{}
 			} else {
-				app.ParamsKeeper.Subspace(odinminttypes.ModuleName).Get(ctx, pair.Key, pair.Value)
+				// the parameters added since are set by the mint store migration
+				app.GetSubspace(odinminttypes.ModuleName).GetIfExists(ctx, pair.Key, pair.Value)
 			}
 		}
 		app.MintKeeper.SetParams(ctx, pz)
@@ -359,6 +372,10 @@ func NewOdinApp(
 		return app.mm.RunMigrations(ctx, cfg, fromVM)
 	})
 
+	app.UpgradeKeeper.SetUpgradeHandler("v0.6.0", func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return app.mm.RunMigrations(ctx, cfg, fromVM)
+	})
+
 	app.StakingKeeper = *stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
 	)
@@ -380,7 +397,8 @@ func NewOdinApp(
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibchost.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibchost.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(odinminttypes.RouterKey, odinmint.NewTreasurySpendProposalHandler(app.MintKeeper))
 
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
@@ -407,7 +425,40 @@ func NewOdinApp(
 		app.CoinswapKeeper,
 	)
 
-	app.TelemetryKeeper = telemetrykeeper.NewKeeper(appCodec, encodingConfig.TxConfig, app.BankKeeper, app.StakingKeeper, app.DistrKeeper)
+	// The telemetry index lives next to the node databases and is updated as an app hook, keeping it out of consensus.
+	var telemetryIndex *telemetrykeeper.Index
+	if cast.ToBool(appOpts.Get(FlagTelemetryIndexEnable)) {
+		telemetryDB := dbm.DB(dbm.NewMemDB())
+		if homePath != "" {
+			telemetryDB, err = dbm.NewGoLevelDB("telemetry", filepath.Join(homePath, "data"))
+			if err != nil {
+				panic(err)
+			}
+		}
+		telemetryIndex = telemetrykeeper.NewIndex(
+			appCodec,
+			telemetryDB,
+			telemetrykeeper.NodeBlockStore{},
+			encodingConfig.TxConfig.TxDecoder(),
+			app.OracleKeeper,
+			app.AccountKeeper,
+			app.BankKeeper,
+			app.MintKeeper,
+			app.DistrKeeper,
+			app.QueryContext,
+			logger,
+		)
+		app.AddHook(telemetryIndex)
+	}
+	app.TelemetryKeeper = telemetrykeeper.NewKeeper(
+		appCodec,
+		encodingConfig.TxConfig,
+		app.BankKeeper,
+		app.StakingKeeper,
+		app.DistrKeeper,
+		app.OracleKeeper,
+		telemetryIndex,
+	)
 
 	oracleModule := oracle.NewAppModule(app.OracleKeeper)
 
@@ -587,6 +638,7 @@ func (app *OdinApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 	if err := tmjson.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
 		panic(err)
 	}
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
 	res := app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 	for _, hook := range app.hooks {
 		hook.AfterInitChain(ctx, req, res)
@@ -776,3 +828,28 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 func (app *OdinApp) AddHook(hook Hook) {
 	app.hooks = append(app.hooks, hook)
 }
+
+// QueryContext returns a read-only context over the state committed at the given height.
+func (app *OdinApp) QueryContext(height int64) (sdk.Context, error) {
+	if app.cms == nil {
+		return sdk.Context{}, fmt.Errorf("store is not loaded")
+	}
+	cms, err := app.cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return sdk.Context{}, err
+	}
+	return sdk.NewContext(cms, tmproto.Header{Height: height}, true, app.Logger()), nil
+}
+
+// Close closes the hooks holding resources such as databases. The server does not close the app, so it has to be
+// called once the node stopped.
+func (app *OdinApp) Close() error {
+	for _, hook := range app.hooks {
+		if closer, ok := hook.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
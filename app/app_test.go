@@ -0,0 +1,37 @@
+package odin_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/GeoDB-Limited/odin-core/x/common/testapp"
+	minttypes "github.com/GeoDB-Limited/odin-core/x/mint/types"
+)
+
+func TestUpgradeV050WithoutNewMintParams(t *testing.T) {
+	app, ctx, _ := testapp.CreateTestInput(true)
+	ctx = ctx.WithBlockHeight(100).WithBlockTime(time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC))
+
+	// the store of a chain before the mint store migration has none of the parameters it adds
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(minttypes.ModuleName+"/"))
+	store.Delete(minttypes.KeyMintVolumeWindow)
+	store.Delete(minttypes.KeyWithdrawalPeriod)
+	store.Delete(minttypes.KeyMaxWithdrawalPerPeriod)
+	versions := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	versions[minttypes.ModuleName] = 1
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, versions)
+
+	require.NotPanics(t, func() {
+		app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "v0.5.0", Height: ctx.BlockHeight()})
+	})
+	require.Equal(t, uint64(minttypes.ModuleVersion), app.UpgradeKeeper.GetModuleVersionMap(ctx)[minttypes.ModuleName])
+	params := app.MintKeeper.GetParams(ctx)
+	require.Equal(t, minttypes.DefaultParams().MintVolumeWindow, params.MintVolumeWindow)
+	require.Zero(t, params.WithdrawalPeriod)
+	require.True(t, params.MaxWithdrawalPerPeriod.IsZero())
+}
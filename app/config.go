@@ -0,0 +1,42 @@
+package odin
+
+import (
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// FlagTelemetryIndexEnable is the app.toml option enabling the telemetry index.
+const FlagTelemetryIndexEnable = "telemetry-index.enable"
+
+// TelemetryIndexConfig defines the telemetry index configuration.
+type TelemetryIndexConfig struct {
+	// Enable defines if the telemetry index should be kept by the node.
+	Enable bool `mapstructure:"enable"`
+}
+
+// Config defines the app.toml configuration of the Odin app.
+type Config struct {
+	serverconfig.Config `mapstructure:",squash"`
+
+	TelemetryIndex TelemetryIndexConfig `mapstructure:"telemetry-index"`
+}
+
+// ConfigTemplate is the app.toml template of the Odin app.
+const ConfigTemplate = serverconfig.DefaultConfigTemplate + `
+###############################################################################
+###                       Telemetry Index Configuration                     ###
+###############################################################################
+
+[telemetry-index]
+
+# Enable defines if the node keeps the telemetry index in data/telemetry.db. The index aggregates block, oracle and
+# account statistics as blocks are processed. Without it, only the block statistics are served, read from the block
+# store.
+enable = {{ .TelemetryIndex.Enable }}
+`
+
+// DefaultConfig returns the default app.toml configuration of the Odin app.
+func DefaultConfig() Config {
+	return Config{
+		Config: *serverconfig.DefaultConfig(),
+	}
+}
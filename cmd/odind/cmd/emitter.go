@@ -0,0 +1,230 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	odin "github.com/GeoDB-Limited/odin-core/app"
+	"github.com/GeoDB-Limited/odin-core/hooks/emitter"
+)
+
+const (
+	flagFromHeight = "from-height"
+	flagToHeight   = "to-height"
+	flagReplayDir  = "replay-dir"
+	flagCheckpoint = "checkpoint"
+	flagBufferSize = "buffer-size"
+)
+
+// EmitterCmd returns the command group for emitter maintenance.
+func EmitterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emitter",
+		Short: "Emitter maintenance commands",
+	}
+	cmd.AddCommand(EmitterReplayCmd())
+	return cmd
+}
+
+// EmitterReplayCmd returns the command to re-execute stored blocks with the emitter attached.
+func EmitterReplayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Re-execute stored blocks in an offline app and emit their messages",
+		Long: `Re-execute blocks from the node's block store in a separate application database with the emitter
+hook attached. The node must be stopped while replaying. The last emitted height is checkpointed, so an
+interrupted replay resumes where it stopped. Blocks below --from-height are executed without being emitted;
+to start above height 1 faster, seed --replay-dir with a copy of application.db at a lower height.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			home := serverCtx.Config.RootDir
+			uri, _ := cmd.Flags().GetString(flagWithEmitter)
+			if uri == "" {
+				return fmt.Errorf("--%s is required", flagWithEmitter)
+			}
+			fromHeight, _ := cmd.Flags().GetInt64(flagFromHeight)
+			toHeight, _ := cmd.Flags().GetInt64(flagToHeight)
+			bufferSize, _ := cmd.Flags().GetInt(flagBufferSize)
+			replayDir, _ := cmd.Flags().GetString(flagReplayDir)
+			if replayDir == "" {
+				replayDir = filepath.Join(home, "data", "emitter-replay")
+			}
+			checkpointPath, _ := cmd.Flags().GetString(flagCheckpoint)
+			if checkpointPath == "" {
+				checkpointPath = filepath.Join(replayDir, "checkpoint")
+			}
+			return replayBlocks(serverCtx, replayParams{
+				uri:            uri,
+				fromHeight:     fromHeight,
+				toHeight:       toHeight,
+				bufferSize:     bufferSize,
+				replayDir:      replayDir,
+				checkpointPath: checkpointPath,
+			})
+		},
+	}
+	cmd.Flags().Int64(flagFromHeight, 1, "first height to emit, the genesis state is emitted when at most 1")
+	cmd.Flags().Int64(flagToHeight, 0, "last height to replay, 0 for the latest stored block")
+	cmd.Flags().String(flagReplayDir, "", "directory of the replay application database (default <home>/data/emitter-replay)")
+	cmd.Flags().String(flagCheckpoint, "", "file storing the last emitted height (default <replay-dir>/checkpoint)")
+	cmd.Flags().Int(flagBufferSize, 100, "number of blocks queued for asynchronous writes to the emitter sink")
+	return cmd
+}
+
+type replayParams struct {
+	uri            string
+	fromHeight     int64
+	toHeight       int64
+	bufferSize     int
+	replayDir      string
+	checkpointPath string
+}
+
+func replayBlocks(serverCtx *server.Context, params replayParams) error {
+	cfg := serverCtx.Config
+	logger := serverCtx.Logger
+	dbBackend := dbm.BackendType(cfg.DBBackend)
+
+	blockStoreDB, err := dbm.NewDB("blockstore", dbBackend, cfg.DBDir())
+	if err != nil {
+		return err
+	}
+	defer blockStoreDB.Close()
+	blockStore := tmstore.NewBlockStore(blockStoreDB)
+	stateDB, err := dbm.NewDB("state", dbBackend, cfg.DBDir())
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+	stateStore := sm.NewStore(stateDB)
+	genDoc, err := tmtypes.GenesisDocFromFile(cfg.GenesisFile())
+	if err != nil {
+		return err
+	}
+
+	checkpoint, err := emitter.ReadCheckpoint(params.checkpointPath)
+	if err != nil {
+		return err
+	}
+	emitFrom := params.fromHeight
+	if checkpoint+1 > emitFrom {
+		emitFrom = checkpoint + 1
+	}
+
+	appDB, err := sdk.NewLevelDB("application", params.replayDir)
+	if err != nil {
+		return err
+	}
+	defer appDB.Close()
+	// Keep enough versions to rewind to the checkpoint, which lags behind by up to the queued blocks.
+	keepRecent := uint64(2*params.bufferSize + 10)
+	odinApp := odin.NewOdinApp(
+		logger, appDB, nil, true, map[int64]bool{}, cfg.RootDir, 0, odin.MakeEncodingConfig(), serverCtx.Viper,
+		false, cast.ToUint32(serverCtx.Viper.Get(flagWithOwasmCacheSize)),
+		baseapp.SetPruning(storetypes.NewPruningOptions(keepRecent, 0, 10)),
+	)
+	defer odinApp.Close()
+
+	sink, err := emitter.NewSink(params.uri, logger)
+	if err != nil {
+		return err
+	}
+	asyncSink := emitter.NewAsyncSink(
+		emitter.NewCheckpointSink(sink, params.checkpointPath, emitFrom), params.bufferSize, params.bufferSize,
+	)
+	odinApp.AddHook(emitter.NewHook(
+		odinApp.AppCodec(), odinApp.LegacyAmino(), odin.MakeEncodingConfig(), odinApp.AccountKeeper,
+		odinApp.BankKeeper, odinApp.StakingKeeper, odinApp.MintKeeper, odinApp.DistrKeeper, odinApp.GovKeeper,
+		odinApp.OracleKeeper, odinApp.AuctionKeeper, odinApp.CoinswapKeeper, asyncSink, false, logger,
+	))
+
+	// Rewind the replay state if it is ahead of what has been emitted.
+	resumeHeight := emitFrom - 1
+	if resumeHeight < 0 {
+		resumeHeight = 0
+	}
+	lastHeight := odinApp.LastBlockHeight()
+	if lastHeight > resumeHeight {
+		if err := odinApp.LoadHeight(resumeHeight); err != nil {
+			return fmt.Errorf("cannot rewind replay state from height %d to %d: %w", lastHeight, resumeHeight, err)
+		}
+		lastHeight = resumeHeight
+	}
+
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(odinApp))
+	if err := proxyApp.Start(); err != nil {
+		return err
+	}
+	defer proxyApp.Stop()
+
+	if lastHeight == 0 {
+		if err := initChainFromGenesis(proxyApp.Consensus(), genDoc); err != nil {
+			return err
+		}
+		lastHeight = genDoc.InitialHeight - 1
+	}
+
+	toHeight := params.toHeight
+	if toHeight == 0 || toHeight > blockStore.Height() {
+		toHeight = blockStore.Height()
+	}
+	logger.Info("replaying blocks", "from", lastHeight+1, "to", toHeight, "emit_from", emitFrom)
+	for height := lastHeight + 1; height <= toHeight; height++ {
+		if err := asyncSink.Err(); err != nil {
+			return err
+		}
+		block := blockStore.LoadBlock(height)
+		if block == nil {
+			return fmt.Errorf("block %d is not in the block store", height)
+		}
+		appHash, err := sm.ExecCommitBlock(proxyApp.Consensus(), block, logger, stateStore, genDoc.InitialHeight)
+		if err != nil {
+			return err
+		}
+		if next := blockStore.LoadBlockMeta(height + 1); next != nil && !bytes.Equal(next.Header.AppHash, appHash) {
+			return fmt.Errorf("app hash mismatch at height %d: expected %X, got %X", height, next.Header.AppHash, appHash)
+		}
+		if height%1000 == 0 {
+			logger.Info("replayed block", "height", height)
+		}
+	}
+
+	// Wait for all queued blocks to be written and checkpointed.
+	if err := asyncSink.Close(); err != nil {
+		return err
+	}
+	logger.Info("replay finished", "height", toHeight)
+	return nil
+}
+
+// initChainFromGenesis initializes the replay app with the genesis document, the way Tendermint does on start.
+func initChainFromGenesis(appConn proxy.AppConnConsensus, genDoc *tmtypes.GenesisDoc) error {
+	validators := make([]*tmtypes.Validator, len(genDoc.Validators))
+	for i, val := range genDoc.Validators {
+		validators[i] = tmtypes.NewValidator(val.PubKey, val.Power)
+	}
+	_, err := appConn.InitChainSync(abci.RequestInitChain{
+		Time:            genDoc.GenesisTime,
+		ChainId:         genDoc.ChainID,
+		InitialHeight:   genDoc.InitialHeight,
+		ConsensusParams: tmtypes.TM2PB.ConsensusParams(genDoc.ConsensusParams),
+		Validators:      tmtypes.TM2PB.ValidatorUpdates(tmtypes.NewValidatorSet(validators)),
+		AppStateBytes:   genDoc.AppState,
+	})
+	return err
+}
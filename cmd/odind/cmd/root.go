@@ -32,16 +32,19 @@ import (
 	"github.com/GeoDB-Limited/odin-core/app/params"
 	"github.com/GeoDB-Limited/odin-core/hooks/emitter"
 	"github.com/GeoDB-Limited/odin-core/hooks/request"
+	"github.com/GeoDB-Limited/odin-core/hooks/webhook"
 )
 
 const (
-	flagWithEmitter           = "with-emitter"
-	flagDisableFeelessReports = "disable-feeless-reports"
-	flagEnableFastSync        = "enable-fast-sync"
-	flagWithPricer            = "with-pricer"
-	flagWithRequestSearch     = "with-request-search"
-	flagWithOwasmCacheSize    = "oracle-script-cache-size"
-	flagEnableApi             = "api.enable"
+	flagWithEmitter            = "with-emitter"
+	flagDisableFeelessReports  = "disable-feeless-reports"
+	flagEnableFastSync         = "enable-fast-sync"
+	flagWithPricer             = "with-pricer"
+	flagWithRequestSearch      = "with-request-search"
+	flagRequestSearchRetention = "request-search-retention"
+	flagWithWebhook            = "with-webhook"
+	flagWithOwasmCacheSize     = "oracle-script-cache-size"
+	flagEnableApi              = "api.enable"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -66,7 +69,7 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 				return err
 			}
 
-			return server.InterceptConfigsPreRunHandler(cmd, "", nil)
+			return server.InterceptConfigsPreRunHandler(cmd, odin.ConfigTemplate, odin.DefaultConfig())
 		},
 	}
 
@@ -88,9 +91,28 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		AddGenesisOracleScriptCmd(odin.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		debug.Cmd(),
+		EmitterCmd(),
 	)
 
-	server.AddCommands(rootCmd, odin.DefaultNodeHome, newApp, createSimappAndExport, addModuleInitFlags)
+	var startedApp *odin.OdinApp
+	server.AddCommands(rootCmd, odin.DefaultNodeHome,
+		func(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts servertypes.AppOptions) servertypes.Application {
+			startedApp = newApp(logger, db, traceStore, appOpts).(*odin.OdinApp)
+			return startedApp
+		},
+		createSimappAndExport, addModuleInitFlags,
+	)
+	// The server does not close the app, so close it once the node stopped.
+	startCmd, _, err := rootCmd.Find([]string{"start"})
+	if err != nil {
+		panic(err)
+	}
+	startCmd.PostRunE = func(*cobra.Command, []string) error {
+		if startedApp == nil {
+			return nil
+		}
+		return startedApp.Close()
+	}
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
@@ -101,7 +123,9 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	)
 
 	rootCmd.PersistentFlags().String(flagWithRequestSearch, "", "[Experimental] Enable mode to save request in sql database")
-	rootCmd.PersistentFlags().String(flagWithEmitter, "", "[Experimental] Enable mode with emitter")
+	rootCmd.PersistentFlags().Duration(flagRequestSearchRetention, 0, "[Experimental] How long requests are kept in the request search database, 0 to keep them forever")
+	rootCmd.PersistentFlags().String(flagWithWebhook, "", "[Experimental] Path to the JSON config of webhooks notified of resolved requests")
+	rootCmd.PersistentFlags().String(flagWithEmitter, "", "[Experimental] Enable mode with emitter, e.g. kafka://localhost:9092/topic, file:///path/blocks.ndjson, stdout:// or topic@broker, add ?encoding=protobuf for the protobuf schema")
 	rootCmd.PersistentFlags().Uint32(flagWithOwasmCacheSize, 100, "[Experimental] Number of oracle scripts to cache")
 }
 func addModuleInitFlags(startCmd *cobra.Command) {
@@ -209,15 +233,33 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 	connStr, _ := appOpts.Get(flagWithRequestSearch).(string)
 	if connStr != "" {
 		odinApp.AddHook(request.NewHook(
-			odinApp.AppCodec(), odinApp.OracleKeeper, connStr))
+			odinApp.AppCodec(), odin.MakeEncodingConfig().TxConfig.TxDecoder(), odinApp.OracleKeeper, connStr,
+			cast.ToDuration(appOpts.Get(flagRequestSearchRetention))))
+	}
+
+	webhookConfig, _ := appOpts.Get(flagWithWebhook).(string)
+	if webhookConfig != "" {
+		config, err := webhook.LoadConfig(webhookConfig, filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data"))
+		if err != nil {
+			panic(err)
+		}
+		hook, err := webhook.NewHook(odinApp.OracleKeeper, config, logger)
+		if err != nil {
+			panic(err)
+		}
+		odinApp.AddHook(hook)
 	}
 
 	connStr, _ = appOpts.Get(flagWithEmitter).(string)
 	if connStr != "" {
+		sink, err := emitter.NewSink(connStr, logger)
+		if err != nil {
+			panic(err)
+		}
 		odinApp.AddHook(
 			emitter.NewHook(odinApp.AppCodec(), odinApp.LegacyAmino(), odin.MakeEncodingConfig(), odinApp.AccountKeeper, odinApp.BankKeeper,
 				odinApp.StakingKeeper, odinApp.MintKeeper, odinApp.DistrKeeper, odinApp.GovKeeper,
-				odinApp.OracleKeeper, connStr, false))
+				odinApp.OracleKeeper, odinApp.AuctionKeeper, odinApp.CoinswapKeeper, sink, false, logger))
 	}
 
 	return odinApp
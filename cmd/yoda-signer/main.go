@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	odin "github.com/GeoDB-Limited/odin-core/app"
+	"github.com/GeoDB-Limited/odin-core/yoda/signer"
+)
+
+const (
+	DefaultKeyringBackend = "test"
+	DefaultHomeEnv        = "$HOME/.yoda-signer"
+
+	flagListen = "listen"
+	flagCA     = "ca"
+	flagCert   = "cert"
+	flagKey    = "key"
+)
+
+// main runs the reference remote signer for yoda. It signs with keys of a local keyring in the same format as
+// yoda's own, so keys can be managed with `yoda keys --home <signer home>`.
+func main() {
+	appConfig := sdk.GetConfig()
+	odin.SetBech32AddressPrefixesAndBip44CoinType(appConfig)
+	appConfig.Seal()
+
+	rootCmd := &cobra.Command{
+		Use:   "yoda-signer",
+		Short: "Reference remote signer serving yoda reporter keys over mutual TLS",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			home, err := cmd.Flags().GetString(flags.FlagHome)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to parse home directory")
+			}
+			keyringBackend, err := cmd.Flags().GetString(flags.FlagKeyringBackend)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to parse keyring backend")
+			}
+			kb, err := keyring.New("app", keyringBackend, home, os.Stdin)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to open keyring")
+			}
+
+			caFile, _ := cmd.Flags().GetString(flagCA)
+			certFile, _ := cmd.Flags().GetString(flagCert)
+			keyFile, _ := cmd.Flags().GetString(flagKey)
+			tlsConfig, err := signer.LoadTLSConfig(caFile, certFile, keyFile, true)
+			if err != nil {
+				return err
+			}
+
+			listen, _ := cmd.Flags().GetString(flagListen)
+			lis, err := net.Listen("tcp", listen)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to listen")
+			}
+			srv := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+			signer.RegisterSignerServer(srv, signer.NewServer(kb))
+			fmt.Printf("Serving signer on %s\n", lis.Addr())
+			return srv.Serve(lis)
+		},
+	}
+	rootCmd.Flags().String(flags.FlagHome, os.ExpandEnv(DefaultHomeEnv), "home directory of the keyring")
+	rootCmd.Flags().String(flags.FlagKeyringBackend, DefaultKeyringBackend, "keyring backend")
+	rootCmd.Flags().String(flagListen, "localhost:9010", "address to listen on for signing requests")
+	rootCmd.Flags().String(flagCA, "", "CA certificate file that signed the client certificates")
+	rootCmd.Flags().String(flagCert, "", "server certificate file")
+	rootCmd.Flags().String(flagKey, "", "server private key file")
+	rootCmd.MarkFlagRequired(flagCA)
+	rootCmd.MarkFlagRequired(flagCert)
+	rootCmd.MarkFlagRequired(flagKey)
+
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
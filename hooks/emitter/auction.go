@@ -0,0 +1,33 @@
+package emitter
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/GeoDB-Limited/odin-core/hooks/common"
+	"github.com/GeoDB-Limited/odin-core/x/auction/types"
+)
+
+func (h *Hook) emitSetAuctionStatus(ctx sdk.Context) {
+	h.Write("SET_AUCTION_STATUS", common.JsDict{
+		"pending":           h.auctionKeeper.GetAuctionStatus(ctx).Pending,
+		"start_threshold":   h.auctionKeeper.GetAuctionStartThreshold(ctx).String(),
+		"accumulated":       h.auctionKeeper.GetAccumulatedPaymentsForData(ctx).String(),
+		"last_update":       ctx.BlockTime().UnixNano(),
+		"last_update_block": ctx.BlockHeight(),
+	})
+}
+
+// handleEventAuctionStatus implements emitter handler for auction start and finish events.
+func (h *Hook) handleEventAuctionStatus(ctx sdk.Context) {
+	h.emitSetAuctionStatus(ctx)
+}
+
+// handleMsgBuyCoins implements emitter handler for MsgBuyCoins.
+func (h *Hook) handleMsgBuyCoins(ctx sdk.Context, txHash []byte, msg *types.MsgBuyCoins, extra common.JsDict) {
+	h.emitExchange(
+		ctx, txHash, types.ModuleName, msg.Requester, msg.From, msg.To, msg.Amount, extra,
+		h.auctionKeeper.GetExchangeRates(ctx)...,
+	)
+	// Buying coins decreases the accumulated payments that trigger auctions.
+	h.emitSetAuctionStatus(ctx)
+}
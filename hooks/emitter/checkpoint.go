@@ -0,0 +1,103 @@
+package emitter
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/GeoDB-Limited/odin-core/hooks/common"
+)
+
+// ReadCheckpoint returns the last emitted height stored at path, or -1 if there is no checkpoint yet.
+func ReadCheckpoint(path string) (int64, error) {
+	bz, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return -1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	height, err := strconv.ParseInt(strings.TrimSpace(string(bz)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid checkpoint %s: %w", path, err)
+	}
+	return height, nil
+}
+
+// WriteCheckpoint atomically stores height as the last emitted height at path.
+func WriteCheckpoint(path string, height int64) error {
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, []byte(strconv.FormatInt(height, 10)+"\n"), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// commitHeight returns the height of the given COMMIT message.
+func commitHeight(msg common.Message) (int64, bool) {
+	if msg.Key != "COMMIT" {
+		return 0, false
+	}
+	switch height := msg.Value["height"].(type) {
+	case int64:
+		return height, true
+	case int:
+		return int64(height), true
+	default:
+		return 0, false
+	}
+}
+
+// CheckpointSink records the height of the last COMMIT message written to the underlying sink, so that an
+// interrupted replay can resume after it. Blocks below fromHeight are dropped; the genesis state is kept when
+// fromHeight is at most 1.
+type CheckpointSink struct {
+	sink       Sink
+	path       string
+	fromHeight int64
+}
+
+// NewCheckpointSink creates a new CheckpointSink instance storing its checkpoint at path.
+func NewCheckpointSink(sink Sink, path string, fromHeight int64) *CheckpointSink {
+	return &CheckpointSink{sink: sink, path: path, fromHeight: fromHeight}
+}
+
+func (s *CheckpointSink) emitted(height int64) bool {
+	return height >= s.fromHeight || (height == 0 && s.fromHeight <= 1)
+}
+
+// Write implements Sink.
+func (s *CheckpointSink) Write(msgs []common.Message) error {
+	var kept []common.Message
+	lastHeight := int64(-1)
+	start := 0
+	for idx, msg := range msgs {
+		height, ok := commitHeight(msg)
+		if !ok {
+			continue
+		}
+		if s.emitted(height) {
+			kept = append(kept, msgs[start:idx+1]...)
+		}
+		lastHeight, start = height, idx+1
+	}
+	if start != len(msgs) {
+		return fmt.Errorf("batch does not end with a COMMIT message")
+	}
+	if len(kept) != 0 {
+		if err := s.sink.Write(kept); err != nil {
+			return err
+		}
+	}
+	if lastHeight < 0 {
+		return nil
+	}
+	return WriteCheckpoint(s.path, lastHeight)
+}
+
+// Close implements Sink.
+func (s *CheckpointSink) Close() error {
+	return s.sink.Close()
+}
@@ -0,0 +1,93 @@
+package emitter
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/GeoDB-Limited/odin-core/hooks/common"
+)
+
+func testBlock(height int64) []common.Message {
+	return []common.Message{
+		{Key: "NEW_BLOCK", Value: common.JsDict{"height": height}},
+		{Key: "COMMIT", Value: common.JsDict{"height": height}},
+	}
+}
+
+func TestReadWriteCheckpoint(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint")
+	height, err := ReadCheckpoint(path)
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), height)
+
+	require.NoError(t, WriteCheckpoint(path, 42))
+	height, err = ReadCheckpoint(path)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), height)
+}
+
+func TestCheckpointSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint")
+	memory := NewMemorySink()
+	sink := NewCheckpointSink(memory, path, 3)
+
+	genesis := []common.Message{
+		{Key: "SET_ACCOUNT", Value: common.JsDict{}},
+		{Key: "COMMIT", Value: common.JsDict{"height": 0}},
+	}
+	require.NoError(t, sink.Write(genesis))
+	require.NoError(t, sink.Write(append(testBlock(1), testBlock(2)...)))
+	require.Empty(t, memory.Batches())
+	height, err := ReadCheckpoint(path)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), height)
+
+	// Blocks below the starting height are dropped from a merged batch.
+	require.NoError(t, sink.Write(append(testBlock(2), testBlock(3)...)))
+	require.Equal(t, [][]common.Message{testBlock(3)}, memory.Batches())
+	height, err = ReadCheckpoint(path)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), height)
+
+	require.Error(t, sink.Write(testBlock(4)[:1]))
+}
+
+func TestCheckpointSinkGenesis(t *testing.T) {
+	memory := NewMemorySink()
+	sink := NewCheckpointSink(memory, filepath.Join(t.TempDir(), "checkpoint"), 1)
+	genesis := []common.Message{{Key: "COMMIT", Value: common.JsDict{"height": 0}}}
+	require.NoError(t, sink.Write(genesis))
+	require.Equal(t, [][]common.Message{genesis}, memory.Batches())
+}
+
+type failingSink struct{}
+
+func (failingSink) Write([]common.Message) error { return errors.New("unavailable") }
+func (failingSink) Close() error                 { return nil }
+
+func TestAsyncSink(t *testing.T) {
+	memory := NewMemorySink()
+	sink := NewAsyncSink(memory, 10, 10)
+	for height := int64(1); height <= 5; height++ {
+		require.NoError(t, sink.Write(testBlock(height)))
+	}
+	require.NoError(t, sink.Close())
+
+	var msgs []common.Message
+	for _, batch := range memory.Batches() {
+		msgs = append(msgs, batch...)
+	}
+	require.Len(t, msgs, 10)
+	for idx, msg := range msgs {
+		require.Equal(t, int64(idx/2+1), msg.Value["height"])
+	}
+}
+
+func TestAsyncSinkError(t *testing.T) {
+	sink := NewAsyncSink(failingSink{}, 10, 10)
+	require.NoError(t, sink.Write(testBlock(1)))
+	require.EqualError(t, sink.Close(), "unavailable")
+}
@@ -0,0 +1,74 @@
+package emitter
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/GeoDB-Limited/odin-core/hooks/common"
+	auctiontypes "github.com/GeoDB-Limited/odin-core/x/auction/types"
+	"github.com/GeoDB-Limited/odin-core/x/coinswap/types"
+)
+
+func exchangeRatesToJson(rates []types.Exchange) []common.JsDict {
+	res := make([]common.JsDict, len(rates))
+	for idx, rate := range rates {
+		res[idx] = common.JsDict{
+			"from":            rate.From,
+			"to":              rate.To,
+			"rate_multiplier": rate.RateMultiplier.String(),
+		}
+	}
+	return res
+}
+
+// emitSetExchangeRates emits the exchange rates of the given module if they changed since last emitted.
+func (h *Hook) emitSetExchangeRates(ctx sdk.Context, module string, rates []types.Exchange) {
+	msg := common.JsDict{
+		"module":       module,
+		"initial_rate": h.coinswapKeeper.GetInitialRate(ctx).String(),
+		"rates":        exchangeRatesToJson(rates),
+	}
+	key, _ := json.Marshal(msg) // Error must always be nil.
+	if h.exchangeRates[module] == string(key) {
+		return
+	}
+	h.exchangeRates[module] = string(key)
+	h.Write("SET_EXCHANGE_RATES", msg)
+}
+
+// emitExchangeRates emits the coinswap and auction exchange rates that changed since last emitted.
+func (h *Hook) emitExchangeRates(ctx sdk.Context) {
+	h.emitSetExchangeRates(ctx, types.ModuleName, h.coinswapKeeper.GetParams(ctx).ExchangeRates)
+	h.emitSetExchangeRates(ctx, auctiontypes.ModuleName, h.auctionKeeper.GetExchangeRates(ctx))
+}
+
+// emitExchange emits the given coin exchange along with the rate it was performed at.
+func (h *Hook) emitExchange(
+	ctx sdk.Context, txHash []byte, module, requester, from, to string, amount sdk.Coin,
+	extra common.JsDict, additionalExchangeRates ...types.Exchange,
+) {
+	h.AddAccountsInTx(requester)
+	received := "0"
+	rate, err := h.coinswapKeeper.GetRate(ctx, from, to, additionalExchangeRates...)
+	if err == nil {
+		received = amount.Amount.ToDec().QuoRoundUp(rate).TruncateInt().String()
+		extra["rate"] = rate.String()
+	}
+	extra["received_amount"] = received
+	h.Write("NEW_EXCHANGE", common.JsDict{
+		"tx_hash":         txHash,
+		"module":          module,
+		"requester":       requester,
+		"from":            from,
+		"to":              to,
+		"amount":          amount.Amount.String(),
+		"received_amount": received,
+		"rate":            extra["rate"],
+	})
+}
+
+// handleMsgExchange implements emitter handler for MsgExchange.
+func (h *Hook) handleMsgExchange(ctx sdk.Context, txHash []byte, msg *types.MsgExchange, extra common.JsDict) {
+	h.emitExchange(ctx, txHash, types.ModuleName, msg.Requester, msg.From, msg.To, msg.Amount, extra)
+}
@@ -1,13 +1,10 @@
 package emitter
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
-	"strings"
-	"time"
 
+	auctionkeeper "github.com/GeoDB-Limited/odin-core/x/auction/keeper"
+	coinswapkeeper "github.com/GeoDB-Limited/odin-core/x/coinswap/keeper"
 	mintkeeper "github.com/GeoDB-Limited/odin-core/x/mint/keeper"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,10 +16,10 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
-	"github.com/segmentio/kafka-go"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
 
 	odinapp "github.com/GeoDB-Limited/odin-core/app"
 	"github.com/GeoDB-Limited/odin-core/app/params"
@@ -32,18 +29,21 @@ import (
 	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
 )
 
-// Hook uses Kafka functionality to act as an event producer for all events in the blockchains.
+// Hook acts as an event producer for all events in the blockchains, publishing them to a Sink.
 type Hook struct {
 	cdc            codec.Codec
 	legecyAmino    *codec.LegacyAmino
 	encodingConfig params.EncodingConfig
-	// Main Kafka writer instance.
-	writer *kafka.Writer
+	// Sink receiving the messages of every block.
+	sink   Sink
+	logger log.Logger
 	// Temporary variables that are reset on every block.
 	accsInBlock    map[string]bool  // The accounts that need balance update at the end of block.
 	accsInTx       map[string]bool  // The accounts related to the current processing transaction.
 	msgs           []common.Message // The list of all messages to publish for this block.
 	emitStartState bool             // If emitStartState is true will emit all non historical state to Kafka
+	// The latest emitted exchange rates by module, so that only changes are emitted.
+	exchangeRates map[string]string
 
 	accountKeeper authkeeper.AccountKeeper
 	bankKeeper    bankkeeper.Keeper
@@ -52,26 +52,24 @@ type Hook struct {
 	distrKeeper   distrkeeper.Keeper
 	govKeeper     govkeeper.Keeper
 	oracleKeeper  oraclekeeper.Keeper
+
+	auctionKeeper  auctionkeeper.Keeper
+	coinswapKeeper coinswapkeeper.Keeper
 }
 
 // NewHook creates an emitter hook instance that will be added in Odin App.
 func NewHook(
 	cdc codec.Codec, legecyAmino *codec.LegacyAmino, encodingConfig params.EncodingConfig, accountKeeper authkeeper.AccountKeeper, bankKeeper bankkeeper.Keeper,
 	stakingKeeper stakingkeeper.Keeper, mintKeeper mintkeeper.Keeper, distrKeeper distrkeeper.Keeper, govKeeper govkeeper.Keeper,
-	oracleKeeper oraclekeeper.Keeper, kafkaURI string, emitStartState bool,
+	oracleKeeper oraclekeeper.Keeper, auctionKeeper auctionkeeper.Keeper, coinswapKeeper coinswapkeeper.Keeper,
+	sink Sink, emitStartState bool, logger log.Logger,
 ) *Hook {
-	paths := strings.SplitN(kafkaURI, "@", 2)
 	return &Hook{
 		cdc:            cdc,
 		legecyAmino:    legecyAmino,
 		encodingConfig: encodingConfig,
-		writer: kafka.NewWriter(kafka.WriterConfig{
-			Brokers:      paths[1:],
-			Topic:        paths[0],
-			Balancer:     &kafka.LeastBytes{},
-			BatchTimeout: 1 * time.Millisecond,
-			// Async:    true, // TODO: We may be able to enable async mode on replay
-		}),
+		sink:           sink,
+		logger:         logger,
 		accountKeeper:  accountKeeper,
 		bankKeeper:     bankKeeper,
 		stakingKeeper:  stakingKeeper,
@@ -79,7 +77,10 @@ func NewHook(
 		distrKeeper:    distrKeeper,
 		govKeeper:      govKeeper,
 		oracleKeeper:   oracleKeeper,
+		auctionKeeper:  auctionKeeper,
+		coinswapKeeper: coinswapKeeper,
 		emitStartState: emitStartState,
+		exchangeRates:  make(map[string]string),
 	}
 }
 
@@ -102,16 +103,17 @@ func (h *Hook) Write(key string, val common.JsDict) {
 	h.msgs = append(h.msgs, common.Message{Key: key, Value: val})
 }
 
-// FlushMessages publishes all pending messages to Kafka. Blocks until completion.
+// FlushMessages publishes all pending messages to the sink. Blocks until completion. If the sink fails, the messages
+// are dropped and logged rather than halting the node, so that the block can be emitted again with the replay command.
 func (h *Hook) FlushMessages() {
-	kafkaMsgs := make([]kafka.Message, len(h.msgs))
-	for idx, msg := range h.msgs {
-		res, _ := json.Marshal(msg.Value) // Error must always be nil.
-		kafkaMsgs[idx] = kafka.Message{Key: []byte(msg.Key), Value: res}
-	}
-	err := h.writer.WriteMessages(context.Background(), kafkaMsgs...)
-	if err != nil {
-		panic(err)
+	if err := h.sink.Write(h.msgs); err != nil {
+		height := int64(-1)
+		if len(h.msgs) > 0 {
+			if commit, ok := commitHeight(h.msgs[len(h.msgs)-1]); ok {
+				height = commit
+			}
+		}
+		h.logger.Error("Dropped emitter messages the sink failed to write", "height", height, "count", len(h.msgs), "err", err)
 	}
 }
 
@@ -229,6 +231,13 @@ func (h *Hook) AfterInitChain(ctx sdk.Context, req abci.RequestInitChain, res ab
 	for idx, os := range oracleState.OracleScripts {
 		h.emitSetOracleScript(types.OracleScriptID(idx+1), os, nil)
 	}
+
+	// Odin modules
+	h.emitSetMintPool(ctx)
+	h.emitSetMintVolume(ctx)
+	h.emitExchangeRates(ctx)
+	h.emitSetAuctionStatus(ctx)
+
 	h.Write("COMMIT", common.JsDict{"height": 0})
 	h.FlushMessages()
 }
@@ -312,7 +321,6 @@ func (h *Hook) AfterDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res ab
 		"success":      res.IsOK(),
 		"memo":         memoTx.GetMemo(),
 	}
-	fmt.Println("beebja", txDict)
 	// NOTE: We add txDict to the list of pending Kafka messages here, but it will still be
 	// mutated in the loop below as we know the messages won't get flushed until ABCI Commit.
 	h.Write("NEW_TRANSACTION", txDict)
@@ -354,6 +362,8 @@ func (h *Hook) AfterEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci
 	for _, event := range res.Events {
 		h.handleBeginBlockEndBlockEvent(ctx, event)
 	}
+	// Exchange rates are module params that only change through governance.
+	h.emitExchangeRates(ctx)
 	// Update balances of all affected accounts on this block.
 	// Index 0 is message NEW_BLOCK, we insert SET_ACCOUNT messages right after it.
 	modifiedMsgs := []common.Message{h.msgs[0]}
@@ -0,0 +1,24 @@
+package emitter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/GeoDB-Limited/odin-core/hooks/common"
+)
+
+func TestFlushMessagesSinkFailure(t *testing.T) {
+	var logs bytes.Buffer
+	h := &Hook{sink: failingSink{}, logger: log.NewTMLogger(&logs)}
+	h.Write("SET_ACCOUNT", common.JsDict{"address": "odin1"})
+	h.Write("COMMIT", common.JsDict{"height": int64(42)})
+
+	require.NotPanics(t, h.FlushMessages)
+	require.Contains(t, logs.String(), "Dropped emitter messages the sink failed to write")
+	require.Contains(t, logs.String(), "height=42")
+	require.Contains(t, logs.String(), "count=2")
+	require.Contains(t, logs.String(), "unavailable")
+}
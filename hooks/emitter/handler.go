@@ -11,6 +11,9 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/GeoDB-Limited/odin-core/hooks/common"
+	auctiontypes "github.com/GeoDB-Limited/odin-core/x/auction/types"
+	coinswaptypes "github.com/GeoDB-Limited/odin-core/x/coinswap/types"
+	minttypes "github.com/GeoDB-Limited/odin-core/x/mint/types"
 	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
 )
 
@@ -78,6 +81,14 @@ func (h *Hook) handleMsg(ctx sdk.Context, txHash []byte, msg sdk.Msg, log sdk.AB
 		h.handleMsgDeposit(ctx, txHash, msg)
 	case *channeltypes.MsgRecvPacket:
 		h.handleMsgRecvPacket(ctx, txHash, msg, evMap, extra)
+	case *auctiontypes.MsgBuyCoins:
+		h.handleMsgBuyCoins(ctx, txHash, msg, extra)
+	case *coinswaptypes.MsgExchange:
+		h.handleMsgExchange(ctx, txHash, msg, extra)
+	case *minttypes.MsgMintCoins:
+		h.handleMsgMintCoins(ctx, txHash, msg, extra)
+	case *minttypes.MsgWithdrawCoinsToAccFromTreasury:
+		h.handleMsgWithdrawCoinsToAccFromTreasury(ctx, txHash, msg, extra)
 	}
 }
 
@@ -103,6 +114,12 @@ func (h *Hook) handleBeginBlockEndBlockEvent(ctx sdk.Context, event abci.Event)
 		h.handleEventTypeTransfer(evMap)
 	case channeltypes.EventTypeSendPacket:
 		h.handleEventSendPacket(ctx, evMap)
+	case auctiontypes.EventTypeStartAuction, auctiontypes.EventTypeFinishAuction:
+		h.handleEventAuctionStatus(ctx)
+	case minttypes.EventTypeResetMintVolume:
+		h.emitSetMintVolume(ctx)
+	case minttypes.EventTypeTreasurySpend:
+		h.emitSetMintPool(ctx)
 	default:
 		break
 	}
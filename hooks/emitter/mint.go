@@ -0,0 +1,48 @@
+package emitter
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/GeoDB-Limited/odin-core/hooks/common"
+	"github.com/GeoDB-Limited/odin-core/x/mint/types"
+)
+
+func (h *Hook) emitSetMintPool(ctx sdk.Context) {
+	h.Write("SET_MINT_POOL", common.JsDict{
+		"treasury_pool": h.mintKeeper.GetMintPool(ctx).TreasuryPool.String(),
+	})
+}
+
+func (h *Hook) emitSetMintVolume(ctx sdk.Context) {
+	h.Write("SET_MINT_VOLUME", common.JsDict{
+		"current_mint_volume": h.mintKeeper.GetMinter(ctx).CurrentMintVolume.String(),
+		"max_mint_volume":     h.mintKeeper.GetParams(ctx).MaxAllowedMintVolume.String(),
+	})
+}
+
+// handleMsgMintCoins implements emitter handler for MsgMintCoins.
+func (h *Hook) handleMsgMintCoins(ctx sdk.Context, txHash []byte, msg *types.MsgMintCoins, extra common.JsDict) {
+	extra["minting_volume"] = msg.Amount.String()
+	h.Write("NEW_MINT", common.JsDict{
+		"tx_hash": txHash,
+		"sender":  msg.Sender,
+		"amount":  msg.Amount.String(),
+	})
+	h.emitSetMintPool(ctx)
+	h.emitSetMintVolume(ctx)
+}
+
+// handleMsgWithdrawCoinsToAccFromTreasury implements emitter handler for MsgWithdrawCoinsToAccFromTreasury.
+func (h *Hook) handleMsgWithdrawCoinsToAccFromTreasury(
+	ctx sdk.Context, txHash []byte, msg *types.MsgWithdrawCoinsToAccFromTreasury, extra common.JsDict,
+) {
+	h.AddAccountsInTx(msg.Receiver)
+	extra["withdrawal_amount"] = msg.Amount.String()
+	h.Write("NEW_TREASURY_WITHDRAWAL", common.JsDict{
+		"tx_hash":  txHash,
+		"sender":   msg.Sender,
+		"receiver": msg.Receiver,
+		"amount":   msg.Amount.String(),
+	})
+	h.emitSetMintPool(ctx)
+}
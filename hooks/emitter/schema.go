@@ -0,0 +1,215 @@
+package emitter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/GeoDB-Limited/odin-core/hooks/common"
+	"github.com/GeoDB-Limited/odin-core/hooks/emitter/types"
+)
+
+// SchemaVersion is the version of the emitter message schema defined in proto/emitter/v1.
+const SchemaVersion = 1
+
+// Encoding selects how sinks serialize message values.
+type Encoding string
+
+const (
+	EncodingJSON     Encoding = "json"
+	EncodingProtobuf Encoding = "protobuf"
+)
+
+// ParseEncoding returns the encoding with the given name. JSON is used if the name is empty.
+func ParseEncoding(name string) (Encoding, error) {
+	switch Encoding(name) {
+	case "", EncodingJSON:
+		return EncodingJSON, nil
+	case EncodingProtobuf:
+		return EncodingProtobuf, nil
+	default:
+		return "", fmt.Errorf("unknown emitter encoding %q", name)
+	}
+}
+
+// schemaTypes maps every message key to the protobuf type of its schema.
+var schemaTypes = map[string]func() proto.Message{
+	"COMMIT":                          func() proto.Message { return &types.Commit{} },
+	"NEW_BLOCK":                       func() proto.Message { return &types.NewBlock{} },
+	"NEW_TRANSACTION":                 func() proto.Message { return &types.NewTransaction{} },
+	"SET_RELATED_TRANSACTION":         func() proto.Message { return &types.SetRelatedTransaction{} },
+	"SET_ACCOUNT":                     func() proto.Message { return &types.SetAccount{} },
+	"NEW_VALIDATOR_VOTE":              func() proto.Message { return &types.NewValidatorVote{} },
+	"SET_VALIDATOR":                   func() proto.Message { return &types.SetValidator{} },
+	"UPDATE_VALIDATOR":                func() proto.Message { return &types.UpdateValidator{} },
+	"SET_DELEGATION":                  func() proto.Message { return &types.SetDelegation{} },
+	"UPDATE_DELEGATION":               func() proto.Message { return &types.UpdateDelegation{} },
+	"REMOVE_DELEGATION":               func() proto.Message { return &types.RemoveDelegation{} },
+	"NEW_UNBONDING_DELEGATION":        func() proto.Message { return &types.NewUnbondingDelegation{} },
+	"REMOVE_UNBONDING":                func() proto.Message { return &types.RemoveUnbonding{} },
+	"NEW_REDELEGATION":                func() proto.Message { return &types.NewRedelegation{} },
+	"REMOVE_REDELEGATION":             func() proto.Message { return &types.RemoveRedelegation{} },
+	"NEW_PROPOSAL":                    func() proto.Message { return &types.NewProposal{} },
+	"UPDATE_PROPOSAL":                 func() proto.Message { return &types.UpdateProposal{} },
+	"SET_DEPOSIT":                     func() proto.Message { return &types.SetDeposit{} },
+	"SET_VOTE":                        func() proto.Message { return &types.SetVote{} },
+	"SET_DATA_SOURCE":                 func() proto.Message { return &types.SetDataSource{} },
+	"SET_ORACLE_SCRIPT":               func() proto.Message { return &types.SetOracleScript{} },
+	"NEW_REQUEST":                     func() proto.Message { return &types.NewRequest{} },
+	"UPDATE_REQUEST":                  func() proto.Message { return &types.UpdateRequest{} },
+	"NEW_RAW_REQUEST":                 func() proto.Message { return &types.NewRawRequest{} },
+	"NEW_VAL_REQUEST":                 func() proto.Message { return &types.NewValRequest{} },
+	"NEW_REPORT":                      func() proto.Message { return &types.NewReport{} },
+	"NEW_RAW_REPORT":                  func() proto.Message { return &types.NewRawReport{} },
+	"SET_REPORTER":                    func() proto.Message { return &types.SetReporter{} },
+	"REMOVE_REPORTER":                 func() proto.Message { return &types.RemoveReporter{} },
+	"SET_HISTORICAL_VALIDATOR_STATUS": func() proto.Message { return &types.SetHistoricalValidatorStatus{} },
+	"NEW_PACKET":                      func() proto.Message { return &types.NewPacket{} },
+	"SET_MINT_POOL":                   func() proto.Message { return &types.SetMintPool{} },
+	"SET_MINT_VOLUME":                 func() proto.Message { return &types.SetMintVolume{} },
+	"NEW_MINT":                        func() proto.Message { return &types.NewMint{} },
+	"NEW_TREASURY_WITHDRAWAL":         func() proto.Message { return &types.NewTreasuryWithdrawal{} },
+	"SET_EXCHANGE_RATES":              func() proto.Message { return &types.SetExchangeRates{} },
+	"NEW_EXCHANGE":                    func() proto.Message { return &types.NewExchange{} },
+	"SET_AUCTION_STATUS":              func() proto.Message { return &types.SetAuctionStatus{} },
+}
+
+// NewSchemaMessage returns an empty protobuf message of the schema type for the given message key.
+func NewSchemaMessage(key string) (proto.Message, error) {
+	newMsg, ok := schemaTypes[key]
+	if !ok {
+		return nil, fmt.Errorf("message %s is not in emitter schema v%d", key, SchemaVersion)
+	}
+	return newMsg(), nil
+}
+
+// ToSchemaMessage converts the message value into its schema type. It fails if the value has fields that are not
+// in the schema or values of the wrong type, so schema drift is caught before anything is published.
+func ToSchemaMessage(msg common.Message) (proto.Message, error) {
+	pb, err := unmarshalSchemaMessage(msg, false)
+	if err != nil {
+		return nil, fmt.Errorf("message %s does not match emitter schema v%d: %w", msg.Key, SchemaVersion, err)
+	}
+	return pb, nil
+}
+
+func unmarshalSchemaMessage(msg common.Message, allowUnknownFields bool) (proto.Message, error) {
+	pb, err := NewSchemaMessage(msg.Key)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(msg.Value)
+	if err != nil {
+		return nil, err
+	}
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: allowUnknownFields}
+	if err := unmarshaler.Unmarshal(bytes.NewReader(bz), pb); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// encodeValue serializes the message value with the given encoding. With protobuf encoding, fields that are not in
+// the schema are dropped and logged rather than failing the whole block, since the sink error would halt the node.
+// Values of the wrong type still fail.
+func encodeValue(msg common.Message, encoding Encoding, logger log.Logger) ([]byte, error) {
+	if encoding != EncodingProtobuf {
+		return json.Marshal(msg.Value)
+	}
+	pb, err := ToSchemaMessage(msg)
+	if err != nil {
+		var lenientErr error
+		if pb, lenientErr = unmarshalSchemaMessage(msg, true); lenientErr != nil {
+			return nil, err
+		}
+		logger.Error("Dropped emitter message fields that are not in the schema", "key", msg.Key, "err", err)
+	}
+	return proto.Marshal(pb)
+}
+
+// position is the location of a message within the emitted blocks.
+type position struct {
+	height int64
+	index  uint32
+}
+
+// messagePositions returns the height of the block every message was emitted in along with its index within the
+// block. The messages must consist of whole blocks, each ending with a COMMIT message.
+func messagePositions(msgs []common.Message) ([]position, error) {
+	positions := make([]position, len(msgs))
+	start := 0
+	for idx, msg := range msgs {
+		if msg.Key != "COMMIT" {
+			continue
+		}
+		height, ok := commitHeight(msg)
+		if !ok {
+			return nil, fmt.Errorf("COMMIT message without height")
+		}
+		for i := start; i <= idx; i++ {
+			positions[i] = position{height: height, index: uint32(i - start)}
+		}
+		start = idx + 1
+	}
+	if start != len(msgs) {
+		return nil, fmt.Errorf("batch does not end with a COMMIT message")
+	}
+	return positions, nil
+}
+
+// writeEnvelopes writes msgs to w as varint length-prefixed protobuf envelopes and returns the number of bytes
+// written.
+func writeEnvelopes(w io.Writer, msgs []common.Message, logger log.Logger) (int64, error) {
+	positions, err := messagePositions(msgs)
+	if err != nil {
+		return 0, err
+	}
+	bw := bufio.NewWriter(w)
+	var written int64
+	for idx, msg := range msgs {
+		payload, err := encodeValue(msg, EncodingProtobuf, logger)
+		if err != nil {
+			return written, err
+		}
+		bz, err := proto.Marshal(&types.Envelope{
+			SchemaVersion: SchemaVersion,
+			Height:        positions[idx].height,
+			Index:         positions[idx].index,
+			Key:           msg.Key,
+			Payload:       payload,
+		})
+		if err != nil {
+			return written, err
+		}
+		n, err := bw.Write(append(proto.EncodeVarint(uint64(len(bz))), bz...))
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, bw.Flush()
+}
+
+// ReadEnvelope reads the next length-prefixed envelope written by a protobuf encoded file or stdout sink.
+func ReadEnvelope(r *bufio.Reader) (*types.Envelope, error) {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	bz := make([]byte, size)
+	if _, err := io.ReadFull(r, bz); err != nil {
+		return nil, err
+	}
+	var envelope types.Envelope
+	if err := proto.Unmarshal(bz, &envelope); err != nil {
+		return nil, err
+	}
+	return &envelope, nil
+}
@@ -0,0 +1,330 @@
+package emitter
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/GeoDB-Limited/odin-core/hooks/common"
+	"github.com/GeoDB-Limited/odin-core/hooks/emitter/types"
+	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
+)
+
+func TestSchemaCoversEmittedKeys(t *testing.T) {
+	files, err := filepath.Glob("*.go")
+	require.NoError(t, err)
+	keyPattern := regexp.MustCompile(`(?:Write\(|Key:\s*)"([A-Z_]+)"`)
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		src, err := ioutil.ReadFile(file)
+		require.NoError(t, err)
+		for _, match := range keyPattern.FindAllSubmatch(src, -1) {
+			_, err := NewSchemaMessage(string(match[1]))
+			require.NoError(t, err, "emitted in %s", file)
+		}
+	}
+}
+
+func TestToSchemaMessage(t *testing.T) {
+	addr := sdk.AccAddress([]byte("address"))
+	pb, err := ToSchemaMessage(common.Message{Key: "SET_ACCOUNT", Value: common.JsDict{
+		"address": addr,
+		"balance": "10loki",
+	}})
+	require.NoError(t, err)
+	require.Equal(t, &types.SetAccount{Address: addr.String(), Balance: "10loki"}, pb)
+
+	pb, err = ToSchemaMessage(common.Message{Key: "NEW_REQUEST", Value: common.JsDict{
+		"id":             oracletypes.RequestID(1),
+		"tx_hash":        nil,
+		"calldata":       []byte{1, 2},
+		"ask_count":      2,
+		"resolve_status": oracletypes.RESOLVE_STATUS_SUCCESS,
+	}})
+	require.NoError(t, err)
+	require.Equal(t, &types.NewRequest{Id: 1, Calldata: []byte{1, 2}, AskCount: 2, ResolveStatus: 1}, pb)
+
+	// Partial updates keep track of which fields are set.
+	pb, err = ToSchemaMessage(common.Message{Key: "UPDATE_VALIDATOR", Value: common.JsDict{
+		"operator_address": "odinvaloper1",
+		"jailed":           false,
+	}})
+	require.NoError(t, err)
+	update := pb.(*types.UpdateValidator)
+	require.NotNil(t, update.Jailed)
+	require.False(t, update.Jailed.Value)
+	require.Nil(t, update.Tokens)
+
+	pb, err = ToSchemaMessage(common.Message{Key: "NEW_TRANSACTION", Value: common.JsDict{
+		"hash":    []byte{1},
+		"err_msg": nil,
+		"messages": []map[string]interface{}{
+			{"msg": "{}", "extra": common.JsDict{"id": 1, "name": "price"}},
+		},
+	}})
+	require.NoError(t, err)
+	tx := pb.(*types.NewTransaction)
+	require.Nil(t, tx.ErrMsg)
+	require.Len(t, tx.Messages, 1)
+	require.Equal(t, "price", tx.Messages[0].Extra.Fields["name"].GetStringValue())
+
+	_, err = ToSchemaMessage(common.Message{Key: "SET_ACCOUNT", Value: common.JsDict{"renamed": "x"}})
+	require.Error(t, err)
+	_, err = ToSchemaMessage(common.Message{Key: "SET_ACCOUNT", Value: common.JsDict{"balance": 10}})
+	require.Error(t, err)
+	_, err = ToSchemaMessage(common.Message{Key: "UNKNOWN", Value: common.JsDict{}})
+	require.Error(t, err)
+}
+
+// emittedSamples holds a sample value of every emitted message, built from the same Go types the hook writes.
+func emittedSamples() map[string][]common.JsDict {
+	acc := sdk.AccAddress([]byte("account"))
+	val := sdk.ValAddress([]byte("validator"))
+	txHash := []byte{1, 2, 3}
+	coins := sdk.NewCoins(sdk.NewInt64Coin("loki", 10))
+	now := time.Unix(1600000000, 0)
+	return map[string][]common.JsDict{
+		"COMMIT": {{"height": int64(5)}},
+		"NEW_BLOCK": {{
+			"height": int64(5), "timestamp": now.UnixNano(), "proposer": sdk.ConsAddress(val).String(),
+			"hash": txHash, "inflation": sdk.NewDecWithPrec(13, 2).String(), "supply": coins[0].String(),
+		}},
+		"NEW_TRANSACTION": {{
+			"hash": txHash, "block_height": int64(5), "gas_used": int64(100), "gas_limit": uint64(200),
+			"gas_fee": coins.String(), "err_msg": (*string)(nil), "sender": acc.String(), "success": true,
+			"memo": "memo", "messages": []map[string]interface{}{
+				{"msg": "{}", "extra": common.JsDict{"id": oracletypes.RequestID(1), "received_amount": "0"}},
+			},
+		}},
+		"SET_RELATED_TRANSACTION": {{"hash": txHash, "related_accounts": []string{acc.String()}}},
+		"SET_ACCOUNT":             {{"address": acc, "balance": coins.String()}, {"address": acc.String(), "balance": ""}},
+		"NEW_VALIDATOR_VOTE": {{
+			"consensus_address": sdk.ConsAddress(val).String(), "block_height": int64(4), "voted": true,
+		}},
+		"SET_VALIDATOR": {{
+			"operator_address": val.String(), "delegator_address": acc.String(),
+			"consensus_address": sdk.ConsAddress(val).String(), "consensus_pubkey": "odinvalconspub1",
+			"moniker": "moniker", "identity": "", "website": "", "details": "",
+			"commission_rate": sdk.NewDecWithPrec(1, 1).String(), "commission_max_rate": sdk.OneDec().String(),
+			"commission_max_change": sdk.NewDecWithPrec(1, 2).String(), "min_self_delegation": sdk.OneInt().String(),
+			"tokens": uint64(100), "jailed": false, "delegator_shares": sdk.NewDec(100).String(),
+			"current_reward": "0", "current_ratio": "0", "accumulated_commission": coins.String(),
+			"last_update": now.UnixNano(),
+		}},
+		"UPDATE_VALIDATOR": {
+			{"operator_address": val.String(), "current_reward": "1", "current_ratio": "2", "accumulated_commission": ""},
+			{"operator_address": val.String(), "tokens": uint64(1), "jailed": true},
+			{
+				"operator_address": val.String(), "tokens": uint64(1), "delegator_shares": sdk.NewDec(1).String(),
+				"current_reward": "1", "current_ratio": "2", "last_update": now.UnixNano(),
+			},
+			{"operator_address": val.String(), "status": true, "status_since": now.UnixNano()},
+		},
+		"SET_DELEGATION": {{
+			"delegator_address": acc, "operator_address": val, "shares": sdk.NewDec(1).String(), "last_ratio": "0",
+		}},
+		"UPDATE_DELEGATION": {{"delegator_address": acc, "operator_address": val, "last_ratio": "0"}},
+		"REMOVE_DELEGATION": {{"delegator_address": acc, "operator_address": val}},
+		"NEW_UNBONDING_DELEGATION": {
+			{
+				"delegator_address": acc.String(), "operator_address": val.String(),
+				"completion_time": now.UnixNano(), "amount": sdk.NewInt(10),
+			},
+			{
+				"delegator_address": acc.String(), "operator_address": val.String(), "creation_height": int64(5),
+				"completion_time": now.UnixNano(), "amount": "10",
+			},
+		},
+		"REMOVE_UNBONDING": {{"timestamp": now.UnixNano()}},
+		"NEW_REDELEGATION": {
+			{
+				"delegator_address": acc.String(), "operator_src_address": val.String(),
+				"operator_dst_address": val.String(), "completion_time": now.UnixNano(), "amount": sdk.NewInt(10),
+			},
+			{
+				"delegator_address": acc.String(), "operator_src_address": val.String(),
+				"operator_dst_address": val.String(), "completion_time": now.UnixNano(), "amount": sdk.NewDec(10).String(),
+			},
+		},
+		"REMOVE_REDELEGATION": {{"timestamp": now.UnixNano()}},
+		"NEW_PROPOSAL": {{
+			"id": uint64(1), "proposer": acc, "type": govtypes.ProposalTypeText, "title": "title",
+			"description": "description", "proposal_route": govtypes.RouterKey, "status": int(govtypes.StatusDepositPeriod),
+			"submit_time": now.UnixNano(), "deposit_end_time": now.UnixNano(), "total_deposit": coins.String(),
+			"voting_time": now.UnixNano(), "voting_end_time": now.UnixNano(),
+		}},
+		"UPDATE_PROPOSAL": {
+			{
+				"id": uint64(1), "status": int(govtypes.StatusVotingPeriod), "total_deposit": coins.String(),
+				"voting_time": now.UnixNano(), "voting_end_time": now.UnixNano(),
+			},
+			{"id": int64(1), "status": int(govtypes.StatusPassed)},
+		},
+		"SET_DEPOSIT": {{"proposal_id": uint64(1), "depositor": acc.String(), "amount": coins.String(), "tx_hash": txHash}},
+		"SET_VOTE": {{
+			"proposal_id": uint64(1), "voter": acc.String(), "answer": int(govtypes.OptionYes), "tx_hash": nil,
+		}},
+		"SET_DATA_SOURCE": {{
+			"id": oracletypes.DataSourceID(1), "name": "name", "description": "description", "owner": acc.String(),
+			"executable": []byte("#!/bin/sh"), "tx_hash": txHash,
+		}},
+		"SET_ORACLE_SCRIPT": {{
+			"id": oracletypes.OracleScriptID(1), "name": "name", "description": "description", "owner": acc.String(),
+			"schema": "{price:u64}", "codehash": "hash", "source_code_url": "https://example.com", "tx_hash": txHash,
+		}},
+		"NEW_REQUEST": {
+			{
+				"id": oracletypes.RequestID(1), "oracle_script_id": oracletypes.OracleScriptID(1),
+				"calldata": parseBytes(nil), "ask_count": 2, "min_count": uint64(1), "tx_hash": nil,
+				"client_id": "client", "resolve_status": oracletypes.RESOLVE_STATUS_OPEN, "execute_gas": uint64(1000),
+			},
+			{
+				"id": oracletypes.RequestID(1), "tx_hash": txHash, "oracle_script_id": oracletypes.OracleScriptID(1),
+				"calldata": []byte{1}, "ask_count": uint64(2), "min_count": uint64(1), "sender": acc.String(),
+				"client_id": "client", "resolve_status": oracletypes.RESOLVE_STATUS_OPEN,
+				"timestamp": now.UnixNano(), "prepare_gas": uint64(1000), "execute_gas": uint64(1000),
+			},
+		},
+		"UPDATE_REQUEST": {{
+			"id": oracletypes.RequestID(1), "request_time": now.Unix(), "resolve_time": now.Unix(),
+			"resolve_status": oracletypes.RESOLVE_STATUS_SUCCESS, "result": []byte{1},
+		}},
+		"NEW_RAW_REQUEST": {{
+			"request_id": oracletypes.RequestID(1), "external_id": oracletypes.ExternalID(1),
+			"data_source_id": oracletypes.DataSourceID(1), "calldata": []byte("calldata"),
+		}},
+		"NEW_VAL_REQUEST": {{"request_id": oracletypes.RequestID(1), "validator": val.String()}},
+		"NEW_REPORT": {{
+			"tx_hash": txHash, "request_id": oracletypes.RequestID(1), "validator": val.String(), "reporter": acc.String(),
+		}},
+		"NEW_RAW_REPORT": {{
+			"request_id": oracletypes.RequestID(1), "validator": val.String(), "external_id": oracletypes.ExternalID(1),
+			"data": []byte("data"), "exit_code": uint32(0),
+		}},
+		"SET_REPORTER":    {{"reporter": acc.String(), "validator": val.String()}},
+		"REMOVE_REPORTER": {{"reporter": acc.String(), "validator": val.String()}},
+		"SET_HISTORICAL_VALIDATOR_STATUS": {{
+			"operator_address": val, "status": true, "timestamp": now.UnixNano(),
+		}},
+		"NEW_PACKET": {{
+			"is_incoming": true, "block_height": int64(5), "src_channel": "channel-0", "src_port": "oracle",
+			"sequence": uint64(1), "dst_channel": "channel-1", "dst_port": "oracle", "type": "oracle request",
+			"data": common.JsDict{
+				"oracle_script_id": oracletypes.OracleScriptID(1), "calldata": []byte{1}, "ask_count": uint64(1),
+				"min_count": uint64(1), "client_id": "client",
+			},
+			"acknowledgement": common.JsDict{"success": true, "request_id": oracletypes.RequestID(1)},
+		}},
+		"SET_MINT_POOL":   {{"treasury_pool": coins.String()}},
+		"SET_MINT_VOLUME": {{"current_mint_volume": coins.String(), "max_mint_volume": coins.String()}},
+		"NEW_MINT":        {{"tx_hash": txHash, "sender": acc.String(), "amount": coins.String()}},
+		"NEW_TREASURY_WITHDRAWAL": {{
+			"tx_hash": txHash, "sender": acc.String(), "receiver": acc.String(), "amount": coins.String(),
+		}},
+		"SET_EXCHANGE_RATES": {{
+			"module": "coinswap", "initial_rate": sdk.OneDec().String(),
+			"rates": []common.JsDict{{"from": "loki", "to": "minigeo", "rate_multiplier": sdk.OneDec().String()}},
+		}},
+		"NEW_EXCHANGE": {{
+			"tx_hash": txHash, "module": "coinswap", "requester": acc.String(), "from": "loki", "to": "minigeo",
+			"amount": sdk.NewInt(10).String(), "received_amount": "0", "rate": nil,
+		}},
+		"SET_AUCTION_STATUS": {{
+			"pending": false, "start_threshold": coins.String(), "accumulated": coins.String(),
+			"last_update": now.UnixNano(), "last_update_block": int64(5),
+		}},
+	}
+}
+
+func TestEmittedSamplesMatchSchema(t *testing.T) {
+	samples := emittedSamples()
+	for key := range schemaTypes {
+		require.NotEmpty(t, samples[key], "no sample of %s", key)
+	}
+	for key, values := range samples {
+		for _, value := range values {
+			pb, err := ToSchemaMessage(common.Message{Key: key, Value: value})
+			require.NoError(t, err)
+			_, err = proto.Marshal(pb)
+			require.NoError(t, err)
+		}
+	}
+}
+
+func TestEncodeValueDropsUnknownFields(t *testing.T) {
+	var logs bytes.Buffer
+	logger := log.NewTMLogger(log.NewSyncWriter(&logs))
+	msg := common.Message{Key: "SET_ACCOUNT", Value: common.JsDict{"address": "odin1", "renamed": "x"}}
+	bz, err := encodeValue(msg, EncodingProtobuf, logger)
+	require.NoError(t, err)
+	var account types.SetAccount
+	require.NoError(t, account.Unmarshal(bz))
+	require.Equal(t, "odin1", account.Address)
+	require.Contains(t, logs.String(), "renamed")
+
+	_, err = encodeValue(common.Message{Key: "SET_ACCOUNT", Value: common.JsDict{"balance": 10}}, EncodingProtobuf, logger)
+	require.Error(t, err)
+}
+
+func TestMessagePositions(t *testing.T) {
+	positions, err := messagePositions(append(testBlock(4), testBlock(5)...))
+	require.NoError(t, err)
+	require.Equal(t, []position{{4, 0}, {4, 1}, {5, 0}, {5, 1}}, positions)
+
+	_, err = messagePositions(testBlock(4)[:1])
+	require.Error(t, err)
+}
+
+func TestKafkaMessages(t *testing.T) {
+	msgs, err := kafkaMessages(testBlock(7), EncodingProtobuf, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	require.Equal(t, "NEW_BLOCK", string(msgs[0].Key))
+	headers := make(map[string]string)
+	for _, header := range msgs[1].Headers {
+		headers[header.Key] = string(header.Value)
+	}
+	require.Equal(t, map[string]string{
+		HeaderSchemaVersion: "1",
+		HeaderEncoding:      "protobuf",
+		HeaderHeight:        "7",
+		HeaderIndex:         "1",
+	}, headers)
+	var commit types.Commit
+	require.NoError(t, commit.Unmarshal(msgs[1].Value))
+	require.Equal(t, int64(7), commit.Height)
+
+	msgs, err = kafkaMessages(testBlock(7), EncodingJSON, log.NewNopLogger())
+	require.NoError(t, err)
+	require.JSONEq(t, `{"height":7}`, string(msgs[1].Value))
+}
+
+func TestWriterSinkProtobuf(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewWriterSink(&buf, EncodingProtobuf, log.NewNopLogger())
+	require.NoError(t, sink.Write(append(testBlock(1), testBlock(2)...)))
+
+	reader := bufio.NewReader(&buf)
+	for _, expected := range []position{{1, 0}, {1, 1}, {2, 0}, {2, 1}} {
+		envelope, err := ReadEnvelope(reader)
+		require.NoError(t, err)
+		require.Equal(t, uint32(SchemaVersion), envelope.SchemaVersion)
+		require.Equal(t, expected.height, envelope.Height)
+		require.Equal(t, expected.index, envelope.Index)
+	}
+	_, err := ReadEnvelope(reader)
+	require.Error(t, err)
+}
@@ -0,0 +1,400 @@
+package emitter
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/GeoDB-Limited/odin-core/hooks/common"
+)
+
+const (
+	SchemeKafka  = "kafka"
+	SchemeFile   = "file"
+	SchemeStdout = "stdout"
+	SchemeMemory = "memory"
+
+	// DefaultMaxFileBytes is the size after which the file sink starts a new file.
+	DefaultMaxFileBytes = 100 * 1024 * 1024
+
+	// Headers of every Kafka message.
+	HeaderSchemaVersion = "schema-version"
+	HeaderEncoding      = "encoding"
+	HeaderHeight        = "height"
+	HeaderIndex         = "index"
+)
+
+// Sink receives the messages emitted during a block as a single ordered batch. Write must not return before
+// the batch is handed over, since the block is committed right after.
+type Sink interface {
+	Write(msgs []common.Message) error
+	Close() error
+}
+
+// NewSink creates the sink selected by the scheme of the given URI:
+//
+//	kafka://broker1:9092,broker2:9092/topic
+//	file:///path/to/blocks.ndjson?max-bytes=104857600
+//	stdout://
+//	memory://
+//
+// Kafka, file and stdout sinks accept an encoding query parameter, either json (the default) or protobuf, e.g.
+// kafka://localhost:9092/topic?encoding=protobuf. URIs without a scheme use the legacy topic@broker1@broker2
+// Kafka format.
+func NewSink(uri string, logger log.Logger) (Sink, error) {
+	if !strings.Contains(uri, "://") {
+		paths := strings.Split(uri, "@")
+		if len(paths) < 2 {
+			return nil, fmt.Errorf("invalid emitter URI %q, expected topic@broker", uri)
+		}
+		return NewKafkaSink(paths[0], paths[1:], EncodingJSON, logger), nil
+	}
+
+	parts := strings.SplitN(uri, "://", 2)
+	scheme, rest := parts[0], parts[1]
+	var query url.Values
+	if idx := strings.Index(rest, "?"); idx >= 0 {
+		var err error
+		query, err = url.ParseQuery(rest[idx+1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid emitter URI %q: %w", uri, err)
+		}
+		rest = rest[:idx]
+	}
+	encoding, err := ParseEncoding(query.Get("encoding"))
+	if err != nil {
+		return nil, err
+	}
+	switch scheme {
+	case SchemeKafka:
+		target := strings.SplitN(rest, "/", 2)
+		if len(target) != 2 || target[0] == "" || target[1] == "" {
+			return nil, fmt.Errorf("invalid kafka URI %q, expected kafka://brokers/topic", uri)
+		}
+		return NewKafkaSink(target[1], strings.Split(target[0], ","), encoding, logger), nil
+	case SchemeFile:
+		maxBytes := int64(DefaultMaxFileBytes)
+		if value := query.Get("max-bytes"); value != "" {
+			maxBytes, err = strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid max-bytes: %w", err)
+			}
+		}
+		return NewFileSink(rest, maxBytes, encoding, logger)
+	case SchemeStdout:
+		return NewWriterSink(os.Stdout, encoding, logger), nil
+	case SchemeMemory:
+		return NewMemorySink(), nil
+	default:
+		return nil, fmt.Errorf("unknown emitter scheme %q", scheme)
+	}
+}
+
+// encodedMessage is the representation of a message in NDJSON sinks.
+type encodedMessage struct {
+	Key   string        `json:"key"`
+	Value common.JsDict `json:"value"`
+}
+
+// KafkaSink publishes messages to a Kafka topic. The message key is the message kind, and headers carry the
+// schema version, encoding, block height and index of the message within its block, so that consumers can
+// validate and deduplicate messages.
+type KafkaSink struct {
+	writer   *kafka.Writer
+	encoding Encoding
+	logger   log.Logger
+}
+
+// NewKafkaSink creates a new KafkaSink instance publishing to the topic on the given brokers.
+func NewKafkaSink(topic string, brokers []string, encoding Encoding, logger log.Logger) *KafkaSink {
+	return &KafkaSink{
+		writer: kafka.NewWriter(kafka.WriterConfig{
+			Brokers:      brokers,
+			Topic:        topic,
+			Balancer:     &kafka.LeastBytes{},
+			BatchTimeout: 1 * time.Millisecond,
+			// Async:    true, // TODO: We may be able to enable async mode on replay
+		}),
+		encoding: encoding,
+		logger:   logger,
+	}
+}
+
+func kafkaMessages(msgs []common.Message, encoding Encoding, logger log.Logger) ([]kafka.Message, error) {
+	positions, err := messagePositions(msgs)
+	if err != nil {
+		return nil, err
+	}
+	kafkaMsgs := make([]kafka.Message, len(msgs))
+	for idx, msg := range msgs {
+		value, err := encodeValue(msg, encoding, logger)
+		if err != nil {
+			return nil, err
+		}
+		kafkaMsgs[idx] = kafka.Message{
+			Key:   []byte(msg.Key),
+			Value: value,
+			Headers: []kafka.Header{
+				{Key: HeaderSchemaVersion, Value: []byte(strconv.Itoa(SchemaVersion))},
+				{Key: HeaderEncoding, Value: []byte(encoding)},
+				{Key: HeaderHeight, Value: []byte(strconv.FormatInt(positions[idx].height, 10))},
+				{Key: HeaderIndex, Value: []byte(strconv.FormatUint(uint64(positions[idx].index), 10))},
+			},
+		}
+	}
+	return kafkaMsgs, nil
+}
+
+// Write implements Sink.
+func (s *KafkaSink) Write(msgs []common.Message) error {
+	kafkaMsgs, err := kafkaMessages(msgs, s.encoding, s.logger)
+	if err != nil {
+		return err
+	}
+	return s.writer.WriteMessages(context.Background(), kafkaMsgs...)
+}
+
+// Close implements Sink.
+func (s *KafkaSink) Close() error {
+	return s.writer.Close()
+}
+
+// WriterSink writes messages to an io.Writer, either as newline-delimited JSON or as length-prefixed protobuf
+// envelopes.
+type WriterSink struct {
+	w        io.Writer
+	encoding Encoding
+	logger   log.Logger
+}
+
+// NewWriterSink creates a new WriterSink instance writing to w.
+func NewWriterSink(w io.Writer, encoding Encoding, logger log.Logger) *WriterSink {
+	return &WriterSink{w: w, encoding: encoding, logger: logger}
+}
+
+// Write implements Sink.
+func (s *WriterSink) Write(msgs []common.Message) error {
+	_, err := writeMessages(s.w, msgs, s.encoding, s.logger)
+	return err
+}
+
+// Close implements Sink.
+func (s *WriterSink) Close() error {
+	return nil
+}
+
+// writeMessages writes msgs to w with the given encoding and returns the number of bytes written.
+func writeMessages(w io.Writer, msgs []common.Message, encoding Encoding, logger log.Logger) (int64, error) {
+	if encoding == EncodingProtobuf {
+		return writeEnvelopes(w, msgs, logger)
+	}
+	return writeNDJSON(w, msgs)
+}
+
+func writeNDJSON(w io.Writer, msgs []common.Message) (int64, error) {
+	bw := bufio.NewWriter(w)
+	var written int64
+	for _, msg := range msgs {
+		line, err := json.Marshal(encodedMessage{Key: msg.Key, Value: msg.Value})
+		if err != nil {
+			return written, err
+		}
+		n, err := bw.Write(append(line, '\n'))
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, bw.Flush()
+}
+
+// FileSink appends messages to a file, either as newline-delimited JSON or as length-prefixed protobuf
+// envelopes. Once the file grows beyond maxBytes, it is renamed with a timestamp suffix and a new file is
+// started. Rotation only happens between blocks, so every file contains whole blocks.
+type FileSink struct {
+	path     string
+	maxBytes int64
+	encoding Encoding
+	logger   log.Logger
+	file     *os.File
+	size     int64
+}
+
+// NewFileSink creates a new FileSink instance appending to the file at path.
+func NewFileSink(path string, maxBytes int64, encoding Encoding, logger log.Logger) (*FileSink, error) {
+	if path == "" {
+		return nil, fmt.Errorf("file sink requires a path")
+	}
+	s := &FileSink{path: path, maxBytes: maxBytes, encoding: encoding, logger: logger}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *FileSink) open() error {
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	s.file, s.size = file, info.Size()
+	return nil
+}
+
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(s.path, fmt.Sprintf("%s.%d", s.path, time.Now().UnixNano())); err != nil {
+		return err
+	}
+	return s.open()
+}
+
+// Write implements Sink.
+func (s *FileSink) Write(msgs []common.Message) error {
+	if s.maxBytes > 0 && s.size >= s.maxBytes {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := writeMessages(s.file, msgs, s.encoding, s.logger)
+	s.size += n
+	if err != nil {
+		return err
+	}
+	return s.file.Sync()
+}
+
+// Close implements Sink.
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
+
+// MemorySink keeps all written batches in memory. It is meant for tests.
+type MemorySink struct {
+	mtx     sync.Mutex
+	batches [][]common.Message
+}
+
+// NewMemorySink creates a new empty MemorySink instance.
+func NewMemorySink() *MemorySink {
+	return &MemorySink{}
+}
+
+// Write implements Sink.
+func (s *MemorySink) Write(msgs []common.Message) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	batch := make([]common.Message, len(msgs))
+	copy(batch, msgs)
+	s.batches = append(s.batches, batch)
+	return nil
+}
+
+// Close implements Sink.
+func (s *MemorySink) Close() error {
+	return nil
+}
+
+// Batches returns all batches written so far.
+func (s *MemorySink) Batches() [][]common.Message {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.batches
+}
+
+// AsyncSink writes batches to another sink in the background. Batches queued while a write is in progress are
+// merged into a single write of up to maxMerge batches. A write error is returned by the next Write or Close
+// and all later batches are dropped.
+type AsyncSink struct {
+	sink     Sink
+	maxMerge int
+	batches  chan []common.Message
+	done     chan struct{}
+	mtx      sync.Mutex
+	err      error
+}
+
+// NewAsyncSink creates a new AsyncSink instance queueing up to bufferSize batches in front of sink.
+func NewAsyncSink(sink Sink, bufferSize int, maxMerge int) *AsyncSink {
+	s := &AsyncSink{
+		sink:     sink,
+		maxMerge: maxMerge,
+		batches:  make(chan []common.Message, bufferSize),
+		done:     make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+func (s *AsyncSink) run() {
+	defer close(s.done)
+	for batch := range s.batches {
+		merged := batch
+	Merge:
+		for count := 1; count < s.maxMerge; count++ {
+			select {
+			case next, ok := <-s.batches:
+				if !ok {
+					break Merge
+				}
+				merged = append(merged, next...)
+			default:
+				break Merge
+			}
+		}
+		if s.Err() != nil {
+			continue
+		}
+		if err := s.sink.Write(merged); err != nil {
+			s.mtx.Lock()
+			s.err = err
+			s.mtx.Unlock()
+		}
+	}
+}
+
+// Err returns the first error returned by the underlying sink.
+func (s *AsyncSink) Err() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.err
+}
+
+// Write implements Sink. It blocks only when the queue is full.
+func (s *AsyncSink) Write(msgs []common.Message) error {
+	if err := s.Err(); err != nil {
+		return err
+	}
+	batch := make([]common.Message, len(msgs))
+	copy(batch, msgs)
+	s.batches <- batch
+	return nil
+}
+
+// Close implements Sink. It waits for all queued batches to be written before closing the underlying sink.
+func (s *AsyncSink) Close() error {
+	close(s.batches)
+	<-s.done
+	if err := s.sink.Close(); err != nil && s.Err() == nil {
+		return err
+	}
+	return s.Err()
+}
@@ -0,0 +1,97 @@
+package emitter
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/GeoDB-Limited/odin-core/hooks/common"
+)
+
+func readNDJSON(t *testing.T, path string) []encodedMessage {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	var msgs []encodedMessage
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var msg encodedMessage
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &msg))
+		msgs = append(msgs, msg)
+	}
+	require.NoError(t, scanner.Err())
+	return msgs
+}
+
+func TestNewSink(t *testing.T) {
+	sink, err := NewSink("topic@localhost:9092", log.NewNopLogger())
+	require.NoError(t, err)
+	require.IsType(t, &KafkaSink{}, sink)
+	sink, err = NewSink("kafka://localhost:9092,localhost:9093/topic", log.NewNopLogger())
+	require.NoError(t, err)
+	require.IsType(t, &KafkaSink{}, sink)
+	sink, err = NewSink("kafka://localhost:9092/topic?encoding=protobuf", log.NewNopLogger())
+	require.NoError(t, err)
+	require.Equal(t, EncodingProtobuf, sink.(*KafkaSink).encoding)
+	sink, err = NewSink("stdout://", log.NewNopLogger())
+	require.NoError(t, err)
+	require.IsType(t, &WriterSink{}, sink)
+	sink, err = NewSink("memory://", log.NewNopLogger())
+	require.NoError(t, err)
+	require.IsType(t, &MemorySink{}, sink)
+	sink, err = NewSink("file://"+filepath.Join(t.TempDir(), "blocks.ndjson"), log.NewNopLogger())
+	require.NoError(t, err)
+	require.IsType(t, &FileSink{}, sink)
+	require.NoError(t, sink.Close())
+
+	_, err = NewSink("topic", log.NewNopLogger())
+	require.Error(t, err)
+	_, err = NewSink("kafka://localhost:9092", log.NewNopLogger())
+	require.Error(t, err)
+	_, err = NewSink("stdout://?encoding=xml", log.NewNopLogger())
+	require.Error(t, err)
+	_, err = NewSink("redis://localhost", log.NewNopLogger())
+	require.Error(t, err)
+}
+
+func TestFileSinkRotation(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "blocks.ndjson")
+	sink, err := NewFileSink(path, 1, EncodingJSON, log.NewNopLogger())
+	require.NoError(t, err)
+
+	block := func(height int64) []common.Message {
+		return []common.Message{
+			{Key: "NEW_BLOCK", Value: common.JsDict{"height": height}},
+			{Key: "COMMIT", Value: common.JsDict{"height": height}},
+		}
+	}
+	require.NoError(t, sink.Write(block(1)))
+	require.NoError(t, sink.Write(block(2)))
+	require.NoError(t, sink.Close())
+
+	// The second block does not fit in the first file and starts a new one.
+	rotated, err := filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	require.Len(t, rotated, 1)
+	first := readNDJSON(t, rotated[0])
+	require.Len(t, first, 2)
+	require.Equal(t, "NEW_BLOCK", first[0].Key)
+	require.Equal(t, float64(1), first[1].Value["height"])
+	second := readNDJSON(t, path)
+	require.Len(t, second, 2)
+	require.Equal(t, float64(2), second[1].Value["height"])
+}
+
+func TestMemorySink(t *testing.T) {
+	sink := NewMemorySink()
+	msgs := []common.Message{{Key: "COMMIT", Value: common.JsDict{"height": 1}}}
+	require.NoError(t, sink.Write(msgs))
+	msgs[0].Key = "CHANGED"
+	require.Equal(t, [][]common.Message{{{Key: "COMMIT", Value: common.JsDict{"height": 1}}}}, sink.Batches())
+}
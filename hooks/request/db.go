@@ -2,41 +2,154 @@ package request
 
 import (
 	"encoding/hex"
+
 	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
 	sq "github.com/Masterminds/squirrel"
 )
 
 const (
-	requestsTable = "requests"
+	requestsTable   = "requests"
+	reportsTable    = "reports"
+	rawReportsTable = "raw_reports"
 )
 
+// Request is a row of the requests table. Binary fields are stored hex encoded.
 type Request struct {
 	RequestID      oracletypes.RequestID      `db:"request_id, primarykey" json:"request_id"`
 	OracleScriptID oracletypes.OracleScriptID `db:"oracle_script_id" json:"oracle_script_id"`
 	Calldata       string                     `db:"calldata" json:"calldata"`
 	MinCount       uint64                     `db:"min_count" json:"min_count"`
 	AskCount       uint64                     `db:"ask_count" json:"ask_count"`
+	AnsCount       uint64                     `db:"ans_count" json:"ans_count"`
+	ClientID       string                     `db:"client_id" json:"client_id"`
+	Requester      string                     `db:"requester" json:"requester"`
+	RequestHeight  int64                      `db:"request_height" json:"request_height"`
+	RequestTime    int64                      `db:"request_time" json:"request_time"`
 	ResolveTime    int64                      `db:"resolve_time" json:"resolve_time"`
+	ResolveStatus  oracletypes.ResolveStatus  `db:"resolve_status" json:"resolve_status"`
+	Result         string                     `db:"result" json:"result"`
+}
+
+// Report is a row of the reports table.
+type Report struct {
+	RequestID       oracletypes.RequestID `db:"request_id" json:"request_id"`
+	Validator       string                `db:"validator" json:"validator"`
+	InBeforeResolve bool                  `db:"in_before_resolve" json:"in_before_resolve"`
+	ReportHeight    int64                 `db:"report_height" json:"report_height"`
+}
+
+// RawReport is a row of the raw_reports table.
+type RawReport struct {
+	RequestID  oracletypes.RequestID  `db:"request_id" json:"request_id"`
+	Validator  string                 `db:"validator" json:"validator"`
+	ExternalID oracletypes.ExternalID `db:"external_id" json:"external_id"`
+	ExitCode   uint32                 `db:"exit_code" json:"exit_code"`
+	Data       string                 `db:"data" json:"data"`
 }
 
-func (h *Hook) insertRequest(requestID oracletypes.RequestID, oracleScriptID oracletypes.OracleScriptID, calldata []byte, askCount uint64, minCount uint64, resolveTime int64) {
+func (h *Hook) insertRequest(id oracletypes.RequestID, req oracletypes.Request, requester string) {
 	err := h.trans.Insert(&Request{
-		RequestID:      requestID,
-		OracleScriptID: oracleScriptID,
-		Calldata:       hex.EncodeToString(calldata),
-		MinCount:       minCount,
-		AskCount:       askCount,
-		ResolveTime:    resolveTime,
+		RequestID:      id,
+		OracleScriptID: req.OracleScriptID,
+		Calldata:       hex.EncodeToString(req.Calldata),
+		MinCount:       req.MinCount,
+		AskCount:       uint64(len(req.RequestedValidators)),
+		ClientID:       req.ClientID,
+		Requester:      requester,
+		RequestHeight:  req.RequestHeight,
+		RequestTime:    int64(req.RequestTime),
+		ResolveStatus:  oracletypes.RESOLVE_STATUS_OPEN,
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// updateResult stores the result of a resolved request. Requests that were not seen when created, such as the
+// ones created before the hook was enabled, are inserted without a requester.
+func (h *Hook) updateResult(req oracletypes.Request, result oracletypes.Result) {
+	row := &Request{
+		RequestID:      result.RequestID,
+		OracleScriptID: result.OracleScriptID,
+		Calldata:       hex.EncodeToString(result.Calldata),
+		MinCount:       result.MinCount,
+		AskCount:       result.AskCount,
+		AnsCount:       result.AnsCount,
+		ClientID:       result.ClientID,
+		RequestHeight:  req.RequestHeight,
+		RequestTime:    result.RequestTime,
+		ResolveTime:    result.ResolveTime,
+		ResolveStatus:  result.ResolveStatus,
+		Result:         hex.EncodeToString(result.Result),
+	}
+	existing, err := h.trans.Get(Request{}, result.RequestID)
+	if err != nil {
+		panic(err)
+	}
+	if existing == nil {
+		err = h.trans.Insert(row)
+	} else {
+		row.Requester = existing.(*Request).Requester
+		_, err = h.trans.Update(row)
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (h *Hook) insertReport(rid oracletypes.RequestID, report oracletypes.Report, height int64) {
+	err := h.trans.Insert(&Report{
+		RequestID:       rid,
+		Validator:       report.Validator,
+		InBeforeResolve: report.InBeforeResolve,
+		ReportHeight:    height,
 	})
 	if err != nil {
 		panic(err)
 	}
+	for _, raw := range report.RawReports {
+		err := h.trans.Insert(&RawReport{
+			RequestID:  rid,
+			Validator:  report.Validator,
+			ExternalID: raw.ExternalID,
+			ExitCode:   raw.ExitCode,
+			Data:       hex.EncodeToString(raw.Data),
+		})
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// pruneRequests removes requests created before the given unix time along with their reports.
+func (h *Hook) pruneRequests(before int64) {
+	expired := sq.Select("request_id").From(requestsTable).Where(sq.Lt{"request_time": before})
+	for _, table := range []string{rawReportsTable, reportsTable} {
+		sql, args, err := sq.Delete(table).
+			Where(expired.Prefix("request_id IN (").Suffix(")")).
+			PlaceholderFormat(h.placeholder).ToSql()
+		if err != nil {
+			panic(err)
+		}
+		if _, err := h.trans.Exec(sql, args...); err != nil {
+			panic(err)
+		}
+	}
+	sql, args, err := sq.Delete(requestsTable).Where(sq.Lt{"request_time": before}).
+		PlaceholderFormat(h.placeholder).ToSql()
+	if err != nil {
+		panic(err)
+	}
+	if _, err := h.trans.Exec(sql, args...); err != nil {
+		panic(err)
+	}
 }
 
 func (h *Hook) getMultiRequestID(requestSearchRequest oracletypes.QueryRequestSearchRequest, limit int64) oracletypes.QueryRequestIDs {
 
 	requestsSql := sq.Select("*").From(requestsTable)
-	conditionsSql := make([]sq.Sqlizer, 0, 4)
+	conditionsSql := make([]sq.Sqlizer, 0, 5)
+	conditionsSql = append(conditionsSql, sq.Eq{"resolve_status": oracletypes.RESOLVE_STATUS_SUCCESS})
 	if requestSearchRequest.OracleScriptId != 0 {
 		conditionsSql = append(conditionsSql, sq.Eq{"oracle_script_id": requestSearchRequest.OracleScriptId})
 	}
@@ -50,7 +163,7 @@ func (h *Hook) getMultiRequestID(requestSearchRequest oracletypes.QueryRequestSe
 		conditionsSql = append(conditionsSql, sq.Eq{"ask_count": requestSearchRequest.AskCount})
 	}
 	requestsSql = requestsSql.Where(sq.And(conditionsSql)).OrderBy("resolve_time").Limit(uint64(limit))
-	rawRequestsSql, args, err := requestsSql.PlaceholderFormat(sq.Dollar).ToSql()
+	rawRequestsSql, args, err := requestsSql.PlaceholderFormat(h.placeholder).ToSql()
 	if err != nil {
 		panic(err)
 	}
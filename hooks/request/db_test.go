@@ -0,0 +1,151 @@
+package request
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
+)
+
+func newTestHook(t *testing.T) *Hook {
+	dbMap, placeholder := initDb("sqlite3://" + filepath.Join(t.TempDir(), "requests.db"))
+	t.Cleanup(func() { dbMap.Db.Close() })
+	return &Hook{dbMap: dbMap, placeholder: placeholder}
+}
+
+func (h *Hook) begin(t *testing.T) {
+	trans, err := h.dbMap.Begin()
+	require.NoError(t, err)
+	h.trans = trans
+}
+
+func testRequest(clientID string, requestTime uint64) oracletypes.Request {
+	return oracletypes.Request{
+		OracleScriptID:      1,
+		Calldata:            []byte{0xbe, 0xef},
+		RequestedValidators: []string{"val1", "val2"},
+		MinCount:            1,
+		RequestHeight:       10,
+		RequestTime:         requestTime,
+		ClientID:            clientID,
+	}
+}
+
+func TestRequestHistory(t *testing.T) {
+	h := newTestHook(t)
+	h.begin(t)
+	h.insertRequest(1, testRequest("alice", 100), "odin1alice")
+	h.insertRequest(2, testRequest("bob", 200), "odin1bob")
+	h.insertReport(1, oracletypes.Report{
+		Validator:       "val1",
+		InBeforeResolve: true,
+		RawReports:      []oracletypes.RawReport{{ExternalID: 1, Data: []byte("42")}, {ExternalID: 2, ExitCode: 1}},
+	}, 11)
+	h.updateResult(testRequest("alice", 100), oracletypes.Result{
+		ClientID:       "alice",
+		OracleScriptID: 1,
+		Calldata:       []byte{0xbe, 0xef},
+		AskCount:       2,
+		MinCount:       1,
+		RequestID:      1,
+		AnsCount:       1,
+		RequestTime:    100,
+		ResolveTime:    105,
+		ResolveStatus:  oracletypes.RESOLVE_STATUS_SUCCESS,
+		Result:         []byte{0x01},
+	})
+	// A request created before the hook was enabled is inserted when resolved.
+	h.updateResult(testRequest("carol", 50), oracletypes.Result{
+		ClientID:      "carol",
+		RequestID:     3,
+		RequestTime:   50,
+		ResolveStatus: oracletypes.RESOLVE_STATUS_EXPIRED,
+	})
+	require.NoError(t, h.trans.Commit())
+
+	records, err := h.queryRequests(RequestQuery{OracleScriptID: 1, Calldata: "beef"})
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, oracletypes.RequestID(2), records[0].RequestID)
+	require.Equal(t, oracletypes.RESOLVE_STATUS_OPEN, records[0].ResolveStatus)
+	require.Empty(t, records[0].Reports)
+
+	alice := records[1]
+	require.Equal(t, "odin1alice", alice.Requester)
+	require.Equal(t, oracletypes.RESOLVE_STATUS_SUCCESS, alice.ResolveStatus)
+	require.Equal(t, "01", alice.Result)
+	require.Equal(t, uint64(1), alice.AnsCount)
+	require.Len(t, alice.Reports, 1)
+	require.Len(t, alice.Reports[0].RawReports, 2)
+	require.Equal(t, "3432", alice.Reports[0].RawReports[0].Data)
+	require.Equal(t, uint32(1), alice.Reports[0].RawReports[1].ExitCode)
+
+	records, err = h.queryRequests(RequestQuery{OracleScriptID: 1, Calldata: "beef", FromTime: 150})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, oracletypes.RequestID(2), records[0].RequestID)
+
+	records, err = h.queryRequests(RequestQuery{ClientID: "carol"})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "", records[0].Requester)
+
+	records, err = h.queryRequests(RequestQuery{Requester: "odin1bob", Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+
+	// Only successful requests are returned by the request search.
+	ids := h.getMultiRequestID(oracletypes.QueryRequestSearchRequest{OracleScriptId: 1}, 10)
+	require.Equal(t, []int64{1}, ids.RequestIds)
+
+	h.begin(t)
+	h.pruneRequests(150)
+	require.NoError(t, h.trans.Commit())
+	records, err = h.queryRequests(RequestQuery{})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, oracletypes.RequestID(2), records[0].RequestID)
+	count, err := h.dbMap.SelectInt("SELECT COUNT(*) FROM " + rawReportsTable)
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestMigrateRequestsTable(t *testing.T) {
+	path := "sqlite3://" + filepath.Join(t.TempDir(), "requests.db")
+	// The requests table as created by the first version of the hook.
+	dbMap, _ := initDb(path)
+	_, err := dbMap.Exec("DROP TABLE " + requestsTable)
+	require.NoError(t, err)
+	_, err = dbMap.Exec(`CREATE TABLE requests (request_id integer not null primary key, oracle_script_id integer,
+		calldata varchar(255), min_count integer, ask_count integer, resolve_time integer)`)
+	require.NoError(t, err)
+	_, err = dbMap.Exec("INSERT INTO requests VALUES (1, 1, 'beef', 1, 2, 105)")
+	require.NoError(t, err)
+	require.NoError(t, dbMap.Db.Close())
+
+	dbMap, placeholder := initDb(path)
+	t.Cleanup(func() { dbMap.Db.Close() })
+	h := &Hook{dbMap: dbMap, placeholder: placeholder}
+	records, err := h.queryRequests(RequestQuery{OracleScriptID: 1, Calldata: "beef"})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, oracletypes.RESOLVE_STATUS_SUCCESS, records[0].ResolveStatus)
+	require.Equal(t, int64(105), records[0].RequestTime)
+	require.Equal(t, "", records[0].ClientID)
+	ids := h.getMultiRequestID(oracletypes.QueryRequestSearchRequest{OracleScriptId: 1}, 10)
+	require.Equal(t, []int64{1}, ids.RequestIds)
+
+	// Running the migration again keeps the table as is.
+	dbMap, _ = initDb(path)
+	require.NoError(t, dbMap.Db.Close())
+}
+
+func TestRequestQueryValidate(t *testing.T) {
+	require.Error(t, RequestQuery{Calldata: "beef"}.validate(QueryRequestHistory))
+	require.NoError(t, RequestQuery{OracleScriptID: 1, Calldata: "beef"}.validate(QueryRequestHistory))
+	require.Error(t, RequestQuery{}.validate(QueryRequestsByClientID))
+	require.Error(t, RequestQuery{}.validate(QueryRequestsByRequester))
+	require.Error(t, RequestQuery{Requester: "odin1", Limit: MaxQueryLimit + 1}.validate(QueryRequestsByRequester))
+}
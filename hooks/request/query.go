@@ -0,0 +1,166 @@
+package request
+
+import (
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
+)
+
+const (
+	// QueryRequestHistory queries the requests of an oracle script with the given calldata.
+	QueryRequestHistory = "request_history"
+	// QueryRequestsByClientID queries the requests with the given client ID.
+	QueryRequestsByClientID = "requests_by_client_id"
+	// QueryRequestsByRequester queries the requests sent by the given account.
+	QueryRequestsByRequester = "requests_by_requester"
+
+	DefaultQueryLimit = 100
+	MaxQueryLimit     = 1000
+)
+
+// RequestQuery holds the filters of the request history queries, passed as JSON query data. Zero values match
+// all requests. Times are unix timestamps in seconds and filter on the request time, both ends inclusive.
+type RequestQuery struct {
+	OracleScriptID oracletypes.OracleScriptID `json:"oracle_script_id"`
+	// Calldata is the hex encoded calldata.
+	Calldata  string `json:"calldata"`
+	AskCount  uint64 `json:"ask_count"`
+	MinCount  uint64 `json:"min_count"`
+	ClientID  string `json:"client_id"`
+	Requester string `json:"requester"`
+	FromTime  int64  `json:"from_time"`
+	ToTime    int64  `json:"to_time"`
+	Limit     uint64 `json:"limit"`
+	Offset    uint64 `json:"offset"`
+}
+
+// RequestRecord is a stored request along with its reports, as returned by the request history queries.
+type RequestRecord struct {
+	Request
+	Reports []ReportRecord `json:"reports"`
+}
+
+// ReportRecord is a stored report along with its raw reports.
+type ReportRecord struct {
+	Report
+	RawReports []RawReport `json:"raw_reports"`
+}
+
+// validate checks that the filters required by the given query path are set.
+func (q RequestQuery) validate(path string) error {
+	switch path {
+	case QueryRequestHistory:
+		if q.OracleScriptID == 0 || q.Calldata == "" {
+			return fmt.Errorf("oracle_script_id and calldata are required")
+		}
+	case QueryRequestsByClientID:
+		if q.ClientID == "" {
+			return fmt.Errorf("client_id is required")
+		}
+	case QueryRequestsByRequester:
+		if q.Requester == "" {
+			return fmt.Errorf("requester is required")
+		}
+	}
+	if q.Limit > MaxQueryLimit {
+		return fmt.Errorf("limit must not exceed %d", MaxQueryLimit)
+	}
+	return nil
+}
+
+func (q RequestQuery) conditions() sq.And {
+	conditions := sq.And{}
+	if q.OracleScriptID != 0 {
+		conditions = append(conditions, sq.Eq{"oracle_script_id": q.OracleScriptID})
+	}
+	if q.Calldata != "" {
+		conditions = append(conditions, sq.Eq{"calldata": q.Calldata})
+	}
+	if q.AskCount != 0 {
+		conditions = append(conditions, sq.Eq{"ask_count": q.AskCount})
+	}
+	if q.MinCount != 0 {
+		conditions = append(conditions, sq.Eq{"min_count": q.MinCount})
+	}
+	if q.ClientID != "" {
+		conditions = append(conditions, sq.Eq{"client_id": q.ClientID})
+	}
+	if q.Requester != "" {
+		conditions = append(conditions, sq.Eq{"requester": q.Requester})
+	}
+	if q.FromTime != 0 {
+		conditions = append(conditions, sq.GtOrEq{"request_time": q.FromTime})
+	}
+	if q.ToTime != 0 {
+		conditions = append(conditions, sq.LtOrEq{"request_time": q.ToTime})
+	}
+	return conditions
+}
+
+// queryRequests returns the requests matching q, newest first, along with their reports.
+func (h *Hook) queryRequests(q RequestQuery) ([]RequestRecord, error) {
+	limit := q.Limit
+	if limit == 0 {
+		limit = DefaultQueryLimit
+	}
+	sql, args, err := sq.Select("*").From(requestsTable).Where(q.conditions()).
+		OrderBy("request_id DESC").Limit(limit).Offset(q.Offset).
+		PlaceholderFormat(h.placeholder).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var requests []Request
+	if _, err := h.dbMap.Select(&requests, sql, args...); err != nil {
+		return nil, err
+	}
+	records := make([]RequestRecord, len(requests))
+	if len(requests) == 0 {
+		return records, nil
+	}
+	ids := make([]oracletypes.RequestID, len(requests))
+	for idx, req := range requests {
+		ids[idx] = req.RequestID
+	}
+
+	sql, args, err = sq.Select("*").From(reportsTable).Where(sq.Eq{"request_id": ids}).
+		OrderBy("request_id", "validator").PlaceholderFormat(h.placeholder).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var reports []Report
+	if _, err := h.dbMap.Select(&reports, sql, args...); err != nil {
+		return nil, err
+	}
+	sql, args, err = sq.Select("*").From(rawReportsTable).Where(sq.Eq{"request_id": ids}).
+		OrderBy("request_id", "validator", "external_id").PlaceholderFormat(h.placeholder).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var rawReports []RawReport
+	if _, err := h.dbMap.Select(&rawReports, sql, args...); err != nil {
+		return nil, err
+	}
+
+	type reportKey struct {
+		rid       oracletypes.RequestID
+		validator string
+	}
+	rawByReport := make(map[reportKey][]RawReport)
+	for _, raw := range rawReports {
+		key := reportKey{raw.RequestID, raw.Validator}
+		rawByReport[key] = append(rawByReport[key], raw)
+	}
+	reportsByRequest := make(map[oracletypes.RequestID][]ReportRecord)
+	for _, report := range reports {
+		reportsByRequest[report.RequestID] = append(reportsByRequest[report.RequestID], ReportRecord{
+			Report:     report,
+			RawReports: rawByReport[reportKey{report.RequestID, report.Validator}],
+		})
+	}
+	for idx, req := range requests {
+		records[idx] = RequestRecord{Request: req, Reports: reportsByRequest[req.RequestID]}
+	}
+	return records, nil
+}
@@ -2,10 +2,15 @@ package request
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
-	"github.com/lib/pq"
+	"reflect"
 	"strings"
+	"time"
+
+	"github.com/lib/pq"
 
+	sq "github.com/Masterminds/squirrel"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/go-gorp/gorp"
@@ -22,12 +27,16 @@ import (
 	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
 )
 
-// Hook inherits from Odin app hook to save latest request into SQL database.
+// Hook inherits from Odin app hook to save requests along with their results and reports into SQL database.
 type Hook struct {
 	cdc          codec.JSONCodec
+	txDecoder    sdk.TxDecoder
 	oracleKeeper oraclekeeper.Keeper
 	dbMap        *gorp.DbMap
+	placeholder  sq.PlaceholderFormat
 	trans        *gorp.Transaction
+	// retention is how long requests are kept after they were created, zero to keep them forever.
+	retention time.Duration
 }
 
 func getDB(driverName string, dataSourceName string) *sql.DB {
@@ -42,13 +51,78 @@ func getDB(driverName string, dataSourceName string) *sql.DB {
 	return db
 }
 
-func initDb(connStr string) *gorp.DbMap {
+// createIndex creates the index unless it already exists.
+func createIndex(dbMap *gorp.DbMap, table string, name string, columns ...string) {
+	_, err := dbMap.Exec(fmt.Sprintf("CREATE INDEX %s ON %s (%s)", name, table, strings.Join(columns, ", ")))
+	// Check error if it's not creating existed index, panic the process.
+	if err != nil && !strings.Contains(err.Error(), fmt.Sprintf("index %s already exists", name)) {
+		if perr, ok := err.(*pq.Error); ok {
+			if perr.Code != "42P07" {
+				panic(perr)
+			}
+		} else if !strings.Contains(err.Error(), fmt.Sprintf("Duplicate key name '%s'", name)) {
+			panic(err)
+		}
+	}
+}
+
+// requestsMigrations are the columns added to the requests table after its first version, which only kept
+// successfully resolved requests.
+var requestsMigrations = []columnMigration{
+	{name: "ans_count", value: uint64(0)},
+	{name: "client_id", value: ""},
+	{name: "requester", value: ""},
+	{name: "request_height", value: int64(0)},
+	// Request time is unknown for existing rows, resolve time is the closest to it for pruning.
+	{name: "request_time", value: int64(0), fill: "resolve_time"},
+	{name: "resolve_status", value: oracletypes.RESOLVE_STATUS_OPEN, fill: fmt.Sprint(int32(oracletypes.RESOLVE_STATUS_SUCCESS))},
+	{name: "result", value: ""},
+}
+
+// columnMigration describes a column added to an existing table.
+type columnMigration struct {
+	name string
+	// value is the zero value of the column's Go type.
+	value interface{}
+	// fill is an SQL expression the column of existing rows is set to, empty to keep the zero value.
+	fill string
+}
+
+// addColumns adds the missing columns to the table created by an earlier version of the hook.
+func addColumns(dbMap *gorp.DbMap, table string, migrations []columnMigration) {
+	for _, m := range migrations {
+		rows, err := dbMap.Db.Query(fmt.Sprintf("SELECT %s FROM %s WHERE 1 = 0", m.name, table))
+		if err == nil {
+			rows.Close()
+			continue
+		}
+		def := "0"
+		if _, ok := m.value.(string); ok {
+			def = "''"
+		}
+		sqlType := dbMap.Dialect.ToSqlType(reflect.TypeOf(m.value), 0, false)
+		if _, err := dbMap.Exec(
+			fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s DEFAULT %s", table, m.name, sqlType, def),
+		); err != nil {
+			panic(err)
+		}
+		if m.fill != "" {
+			if _, err := dbMap.Exec(fmt.Sprintf("UPDATE %s SET %s = %s", table, m.name, m.fill)); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+// initDb opens the database, creates the tables that do not exist yet and adds the columns missing from tables
+// created by earlier versions of the hook.
+func initDb(connStr string) (*gorp.DbMap, sq.PlaceholderFormat) {
 	connStrs := strings.Split(connStr, "://")
 	if len(connStrs) != 2 {
 		panic("failed to parse connection string")
 	}
 	var dbMap *gorp.DbMap
-	fmt.Println(connStrs)
+	var placeholder sq.PlaceholderFormat = sq.Dollar
 	switch connStrs[0] {
 	case "sqlite3":
 		dbMap = &gorp.DbMap{Db: getDB(connStrs[0], connStrs[1]), Dialect: gorp.SqliteDialect{}}
@@ -56,35 +130,41 @@ func initDb(connStr string) *gorp.DbMap {
 		dbMap = &gorp.DbMap{Db: getDB(connStrs[0], connStrs[1]), Dialect: gorp.PostgresDialect{}}
 	case "mysql":
 		dbMap = &gorp.DbMap{Db: getDB(connStrs[0], connStrs[1]), Dialect: gorp.MySQLDialect{}}
+		placeholder = sq.Question
 	default:
 		panic(fmt.Sprintf("unknown driver %s", connStrs[0]))
 	}
-	indexName := "ix_calldata_min_count_ask_count_oracle_script_id_resolve_time"
-	dbMap.AddTableWithName(Request{}, requestsTable).AddIndex(indexName, "Btree", []string{"calldata", "min_count", "ask_count", "oracle_script_id", "resolve_time"})
+	dbMap.AddTableWithName(Request{}, requestsTable)
+	dbMap.AddTableWithName(Report{}, reportsTable).SetKeys(false, "RequestID", "Validator")
+	dbMap.AddTableWithName(RawReport{}, rawReportsTable).SetKeys(false, "RequestID", "Validator", "ExternalID")
 	err := dbMap.CreateTablesIfNotExists()
 	if err != nil {
 		panic(err)
 	}
-	err = dbMap.CreateIndex()
-	// Check error if it's not creating existed index, panic the process.
-	if err != nil && err.Error() != fmt.Sprintf("index %s already exists", indexName) {
-		if perr, ok := err.(*pq.Error); ok {
-			if perr.Code != "42P07" {
-				panic(perr)
-			}
-		} else {
-			panic(err)
-		}
-	}
-	return dbMap
+	addColumns(dbMap, requestsTable, requestsMigrations)
+	createIndex(
+		dbMap, requestsTable, "ix_calldata_min_count_ask_count_oracle_script_id_resolve_time",
+		"calldata", "min_count", "ask_count", "oracle_script_id", "resolve_time",
+	)
+	createIndex(dbMap, requestsTable, "ix_requests_client_id", "client_id", "request_id")
+	createIndex(dbMap, requestsTable, "ix_requests_requester", "requester", "request_id")
+	createIndex(dbMap, requestsTable, "ix_requests_request_time", "request_time")
+	return dbMap, placeholder
 }
 
 // NewHook creates a request hook instance that will be added in Odin App.
-func NewHook(cdc codec.JSONCodec, oracleKeeper oraclekeeper.Keeper, connStr string) *Hook {
+func NewHook(
+	cdc codec.JSONCodec, txDecoder sdk.TxDecoder, oracleKeeper oraclekeeper.Keeper, connStr string,
+	retention time.Duration,
+) *Hook {
+	dbMap, placeholder := initDb(connStr)
 	return &Hook{
 		cdc:          cdc,
+		txDecoder:    txDecoder,
 		oracleKeeper: oracleKeeper,
-		dbMap:        initDb(connStr),
+		dbMap:        dbMap,
+		placeholder:  placeholder,
+		retention:    retention,
 	}
 }
 
@@ -103,6 +183,35 @@ func (h *Hook) AfterBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res
 
 // AfterDeliverTx specify actions need to do after transaction has been processed (app.Hook interface).
 func (h *Hook) AfterDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) {
+	if ctx.BlockHeight() == 0 || !res.IsOK() {
+		return
+	}
+	tx, err := h.txDecoder(req.Tx)
+	if err != nil {
+		return
+	}
+	logs, _ := sdk.ParseABCILogs(res.Log) // Error must always be nil if res.IsOK is true.
+	for idx, msg := range tx.GetMsgs() {
+		switch msg := msg.(type) {
+		case *oracletypes.MsgReportData:
+			for _, report := range h.oracleKeeper.GetRequestReports(ctx, msg.RequestID) {
+				if report.Validator == msg.Validator {
+					h.insertReport(msg.RequestID, report, ctx.BlockHeight())
+				}
+			}
+		default:
+			// Requests are created by MsgRequestData as well as by incoming IBC packets.
+			var requester string
+			if msg, ok := msg.(*oracletypes.MsgRequestData); ok {
+				requester = msg.Sender
+			}
+			evMap := common.ParseEvents(logs[idx].Events)
+			for _, id := range evMap[oracletypes.EventTypeRequest+"."+oracletypes.AttributeKeyID] {
+				rid := oracletypes.RequestID(common.Atoi(id))
+				h.insertRequest(rid, h.oracleKeeper.MustGetRequest(ctx, rid), requester)
+			}
+		}
+	}
 }
 
 // AfterEndBlock specify actions need to do after end block period (app.Hook interface).
@@ -113,17 +222,14 @@ func (h *Hook) AfterEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci
 		switch event.Type {
 		case oracletypes.EventTypeResolve:
 			reqID := oracletypes.RequestID(common.Atoi(evMap[oracletypes.EventTypeResolve+"."+oracletypes.AttributeKeyID][0]))
-			result := h.oracleKeeper.MustGetResult(ctx, reqID)
-			if result.ResolveStatus == oracletypes.RESOLVE_STATUS_SUCCESS {
-				h.insertRequest(
-					reqID, result.OracleScriptID, result.Calldata,
-					result.AskCount, result.MinCount, result.ResolveTime,
-				)
-			}
+			h.updateResult(h.oracleKeeper.MustGetRequest(ctx, reqID), h.oracleKeeper.MustGetResult(ctx, reqID))
 		default:
 			break
 		}
 	}
+	if h.retention > 0 {
+		h.pruneRequests(ctx.BlockTime().Add(-h.retention).Unix())
+	}
 }
 
 // ApplyQuery catch the custom query that matches specific paths (app.Hook interface).
@@ -150,6 +256,23 @@ func (h *Hook) ApplyQuery(req abci.RequestQuery) (res abci.ResponseQuery, stop b
 				return common.QueryResultError(err), true
 			}
 			return common.QueryResultSuccess(bz, req.Height), true
+		case QueryRequestHistory, QueryRequestsByClientID, QueryRequestsByRequester:
+			var query RequestQuery
+			if err := json.Unmarshal(req.Data, &query); err != nil {
+				return common.QueryResultError(err), true
+			}
+			if err := query.validate(paths[1]); err != nil {
+				return common.QueryResultError(err), true
+			}
+			records, err := h.queryRequests(query)
+			if err != nil {
+				return common.QueryResultError(err), true
+			}
+			bz, err := json.Marshal(records)
+			if err != nil {
+				return common.QueryResultError(err), true
+			}
+			return common.QueryResultSuccess(bz, req.Height), true
 		default:
 			return abci.ResponseQuery{}, false
 		}
@@ -0,0 +1,142 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"time"
+
+	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
+)
+
+// Duration is a time.Duration that is written as a string such as "10s" in the config file.
+type Duration struct {
+	time.Duration
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Duration) UnmarshalJSON(bz []byte) error {
+	var value string
+	if err := json.Unmarshal(bz, &value); err != nil {
+		return err
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+	d.Duration = duration
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// Target is an operator URL that receives notifications of resolved requests. A target without filters receives
+// all notifications, otherwise the request must match one of the oracle script IDs or one of the client IDs.
+type Target struct {
+	URL string `json:"url"`
+	// Secret is the key of the HMAC-SHA256 signature sent along with every notification.
+	Secret          string                       `json:"secret"`
+	OracleScriptIDs []oracletypes.OracleScriptID `json:"oracle_script_ids"`
+	ClientIDs       []string                     `json:"client_ids"`
+}
+
+// Matches returns whether the notification of the given result should be sent to the target.
+func (t Target) Matches(result oracletypes.Result) bool {
+	if len(t.OracleScriptIDs) == 0 && len(t.ClientIDs) == 0 {
+		return true
+	}
+	for _, id := range t.OracleScriptIDs {
+		if id == result.OracleScriptID {
+			return true
+		}
+	}
+	for _, id := range t.ClientIDs {
+		if id == result.ClientID {
+			return true
+		}
+	}
+	return false
+}
+
+// Config is the configuration of the webhook hook.
+type Config struct {
+	Targets []Target `json:"targets"`
+	// QueueDir is the directory of the database holding notifications that are not delivered yet.
+	QueueDir string `json:"queue_dir"`
+	// DeadLetterLog is the file notifications are appended to once they cannot be delivered.
+	DeadLetterLog string `json:"dead_letter_log"`
+	// MaxAttempts is the number of delivery attempts before a notification is moved to the dead-letter log.
+	MaxAttempts    int      `json:"max_attempts"`
+	Timeout        Duration `json:"timeout"`
+	InitialBackoff Duration `json:"initial_backoff"`
+	MaxBackoff     Duration `json:"max_backoff"`
+	PollInterval   Duration `json:"poll_interval"`
+}
+
+// DefaultConfig returns the default configuration storing its queue and dead-letter log in dataDir.
+func DefaultConfig(dataDir string) Config {
+	return Config{
+		QueueDir:       filepath.Join(dataDir, "webhook"),
+		DeadLetterLog:  filepath.Join(dataDir, "webhook", "dead-letter.ndjson"),
+		MaxAttempts:    10,
+		Timeout:        Duration{10 * time.Second},
+		InitialBackoff: Duration{time.Second},
+		MaxBackoff:     Duration{10 * time.Minute},
+		PollInterval:   Duration{time.Second},
+	}
+}
+
+// LoadConfig reads the JSON config file at path on top of the default configuration.
+func LoadConfig(path string, dataDir string) (Config, error) {
+	config := DefaultConfig(dataDir)
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	if err := json.Unmarshal(bz, &config); err != nil {
+		return Config{}, fmt.Errorf("invalid webhook config %s: %w", path, err)
+	}
+	return config, config.Validate()
+}
+
+// Validate checks that the configuration is usable.
+func (c Config) Validate() error {
+	if len(c.Targets) == 0 {
+		return fmt.Errorf("webhook config has no targets")
+	}
+	seen := make(map[string]bool)
+	for _, target := range c.Targets {
+		u, err := url.Parse(target.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return fmt.Errorf("invalid webhook URL %q", target.URL)
+		}
+		if seen[target.URL] {
+			return fmt.Errorf("duplicate webhook URL %q", target.URL)
+		}
+		seen[target.URL] = true
+		if target.Secret == "" {
+			return fmt.Errorf("webhook %s has no secret", target.URL)
+		}
+	}
+	if c.MaxAttempts <= 0 {
+		return fmt.Errorf("max_attempts must be positive")
+	}
+	if c.Timeout.Duration <= 0 {
+		return fmt.Errorf("timeout must be positive")
+	}
+	if c.InitialBackoff.Duration <= 0 {
+		return fmt.Errorf("initial_backoff must be positive")
+	}
+	if c.MaxBackoff.Duration <= 0 {
+		return fmt.Errorf("max_backoff must be positive")
+	}
+	if c.PollInterval.Duration <= 0 {
+		return fmt.Errorf("poll_interval must be positive")
+	}
+	return nil
+}
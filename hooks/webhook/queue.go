@@ -0,0 +1,120 @@
+package webhook
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+// delivery is a notification waiting to be delivered to a target.
+type delivery struct {
+	ID          string          `json:"id"`
+	URL         string          `json:"url"`
+	Body        json.RawMessage `json:"body"`
+	Attempts    int             `json:"attempts"`
+	NextAttempt int64           `json:"next_attempt"`
+	LastError   string          `json:"last_error,omitempty"`
+}
+
+// queue is the persistent list of pending deliveries ordered by the time they were enqueued.
+type queue struct {
+	mtx  sync.Mutex
+	db   dbm.DB
+	next uint64
+}
+
+func openQueue(dir string) (*queue, error) {
+	db, err := dbm.NewGoLevelDB("queue", dir)
+	if err != nil {
+		return nil, err
+	}
+	q := &queue{db: db}
+	it, err := db.ReverseIterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	if it.Valid() {
+		q.next = binary.BigEndian.Uint64(it.Key()) + 1
+	}
+	return q, nil
+}
+
+// push persists the given deliveries atomically.
+func (q *queue) push(deliveries []delivery) error {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	batch := q.db.NewBatch()
+	defer batch.Close()
+	for _, d := range deliveries {
+		bz, err := json.Marshal(d)
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(queueKey(q.next), bz); err != nil {
+			return err
+		}
+		q.next++
+	}
+	return batch.WriteSync()
+}
+
+// due returns the keys and deliveries whose next attempt is not after now.
+func (q *queue) due(now int64) ([][]byte, []delivery, error) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	it, err := q.db.Iterator(nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+	var keys [][]byte
+	var deliveries []delivery
+	for ; it.Valid(); it.Next() {
+		var d delivery
+		if err := json.Unmarshal(it.Value(), &d); err != nil {
+			return nil, nil, err
+		}
+		if d.NextAttempt <= now {
+			keys = append(keys, append([]byte{}, it.Key()...))
+			deliveries = append(deliveries, d)
+		}
+	}
+	return keys, deliveries, it.Error()
+}
+
+// update stores the new state of the delivery at key.
+func (q *queue) update(key []byte, d delivery) error {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	bz, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	return q.db.SetSync(key, bz)
+}
+
+// remove deletes the delivery at key.
+func (q *queue) remove(key []byte) error {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	return q.db.DeleteSync(key)
+}
+
+// size returns the number of pending deliveries.
+func (q *queue) size() (int, error) {
+	_, all, err := q.due(1<<63 - 1)
+	return len(all), err
+}
+
+func (q *queue) close() error {
+	return q.db.Close()
+}
+
+func queueKey(n uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, n)
+	return key
+}
@@ -0,0 +1,342 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/GeoDB-Limited/odin-core/hooks/common"
+	oraclekeeper "github.com/GeoDB-Limited/odin-core/x/oracle/keeper"
+	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
+)
+
+const (
+	// HeaderSignature carries the hex encoded HMAC-SHA256 of the timestamp, a dot and the body, prefixed by sha256=.
+	HeaderSignature = "X-Odin-Signature"
+	// HeaderTimestamp carries the unix time the notification was sent at.
+	HeaderTimestamp = "X-Odin-Timestamp"
+	// HeaderDelivery carries the notification ID, which is the same across retries.
+	HeaderDelivery = "X-Odin-Delivery"
+)
+
+// Notification is the JSON body posted to targets when an oracle request resolves.
+type Notification struct {
+	RequestID      oracletypes.RequestID      `json:"request_id"`
+	OracleScriptID oracletypes.OracleScriptID `json:"oracle_script_id"`
+	ClientID       string                     `json:"client_id"`
+	Calldata       []byte                     `json:"calldata"`
+	AskCount       uint64                     `json:"ask_count"`
+	MinCount       uint64                     `json:"min_count"`
+	AnsCount       uint64                     `json:"ans_count"`
+	RequestTime    int64                      `json:"request_time"`
+	ResolveTime    int64                      `json:"resolve_time"`
+	ResolveStatus  string                     `json:"resolve_status"`
+	Result         []byte                     `json:"result"`
+	BlockHeight    int64                      `json:"block_height"`
+}
+
+// Sign returns the signature of a notification body sent at the given unix time.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Hook inherits from Odin app hook to notify operator URLs of resolved requests. Notifications are persisted
+// when the block is committed and delivered in the background at least once, retrying with exponential backoff.
+// Every target has its own worker, so that a slow target does not hold back the others. Notifications that cannot
+// be delivered are appended to the dead-letter log.
+type Hook struct {
+	oracleKeeper oraclekeeper.Keeper
+	config       Config
+	logger       log.Logger
+	client       *http.Client
+	targets      map[string]Target
+	queue        *queue
+
+	pending       []delivery
+	wake          map[string]chan struct{}
+	quit          chan struct{}
+	wg            sync.WaitGroup
+	deadLetterMtx sync.Mutex
+}
+
+// NewHook creates a webhook hook instance that will be added in Odin App and starts delivering the notifications
+// left in its queue. Notifications left for targets that are no longer configured are moved to the dead-letter log.
+func NewHook(oracleKeeper oraclekeeper.Keeper, config Config, logger log.Logger) (*Hook, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	q, err := openQueue(config.QueueDir)
+	if err != nil {
+		return nil, err
+	}
+	targets := make(map[string]Target)
+	for _, target := range config.Targets {
+		targets[target.URL] = target
+	}
+	h := &Hook{
+		oracleKeeper: oracleKeeper,
+		config:       config,
+		logger:       logger.With("module", "webhook"),
+		client:       &http.Client{Timeout: config.Timeout.Duration},
+		targets:      targets,
+		queue:        q,
+		wake:         make(map[string]chan struct{}),
+		quit:         make(chan struct{}),
+	}
+	h.deadLetterRemovedTargets()
+	for url := range targets {
+		h.wake[url] = make(chan struct{}, 1)
+		h.wg.Add(1)
+		go h.run(url)
+	}
+	return h, nil
+}
+
+// Close stops delivering notifications and closes the queue.
+func (h *Hook) Close() error {
+	close(h.quit)
+	h.wg.Wait()
+	return h.queue.close()
+}
+
+// notify queues the notification of the given result for all matching targets.
+func (h *Hook) notify(result oracletypes.Result, height int64) {
+	body, err := json.Marshal(Notification{
+		RequestID:      result.RequestID,
+		OracleScriptID: result.OracleScriptID,
+		ClientID:       result.ClientID,
+		Calldata:       result.Calldata,
+		AskCount:       result.AskCount,
+		MinCount:       result.MinCount,
+		AnsCount:       result.AnsCount,
+		RequestTime:    result.RequestTime,
+		ResolveTime:    result.ResolveTime,
+		ResolveStatus:  result.ResolveStatus.String(),
+		Result:         result.Result,
+		BlockHeight:    height,
+	})
+	if err != nil {
+		panic(err)
+	}
+	for _, target := range h.config.Targets {
+		if target.Matches(result) {
+			h.pending = append(h.pending, delivery{
+				ID:   fmt.Sprintf("%d", result.RequestID),
+				URL:  target.URL,
+				Body: body,
+			})
+		}
+	}
+}
+
+// AfterInitChain specify actions need to do after chain initialization (app.Hook interface).
+func (h *Hook) AfterInitChain(ctx sdk.Context, req abci.RequestInitChain, res abci.ResponseInitChain) {
+}
+
+// AfterBeginBlock specify actions need to do after begin block period (app.Hook interface).
+func (h *Hook) AfterBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) {
+	h.pending = nil
+}
+
+// AfterDeliverTx specify actions need to do after transaction has been processed (app.Hook interface).
+func (h *Hook) AfterDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) {
+}
+
+// AfterEndBlock specify actions need to do after end block period (app.Hook interface).
+func (h *Hook) AfterEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) {
+	for _, event := range res.Events {
+		if event.Type != oracletypes.EventTypeResolve {
+			continue
+		}
+		evMap := common.ParseEvents(sdk.StringifyEvents([]abci.Event{event}))
+		reqID := oracletypes.RequestID(common.Atoi(evMap[oracletypes.EventTypeResolve+"."+oracletypes.AttributeKeyID][0]))
+		h.notify(h.oracleKeeper.MustGetResult(ctx, reqID), req.Height)
+	}
+}
+
+// ApplyQuery catch the custom query that matches specific paths (app.Hook interface).
+func (h *Hook) ApplyQuery(req abci.RequestQuery) (res abci.ResponseQuery, stop bool) {
+	return abci.ResponseQuery{}, false
+}
+
+// BeforeCommit specify actions need to do before commit block (app.Hook interface).
+func (h *Hook) BeforeCommit() {
+	if len(h.pending) == 0 {
+		return
+	}
+	if err := h.queue.push(h.pending); err != nil {
+		panic(fmt.Errorf("failed to queue %d webhook notifications: %w", len(h.pending), err))
+	}
+	for _, d := range h.pending {
+		select {
+		case h.wake[d.URL] <- struct{}{}:
+		default:
+		}
+	}
+	h.pending = nil
+}
+
+// run delivers the notifications of the target with the given URL until the hook is closed.
+func (h *Hook) run(url string) {
+	defer h.wg.Done()
+	ticker := time.NewTicker(h.config.PollInterval.Duration)
+	defer ticker.Stop()
+	for {
+		h.deliverDue(url)
+		select {
+		case <-h.quit:
+			return
+		case <-h.wake[url]:
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliverDue attempts the deliveries to the target with the given URL that are due and reschedules or dead-letters
+// the failed ones.
+func (h *Hook) deliverDue(url string) {
+	keys, deliveries, err := h.queue.due(time.Now().UnixNano())
+	if err != nil {
+		h.logger.Error("failed to read webhook queue", "err", err)
+		return
+	}
+	for idx, d := range deliveries {
+		if d.URL != url {
+			continue
+		}
+		select {
+		case <-h.quit:
+			return
+		default:
+		}
+		retry, err := h.deliver(d)
+		if err == nil {
+			if err := h.queue.remove(keys[idx]); err != nil {
+				h.logger.Error("failed to remove delivered webhook notification", "err", err)
+			}
+			continue
+		}
+		d.Attempts++
+		d.LastError = err.Error()
+		if !retry || d.Attempts >= h.config.MaxAttempts {
+			h.giveUp(keys[idx], d)
+			continue
+		}
+		d.NextAttempt = time.Now().Add(h.backoff(d.Attempts)).UnixNano()
+		h.logger.Debug("retrying webhook notification", "id", d.ID, "url", d.URL, "attempts", d.Attempts, "err", err)
+		if err := h.queue.update(keys[idx], d); err != nil {
+			h.logger.Error("failed to reschedule webhook notification", "err", err)
+		}
+	}
+}
+
+// deadLetterRemovedTargets moves the deliveries to targets that are no longer configured to the dead-letter log.
+func (h *Hook) deadLetterRemovedTargets() {
+	keys, deliveries, err := h.queue.due(1<<63 - 1)
+	if err != nil {
+		h.logger.Error("failed to read webhook queue", "err", err)
+		return
+	}
+	for idx, d := range deliveries {
+		if _, ok := h.targets[d.URL]; ok {
+			continue
+		}
+		d.LastError = "target is no longer configured"
+		h.giveUp(keys[idx], d)
+	}
+}
+
+// giveUp moves the delivery at key from the queue to the dead-letter log.
+func (h *Hook) giveUp(key []byte, d delivery) {
+	h.logger.Error("giving up webhook notification", "id", d.ID, "url", d.URL, "err", d.LastError)
+	if err := h.deadLetter(d); err != nil {
+		h.logger.Error("failed to write webhook dead-letter log", "err", err)
+		return
+	}
+	if err := h.queue.remove(key); err != nil {
+		h.logger.Error("failed to remove dead-lettered webhook notification", "err", err)
+	}
+}
+
+// backoff returns the delay before the next attempt after the given number of failed attempts.
+func (h *Hook) backoff(attempts int) time.Duration {
+	delay := h.config.InitialBackoff.Duration
+	for i := 1; i < attempts && delay < h.config.MaxBackoff.Duration; i++ {
+		delay *= 2
+	}
+	if delay > h.config.MaxBackoff.Duration {
+		delay = h.config.MaxBackoff.Duration
+	}
+	return delay
+}
+
+// deliver posts the notification and returns whether a failure may succeed on retry.
+func (h *Hook) deliver(d delivery) (bool, error) {
+	target, ok := h.targets[d.URL]
+	if !ok {
+		return false, fmt.Errorf("target is no longer configured")
+	}
+	timestamp := time.Now().Unix()
+	req, err := http.NewRequest(http.MethodPost, d.URL, bytes.NewReader(d.Body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderDelivery, d.ID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(target.Secret, timestamp, d.Body))
+	res, err := h.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer res.Body.Close()
+	io.Copy(ioutil.Discard, res.Body)
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return true, nil
+	}
+	// Client errors other than timeouts and rate limiting will not go away by retrying.
+	retry := res.StatusCode >= 500 || res.StatusCode == http.StatusRequestTimeout ||
+		res.StatusCode == http.StatusTooManyRequests
+	return retry, fmt.Errorf("unexpected status %s", res.Status)
+}
+
+// deadLetter appends the undeliverable notification to the dead-letter log.
+func (h *Hook) deadLetter(d delivery) error {
+	h.deadLetterMtx.Lock()
+	defer h.deadLetterMtx.Unlock()
+	if err := os.MkdirAll(filepath.Dir(h.config.DeadLetterLog), 0755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(h.config.DeadLetterLog, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	line, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	return file.Sync()
+}
@@ -0,0 +1,289 @@
+package webhook
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	oraclekeeper "github.com/GeoDB-Limited/odin-core/x/oracle/keeper"
+	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
+)
+
+const testSecret = "secret"
+
+type receiver struct {
+	mtx           sync.Mutex
+	notifications []Notification
+	failures      int
+	status        int
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if r.failures > 0 {
+		r.failures--
+		w.WriteHeader(r.status)
+		return
+	}
+	body, _ := ioutil.ReadAll(req.Body)
+	timestamp, _ := strconv.ParseInt(req.Header.Get(HeaderTimestamp), 10, 64)
+	if req.Header.Get(HeaderSignature) != Sign(testSecret, timestamp, body) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	var notification Notification
+	if err := json.Unmarshal(body, &notification); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	r.notifications = append(r.notifications, notification)
+}
+
+func (r *receiver) received() []Notification {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return append([]Notification{}, r.notifications...)
+}
+
+func testConfig(dir string, targets ...Target) Config {
+	config := DefaultConfig(dir)
+	config.Targets = targets
+	config.MaxAttempts = 3
+	config.InitialBackoff = Duration{10 * time.Millisecond}
+	config.MaxBackoff = Duration{20 * time.Millisecond}
+	config.PollInterval = Duration{10 * time.Millisecond}
+	return config
+}
+
+func newTestHook(t *testing.T, config Config) *Hook {
+	h, err := NewHook(oraclekeeper.Keeper{}, config, log.NewNopLogger())
+	require.NoError(t, err)
+	return h
+}
+
+func testResult(id oracletypes.RequestID, oid oracletypes.OracleScriptID, clientID string) oracletypes.Result {
+	return oracletypes.Result{
+		RequestID:      id,
+		OracleScriptID: oid,
+		ClientID:       clientID,
+		ResolveStatus:  oracletypes.RESOLVE_STATUS_SUCCESS,
+		Result:         []byte("result"),
+	}
+}
+
+func TestDeliverFilteredNotifications(t *testing.T) {
+	all, byScript := &receiver{}, &receiver{}
+	allServer, scriptServer := httptest.NewServer(all), httptest.NewServer(byScript)
+	defer allServer.Close()
+	defer scriptServer.Close()
+
+	h := newTestHook(t, testConfig(t.TempDir(),
+		Target{URL: allServer.URL, Secret: testSecret},
+		Target{URL: scriptServer.URL, Secret: testSecret, OracleScriptIDs: []oracletypes.OracleScriptID{2}},
+	))
+	defer h.Close()
+	h.notify(testResult(1, 1, "alice"), 10)
+	h.notify(testResult(2, 2, "bob"), 10)
+	h.BeforeCommit()
+
+	require.Eventually(t, func() bool { return len(all.received()) == 2 }, time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool { return len(byScript.received()) == 1 }, time.Second, 10*time.Millisecond)
+	notification := byScript.received()[0]
+	require.Equal(t, oracletypes.RequestID(2), notification.RequestID)
+	require.Equal(t, "RESOLVE_STATUS_SUCCESS", notification.ResolveStatus)
+	require.Equal(t, []byte("result"), notification.Result)
+	require.Equal(t, int64(10), notification.BlockHeight)
+	require.Eventually(t, func() bool {
+		size, err := h.queue.size()
+		return err == nil && size == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestRetryNotification(t *testing.T) {
+	r := &receiver{failures: 2, status: http.StatusServiceUnavailable}
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	h := newTestHook(t, testConfig(t.TempDir(), Target{URL: server.URL, Secret: testSecret}))
+	defer h.Close()
+	h.notify(testResult(1, 1, "alice"), 10)
+	h.BeforeCommit()
+	require.Eventually(t, func() bool { return len(r.received()) == 1 }, time.Second, 10*time.Millisecond)
+}
+
+func TestDeadLetterNotification(t *testing.T) {
+	retried := &receiver{failures: 100, status: http.StatusInternalServerError}
+	rejected := &receiver{failures: 100, status: http.StatusBadRequest}
+	retriedServer, rejectedServer := httptest.NewServer(retried), httptest.NewServer(rejected)
+	defer retriedServer.Close()
+	defer rejectedServer.Close()
+
+	config := testConfig(t.TempDir(),
+		Target{URL: retriedServer.URL, Secret: testSecret},
+		Target{URL: rejectedServer.URL, Secret: testSecret},
+	)
+	h := newTestHook(t, config)
+	defer h.Close()
+	h.notify(testResult(1, 1, "alice"), 10)
+	h.BeforeCommit()
+
+	var lines []delivery
+	require.Eventually(t, func() bool {
+		file, err := os.Open(config.DeadLetterLog)
+		if err != nil {
+			return false
+		}
+		defer file.Close()
+		lines = nil
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			var d delivery
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &d))
+			lines = append(lines, d)
+		}
+		return len(lines) == 2
+	}, 2*time.Second, 10*time.Millisecond)
+
+	attempts := map[string]int{lines[0].URL: lines[0].Attempts, lines[1].URL: lines[1].Attempts}
+	// Server errors are retried up to the maximum number of attempts, client errors are not.
+	require.Equal(t, map[string]int{retriedServer.URL: 3, rejectedServer.URL: 1}, attempts)
+	size, err := h.queue.size()
+	require.NoError(t, err)
+	require.Zero(t, size)
+}
+
+func TestQueueSurvivesRestart(t *testing.T) {
+	r := &receiver{}
+	server := httptest.NewServer(r)
+	defer server.Close()
+	dir := t.TempDir()
+	config := testConfig(dir, Target{URL: server.URL, Secret: testSecret})
+	config.InitialBackoff = Duration{time.Hour}
+	config.MaxBackoff = Duration{time.Hour}
+
+	// The first attempt fails and the retry is scheduled far in the future.
+	r.failures, r.status = 1, http.StatusServiceUnavailable
+	h := newTestHook(t, config)
+	h.notify(testResult(1, 1, "alice"), 10)
+	h.BeforeCommit()
+	require.Eventually(t, func() bool {
+		r.mtx.Lock()
+		defer r.mtx.Unlock()
+		return r.failures == 0
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, h.Close())
+
+	// Pending deliveries are loaded from disk after a restart.
+	q, err := openQueue(filepath.Join(dir, "webhook"))
+	require.NoError(t, err)
+	keys, deliveries, err := q.due(time.Now().Add(2 * time.Hour).UnixNano())
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	deliveries[0].NextAttempt = 0
+	require.NoError(t, q.update(keys[0], deliveries[0]))
+	require.NoError(t, q.close())
+
+	h = newTestHook(t, config)
+	defer h.Close()
+	require.Eventually(t, func() bool { return len(r.received()) == 1 }, time.Second, 10*time.Millisecond)
+}
+
+func TestConfigValidate(t *testing.T) {
+	config := testConfig(t.TempDir(), Target{URL: "http://localhost/hook", Secret: testSecret})
+	require.NoError(t, config.Validate())
+	config.Targets = append(config.Targets, Target{URL: "http://localhost/hook", Secret: testSecret})
+	require.Error(t, config.Validate())
+	config.Targets = []Target{{URL: "ftp://localhost", Secret: testSecret}}
+	require.Error(t, config.Validate())
+	config.Targets = []Target{{URL: "http://localhost/hook"}}
+	require.Error(t, config.Validate())
+}
+
+func TestConfigValidateDurations(t *testing.T) {
+	for name, clear := range map[string]func(*Config){
+		"timeout":         func(c *Config) { c.Timeout = Duration{} },
+		"initial_backoff": func(c *Config) { c.InitialBackoff = Duration{} },
+		"max_backoff":     func(c *Config) { c.MaxBackoff = Duration{-time.Second} },
+		"poll_interval":   func(c *Config) { c.PollInterval = Duration{} },
+	} {
+		config := testConfig(t.TempDir(), Target{URL: "http://localhost/hook", Secret: testSecret})
+		clear(&config)
+		require.EqualError(t, config.Validate(), name+" must be positive")
+	}
+}
+
+func TestSlowTargetDoesNotBlockOthers(t *testing.T) {
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		<-release
+	}))
+	defer slow.Close()
+	fast := &receiver{}
+	fastServer := httptest.NewServer(fast)
+	defer fastServer.Close()
+
+	h := newTestHook(t, testConfig(t.TempDir(),
+		Target{URL: slow.URL, Secret: testSecret},
+		Target{URL: fastServer.URL, Secret: testSecret},
+	))
+	defer h.Close()
+	defer close(release)
+	h.notify(testResult(1, 1, "alice"), 10)
+	h.notify(testResult(2, 1, "alice"), 10)
+	h.BeforeCommit()
+
+	require.Eventually(t, func() bool { return len(fast.received()) == 2 }, time.Second, 10*time.Millisecond)
+}
+
+func TestDeadLetterRemovedTarget(t *testing.T) {
+	r := &receiver{}
+	server := httptest.NewServer(r)
+	defer server.Close()
+	dir := t.TempDir()
+	config := testConfig(dir, Target{URL: server.URL, Secret: testSecret})
+
+	q, err := openQueue(config.QueueDir)
+	require.NoError(t, err)
+	require.NoError(t, q.push([]delivery{
+		{ID: "1", URL: "http://localhost/removed", Body: []byte("{}")},
+		{ID: "2", URL: server.URL, Body: []byte("{}")},
+	}))
+	require.NoError(t, q.close())
+
+	h := newTestHook(t, config)
+	defer h.Close()
+	require.Eventually(t, func() bool { return len(r.received()) == 1 }, time.Second, 10*time.Millisecond)
+	bz, err := ioutil.ReadFile(config.DeadLetterLog)
+	require.NoError(t, err)
+	var d delivery
+	require.NoError(t, json.Unmarshal(bz, &d))
+	require.Equal(t, "http://localhost/removed", d.URL)
+	require.Equal(t, "target is no longer configured", d.LastError)
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "webhook.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{
+		"targets": [{"url": "https://example.com/hook", "secret": "s", "client_ids": ["alice"]}],
+		"timeout": "3s"
+	}`), 0600))
+	config, err := LoadConfig(path, dir)
+	require.NoError(t, err)
+	require.Equal(t, 3*time.Second, config.Timeout.Duration)
+	require.Equal(t, 10, config.MaxAttempts)
+	require.True(t, config.Targets[0].Matches(testResult(1, 1, "alice")))
+	require.False(t, config.Targets[0].Matches(testResult(1, 1, "bob")))
+}
@@ -0,0 +1,192 @@
+package verifier
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/GeoDB-Limited/odin-core/x/oracle/types"
+)
+
+// Headers that data source scripts forward from the environment given by yoda.
+const (
+	HeaderChainID    = "BAND_CHAIN_ID"
+	HeaderValidator  = "BAND_VALIDATOR"
+	HeaderRequestID  = "BAND_REQUEST_ID"
+	HeaderExternalID = "BAND_EXTERNAL_ID"
+	HeaderReporter   = "BAND_REPORTER"
+	HeaderSignature  = "BAND_SIGNATURE"
+)
+
+var (
+	ErrMissingHeader     = errors.New("missing verification header")
+	ErrChainIDMismatch   = errors.New("chain ID mismatch")
+	ErrInvalidReporter   = errors.New("invalid reporter public key")
+	ErrInvalidSignature  = errors.New("invalid reporter signature")
+	ErrVerificationQuery = errors.New("request verification query failed")
+)
+
+// Request contains the values a reporter signed when calling a data provider.
+type Request struct {
+	ChainID    string
+	Validator  string
+	RequestID  uint64
+	ExternalID uint64
+	Reporter   string // Hex-encoded compressed secp256k1 public key of the reporter
+	Signature  []byte
+}
+
+// ParseHeaders reads the verification request from HTTP headers. The signature is expected to be base64-encoded,
+// the way yoda's executors pass it to data source scripts.
+func ParseHeaders(h http.Header) (Request, error) {
+	values := make(map[string]string)
+	for _, key := range []string{
+		HeaderChainID, HeaderValidator, HeaderRequestID, HeaderExternalID, HeaderReporter, HeaderSignature,
+	} {
+		values[key] = h.Get(key)
+		if values[key] == "" {
+			return Request{}, fmt.Errorf("%w: %s", ErrMissingHeader, key)
+		}
+	}
+
+	requestID, err := strconv.ParseUint(values[HeaderRequestID], 10, 64)
+	if err != nil {
+		return Request{}, fmt.Errorf("invalid request ID: %w", err)
+	}
+	externalID, err := strconv.ParseUint(values[HeaderExternalID], 10, 64)
+	if err != nil {
+		return Request{}, fmt.Errorf("invalid external ID: %w", err)
+	}
+	signature, err := base64.StdEncoding.DecodeString(values[HeaderSignature])
+	if err != nil {
+		return Request{}, fmt.Errorf("invalid signature encoding: %w", err)
+	}
+
+	return Request{
+		ChainID:    values[HeaderChainID],
+		Validator:  values[HeaderValidator],
+		RequestID:  requestID,
+		ExternalID: externalID,
+		Reporter:   values[HeaderReporter],
+		Signature:  signature,
+	}, nil
+}
+
+// VerifySignature checks that the request is signed by the reporter over RequestVerification.GetSignBytes.
+// The chain's bech32 prefixes must be set in the SDK config to parse the validator address.
+func (r Request) VerifySignature() error {
+	pk, err := hex.DecodeString(r.Reporter)
+	if err != nil || len(pk) != secp256k1.PubKeySize {
+		return ErrInvalidReporter
+	}
+	validator, err := sdk.ValAddressFromBech32(r.Validator)
+	if err != nil {
+		return fmt.Errorf("invalid validator address: %w", err)
+	}
+	vmsg := types.NewRequestVerification(r.ChainID, validator, types.RequestID(r.RequestID), types.ExternalID(r.ExternalID))
+	pubKey := secp256k1.PubKey(pk)
+	if !pubKey.VerifySignature(vmsg.GetSignBytes(), r.Signature) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+type cacheEntry struct {
+	res    *types.QueryRequestVerificationResponse
+	expiry time.Time
+}
+
+// Verifier checks requests signed by yoda reporters. Signatures are verified locally, then the chain is asked
+// whether the reporter is authorized by the validator and the request is still waiting for its report.
+// Successful verifications are cached for a short time so repeated calls do not hit the node.
+type Verifier struct {
+	chainID string
+	client  types.QueryClient
+	ttl     time.Duration
+	cache   *lru.Cache
+}
+
+// NewVerifier creates a new Verifier instance. Requests for other chains than chainID are rejected without
+// querying the node. Up to cacheSize verifications are cached for ttl, a non-positive ttl disables caching.
+func NewVerifier(chainID string, client types.QueryClient, cacheSize int, ttl time.Duration) (*Verifier, error) {
+	cache, err := lru.New(cacheSize)
+	if err != nil {
+		return nil, err
+	}
+	return &Verifier{chainID: chainID, client: client, ttl: ttl, cache: cache}, nil
+}
+
+func cacheKey(r Request) string {
+	return fmt.Sprintf("%s:%s:%d:%d:%s:%x", r.ChainID, r.Validator, r.RequestID, r.ExternalID, r.Reporter, r.Signature)
+}
+
+// Verify returns the on-chain verification of the given request or an error if the request is not authorized.
+func (v *Verifier) Verify(ctx context.Context, r Request) (*types.QueryRequestVerificationResponse, error) {
+	if r.ChainID != v.chainID {
+		return nil, ErrChainIDMismatch
+	}
+	if err := r.VerifySignature(); err != nil {
+		return nil, err
+	}
+
+	key := cacheKey(r)
+	if value, ok := v.cache.Get(key); ok {
+		entry := value.(cacheEntry)
+		if time.Now().Before(entry.expiry) {
+			return entry.res, nil
+		}
+		v.cache.Remove(key)
+	}
+
+	res, err := v.client.RequestVerification(ctx, &types.QueryRequestVerificationRequest{
+		ChainId:    r.ChainID,
+		Validator:  r.Validator,
+		RequestId:  r.RequestID,
+		ExternalId: r.ExternalID,
+		Reporter:   r.Reporter,
+		Signature:  r.Signature,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrVerificationQuery, err.Error())
+	}
+
+	if v.ttl > 0 {
+		v.cache.Add(key, cacheEntry{res: res, expiry: time.Now().Add(v.ttl)})
+	}
+	return res, nil
+}
+
+type contextKey struct{}
+
+// FromContext returns the verification stored by Middleware in the HTTP request context.
+func FromContext(ctx context.Context) (*types.QueryRequestVerificationResponse, bool) {
+	res, ok := ctx.Value(contextKey{}).(*types.QueryRequestVerificationResponse)
+	return res, ok
+}
+
+// Middleware rejects HTTP requests that do not carry a valid yoda verification with 401 Unauthorized.
+// Verified requests are passed to next with the verification available through FromContext.
+func Middleware(v *Verifier, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r, err := ParseHeaders(req.Header)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		res, err := v.Verify(req.Context(), r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, req.WithContext(context.WithValue(req.Context(), contextKey{}, res)))
+	})
+}
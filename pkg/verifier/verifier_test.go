@@ -0,0 +1,149 @@
+package verifier_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"google.golang.org/grpc"
+
+	"github.com/GeoDB-Limited/odin-core/pkg/verifier"
+	"github.com/GeoDB-Limited/odin-core/x/oracle/types"
+)
+
+type mockQueryClient struct {
+	types.QueryClient
+	called int
+	err    error
+}
+
+func (c *mockQueryClient) RequestVerification(
+	ctx context.Context, in *types.QueryRequestVerificationRequest, opts ...grpc.CallOption,
+) (*types.QueryRequestVerificationResponse, error) {
+	c.called++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &types.QueryRequestVerificationResponse{
+		ChainId:      in.ChainId,
+		Validator:    in.Validator,
+		RequestId:    in.RequestId,
+		ExternalId:   in.ExternalId,
+		DataSourceId: 1,
+	}, nil
+}
+
+var (
+	reporterKey = secp256k1.GenPrivKey()
+	validator   = sdk.ValAddress([]byte("validator___________"))
+)
+
+func signedRequest(t *testing.T, chainID string) verifier.Request {
+	vmsg := types.NewRequestVerification(chainID, validator, 42, 3)
+	sig, err := reporterKey.Sign(vmsg.GetSignBytes())
+	require.NoError(t, err)
+	return verifier.Request{
+		ChainID:    chainID,
+		Validator:  validator.String(),
+		RequestID:  42,
+		ExternalID: 3,
+		Reporter:   hex.EncodeToString(reporterKey.PubKey().Bytes()),
+		Signature:  sig,
+	}
+}
+
+func setHeaders(h http.Header, r verifier.Request) {
+	h.Set(verifier.HeaderChainID, r.ChainID)
+	h.Set(verifier.HeaderValidator, r.Validator)
+	h.Set(verifier.HeaderRequestID, strconv.FormatUint(r.RequestID, 10))
+	h.Set(verifier.HeaderExternalID, strconv.FormatUint(r.ExternalID, 10))
+	h.Set(verifier.HeaderReporter, r.Reporter)
+	h.Set(verifier.HeaderSignature, base64.StdEncoding.EncodeToString(r.Signature))
+}
+
+func TestParseHeaders(t *testing.T) {
+	r := signedRequest(t, "odin")
+	h := http.Header{}
+	setHeaders(h, r)
+	parsed, err := verifier.ParseHeaders(h)
+	require.NoError(t, err)
+	require.Equal(t, r, parsed)
+
+	h.Del(verifier.HeaderSignature)
+	_, err = verifier.ParseHeaders(h)
+	require.True(t, errors.Is(err, verifier.ErrMissingHeader))
+}
+
+func TestVerifySignature(t *testing.T) {
+	r := signedRequest(t, "odin")
+	require.NoError(t, r.VerifySignature())
+
+	r.RequestID = 43
+	require.Equal(t, verifier.ErrInvalidSignature, r.VerifySignature())
+
+	r.Reporter = "beeb"
+	require.Equal(t, verifier.ErrInvalidReporter, r.VerifySignature())
+}
+
+func TestVerifyCache(t *testing.T) {
+	client := &mockQueryClient{}
+	v, err := verifier.NewVerifier("odin", client, 10, time.Minute)
+	require.NoError(t, err)
+
+	r := signedRequest(t, "odin")
+	res, err := v.Verify(context.Background(), r)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.DataSourceId)
+	_, err = v.Verify(context.Background(), r)
+	require.NoError(t, err)
+	require.Equal(t, 1, client.called)
+}
+
+func TestVerifyRejected(t *testing.T) {
+	client := &mockQueryClient{err: errors.New("not an authorized reporter")}
+	v, err := verifier.NewVerifier("odin", client, 10, time.Minute)
+	require.NoError(t, err)
+
+	_, err = v.Verify(context.Background(), signedRequest(t, "other-chain"))
+	require.Equal(t, verifier.ErrChainIDMismatch, err)
+	require.Equal(t, 0, client.called)
+
+	r := signedRequest(t, "odin")
+	_, err = v.Verify(context.Background(), r)
+	require.True(t, errors.Is(err, verifier.ErrVerificationQuery))
+	// Failed verifications are not cached.
+	_, err = v.Verify(context.Background(), r)
+	require.Error(t, err)
+	require.Equal(t, 2, client.called)
+}
+
+func TestMiddleware(t *testing.T) {
+	v, err := verifier.NewVerifier("odin", &mockQueryClient{}, 10, time.Minute)
+	require.NoError(t, err)
+	handler := verifier.Middleware(v, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		res, ok := verifier.FromContext(req.Context())
+		require.True(t, ok)
+		require.Equal(t, uint64(42), res.RequestId)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/price", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/price", nil)
+	setHeaders(req.Header, signedRequest(t, "odin"))
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+}
@@ -94,6 +94,7 @@ func (k Keeper) StartAuction(ctx sdk.Context) error {
 	}
 	status.Pending = true
 	k.SetAuctionStatus(ctx, status)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(auctiontypes.EventTypeStartAuction))
 
 	return nil
 }
@@ -106,6 +107,7 @@ func (k Keeper) FinishAuction(ctx sdk.Context) error {
 	}
 	status.Pending = false
 	k.SetAuctionStatus(ctx, status)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(auctiontypes.EventTypeFinishAuction))
 
 	return nil
 }
@@ -1,7 +1,9 @@
 package types
 
 const (
-	EventTypeBuyCoins = "buy_coins"
+	EventTypeBuyCoins      = "buy_coins"
+	EventTypeStartAuction  = "start_auction"
+	EventTypeFinishAuction = "finish_auction"
 )
 
 const (
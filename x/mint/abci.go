@@ -17,6 +17,19 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	minter := k.GetMinter(ctx)
 	params := k.GetParams(ctx)
 
+	// reset the mint volume of the denoms whose window has passed
+	if reset := minter.ResetExpiredMintVolume(ctx.BlockHeight(), params.MintVolumeWindow); !reset.IsZero() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				minttypes.EventTypeResetMintVolume,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, reset.String()),
+			),
+		)
+	}
+
+	// open the windows of the denoms minted while the mint volume window was disabled
+	minter.OpenMintVolumeWindows(ctx.BlockHeight(), ctx.BlockTime(), params.MintVolumeWindow)
+
 	// recalculate inflation rate
 	totalStakingSupply := k.StakingTokenSupply(ctx)
 	bondedRatio := k.BondedRatio(ctx)
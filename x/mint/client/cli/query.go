@@ -26,6 +26,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryIntegrationAddress(),
 		GetCmdQueryTreasuryPool(),
 		GetCmdQueryCurrentMintVolume(),
+		GetCmdQueryTreasuryWithdrawals(),
 	)
 
 	return mintingQueryCmd
@@ -201,7 +202,7 @@ func GetCmdQueryCurrentMintVolume() *cobra.Command {
 				return err
 			}
 
-			return clientCtx.PrintString(fmt.Sprintf("%s\n", res.CurrentMintVolume))
+			return clientCtx.PrintProto(res)
 		},
 	}
 
@@ -209,3 +210,43 @@ func GetCmdQueryCurrentMintVolume() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryTreasuryWithdrawals returns the command for getting the history of treasury withdrawals
+func GetCmdQueryTreasuryWithdrawals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury-withdrawals",
+		Short: "Query the history of withdrawals from the treasury pool",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := minttypes.NewQueryClient(clientCtx)
+
+			receiver, err := cmd.Flags().GetString(flagReceiver)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &minttypes.QueryTreasuryWithdrawalsRequest{Receiver: receiver, Pagination: pageReq}
+			res, err := queryClient.TreasuryWithdrawals(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagReceiver, "", "Filter the withdrawals by the receiving account")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "treasury withdrawals")
+
+	return cmd
+}
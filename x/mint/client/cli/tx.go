@@ -1,20 +1,34 @@
 package cli
 
 import (
+	"fmt"
+
 	minttypes "github.com/GeoDB-Limited/odin-core/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cobra"
 )
 
 const (
-	flagReceiver = "receiver"
-	flagAmount   = "amount"
+	flagReceiver        = "receiver"
+	flagAmount          = "amount"
+	flagVesting         = "vesting"
+	flagVestingCliff    = "vesting-cliff"
+	flagVestingDuration = "vesting-duration"
+	flagVestingPeriods  = "vesting-periods"
 )
 
+// vestingTypes maps the values of the vesting flag to the vesting types
+var vestingTypes = map[string]minttypes.VestingType{
+	"continuous": minttypes.VestingTypeContinuous,
+	"periodic":   minttypes.VestingTypePeriodic,
+}
+
 // NewTxCmd returns a root CLI command handler for all x/mint transaction commands.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -61,7 +75,12 @@ func NewCmdWithdrawCoinsToAccFromTreasury() *cobra.Command {
 				return sdkerrors.Wrapf(err, "amount: %s", amountStr)
 			}
 
-			msg := minttypes.NewMsgWithdrawCoinsToAccFromTreasury(amount, receiver, clientCtx.GetFromAddress())
+			vesting, err := parseVestingSchedule(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := minttypes.NewMsgWithdrawCoinsToAccFromTreasury(amount, receiver, clientCtx.GetFromAddress(), vesting)
 			if err := msg.ValidateBasic(); err != nil {
 				return sdkerrors.Wrapf(err, "amount: %s receiver: %s", amount, receiverStr)
 			}
@@ -72,6 +91,7 @@ func NewCmdWithdrawCoinsToAccFromTreasury() *cobra.Command {
 
 	cmd.Flags().String(flagReceiver, "", "Account address to withdraw coins to")
 	cmd.Flags().String(flagAmount, "", "Amount of coins to withdraw")
+	addVestingFlags(cmd)
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -107,3 +127,106 @@ func NewCmdMintCoins() *cobra.Command {
 
 	return cmd
 }
+
+// NewCmdSubmitTreasurySpendProposal implements the command to submit a treasury spend proposal.
+func NewCmdSubmitTreasurySpendProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury-spend [recipient] [amount] [rationale]",
+		Short: "Submit a treasury spend proposal",
+		Long: "Submit a proposal to spend coins from the treasury pool to the recipient. " +
+			"Spends above the withdrawal limit per time can only be made through this proposal.",
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrapf(err, "recipient: %s", args[0])
+			}
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrapf(err, "amount: %s", args[1])
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "flag: %s", govcli.FlagTitle)
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "flag: %s", govcli.FlagDescription)
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "flag: %s", govcli.FlagDeposit)
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "deposit: %s", depositStr)
+			}
+
+			vesting, err := parseVestingSchedule(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := minttypes.NewTreasurySpendProposal(title, description, recipient, amount, args[2], vesting)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return sdkerrors.Wrapf(err, "recipient: %s amount: %s", args[0], amount)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "Title of the proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "Description of the proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "Deposit of the proposal")
+	addVestingFlags(cmd)
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// addVestingFlags adds the flags to disburse treasury coins into a vesting account.
+func addVestingFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagVesting, "", "Vesting account type to disburse the coins into (continuous|periodic), liquid if unset")
+	cmd.Flags().Duration(flagVestingCliff, 0, "Time after the disbursement before the coins start vesting")
+	cmd.Flags().Duration(flagVestingDuration, 0, "Time the coins vest over after the cliff")
+	cmd.Flags().Uint32(flagVestingPeriods, 0, "Number of equal periods of a periodic vesting")
+}
+
+// parseVestingSchedule returns the vesting schedule given by the vesting flags, nil if the coins should be liquid.
+func parseVestingSchedule(cmd *cobra.Command) (*minttypes.VestingSchedule, error) {
+	vestingStr, err := cmd.Flags().GetString(flagVesting)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "flag: %s", flagVesting)
+	}
+	if vestingStr == "" {
+		return nil, nil
+	}
+	vestingType, ok := vestingTypes[vestingStr]
+	if !ok {
+		return nil, fmt.Errorf("unsupported vesting type: %s", vestingStr)
+	}
+	cliff, err := cmd.Flags().GetDuration(flagVestingCliff)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "flag: %s", flagVestingCliff)
+	}
+	duration, err := cmd.Flags().GetDuration(flagVestingDuration)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "flag: %s", flagVestingDuration)
+	}
+	periods, err := cmd.Flags().GetUint32(flagVestingPeriods)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "flag: %s", flagVestingPeriods)
+	}
+
+	return minttypes.NewVestingSchedule(vestingType, cliff, duration, periods), nil
+}
@@ -0,0 +1,10 @@
+package client
+
+import (
+	"github.com/GeoDB-Limited/odin-core/x/mint/client/cli"
+	"github.com/GeoDB-Limited/odin-core/x/mint/client/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+// ProposalHandler is the treasury spend proposal handler.
+var ProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitTreasurySpendProposal, rest.ProposalRESTHandler)
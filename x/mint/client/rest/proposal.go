@@ -0,0 +1,61 @@
+package rest
+
+import (
+	"net/http"
+
+	minttypes "github.com/GeoDB-Limited/odin-core/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// TreasurySpendProposalReq defines a treasury spend proposal request body.
+type TreasurySpendProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string                     `json:"title" yaml:"title"`
+	Description string                     `json:"description" yaml:"description"`
+	Recipient   sdk.AccAddress             `json:"recipient" yaml:"recipient"`
+	Amount      sdk.Coins                  `json:"amount" yaml:"amount"`
+	Rationale   string                     `json:"rationale" yaml:"rationale"`
+	Vesting     *minttypes.VestingSchedule `json:"vesting" yaml:"vesting"`
+	Proposer    sdk.AccAddress             `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins                  `json:"deposit" yaml:"deposit"`
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the treasury spend REST handler with a given sub-route.
+func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "treasury_spend",
+		Handler:  postTreasurySpendProposalHandlerFn(clientCtx),
+	}
+}
+
+func postTreasurySpendProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req TreasurySpendProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := minttypes.NewTreasurySpendProposal(req.Title, req.Description, req.Recipient, req.Amount, req.Rationale, req.Vesting)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
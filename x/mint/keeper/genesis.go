@@ -32,6 +32,17 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data *minttypes.GenesisState) {
 	}
 
 	keeper.SetMintPool(ctx, data.MintPool)
+
+	for _, withdrawal := range data.AccountWithdrawals {
+		keeper.SetAccountWithdrawal(ctx, withdrawal)
+	}
+
+	for _, withdrawal := range data.TreasuryWithdrawals {
+		keeper.SetTreasuryWithdrawal(ctx, withdrawal)
+		if withdrawal.ID > keeper.GetTreasuryWithdrawalCount(ctx) {
+			keeper.SetTreasuryWithdrawalCount(ctx, withdrawal.ID)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -40,5 +51,9 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) *minttypes.GenesisState {
 	params := keeper.GetParams(ctx)
 	mintPool := keeper.GetMintPool(ctx)
 	mintModuleCoinsAccount := keeper.GetMintModuleCoinsAccount(ctx)
-	return minttypes.NewGenesisState(minter, params, mintPool, mintModuleCoinsAccount)
+	accountWithdrawals := keeper.GetAccountWithdrawals(ctx)
+	treasuryWithdrawals := keeper.GetTreasuryWithdrawals(ctx)
+	return minttypes.NewGenesisState(
+		minter, params, mintPool, mintModuleCoinsAccount, accountWithdrawals, treasuryWithdrawals,
+	)
 }
@@ -107,6 +107,79 @@ func (k Keeper) SetMintPool(ctx sdk.Context, mintPool minttypes.MintPool) {
 	store.Set(minttypes.MintPoolStoreKey, b)
 }
 
+// GetAccountWithdrawal returns the treasury withdrawal of the account in its last withdrawal period
+func (k Keeper) GetAccountWithdrawal(ctx sdk.Context, addr sdk.AccAddress) minttypes.AccountWithdrawal {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(minttypes.AccountWithdrawalStoreKey(addr))
+	if b == nil {
+		return minttypes.NewAccountWithdrawal(addr, 0, sdk.NewCoins())
+	}
+
+	var withdrawal minttypes.AccountWithdrawal
+	k.cdc.MustUnmarshal(b, &withdrawal)
+	return withdrawal
+}
+
+// SetAccountWithdrawal sets the treasury withdrawal of the account to the store
+func (k Keeper) SetAccountWithdrawal(ctx sdk.Context, withdrawal minttypes.AccountWithdrawal) {
+	addr, err := sdk.AccAddressFromBech32(withdrawal.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&withdrawal)
+	store.Set(minttypes.AccountWithdrawalStoreKey(addr), b)
+}
+
+// GetAccountWithdrawals returns the treasury withdrawals of all the accounts
+func (k Keeper) GetAccountWithdrawals(ctx sdk.Context) (withdrawals []minttypes.AccountWithdrawal) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), minttypes.AccountWithdrawalKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var withdrawal minttypes.AccountWithdrawal
+		k.cdc.MustUnmarshal(iterator.Value(), &withdrawal)
+		withdrawals = append(withdrawals, withdrawal)
+	}
+	return withdrawals
+}
+
+// GetTreasuryWithdrawalCount returns the number of withdrawals from the treasury pool
+func (k Keeper) GetTreasuryWithdrawalCount(ctx sdk.Context) uint64 {
+	b := ctx.KVStore(k.storeKey).Get(minttypes.TreasuryWithdrawalCountKey)
+	if b == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(b)
+}
+
+// SetTreasuryWithdrawalCount sets the number of withdrawals from the treasury pool to the store
+func (k Keeper) SetTreasuryWithdrawalCount(ctx sdk.Context, count uint64) {
+	ctx.KVStore(k.storeKey).Set(minttypes.TreasuryWithdrawalCountKey, sdk.Uint64ToBigEndian(count))
+}
+
+// SetTreasuryWithdrawal sets the withdrawal from the treasury pool to the store
+func (k Keeper) SetTreasuryWithdrawal(ctx sdk.Context, withdrawal minttypes.TreasuryWithdrawal) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&withdrawal)
+	store.Set(minttypes.TreasuryWithdrawalStoreKey(withdrawal.ID), b)
+}
+
+// GetTreasuryWithdrawals returns the history of withdrawals from the treasury pool
+func (k Keeper) GetTreasuryWithdrawals(ctx sdk.Context) (withdrawals []minttypes.TreasuryWithdrawal) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), minttypes.TreasuryWithdrawalKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var withdrawal minttypes.TreasuryWithdrawal
+		k.cdc.MustUnmarshal(iterator.Value(), &withdrawal)
+		withdrawals = append(withdrawals, withdrawal)
+	}
+	return withdrawals
+}
+
 // GetParams returns the total set of minting parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params minttypes.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -163,6 +236,29 @@ func (k Keeper) LimitExceeded(ctx sdk.Context, amt sdk.Coins) bool {
 	return amt.IsAnyGT(moduleParams.MaxWithdrawalPerTime)
 }
 
+// WithdrawalPeriodLimitExceeded checks if withdrawal amount exceeds the limit of the account in its withdrawal period
+func (k Keeper) WithdrawalPeriodLimitExceeded(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) bool {
+	moduleParams := k.GetParams(ctx)
+	if moduleParams.WithdrawalPeriod == 0 {
+		return false
+	}
+
+	withdrawal := k.GetAccountWithdrawal(ctx, addr).At(ctx.BlockHeight(), moduleParams.WithdrawalPeriod)
+	return withdrawal.Amount.Add(amt...).IsAnyGT(moduleParams.MaxWithdrawalPerPeriod)
+}
+
+// AddAccountWithdrawal accounts the withdrawal amount to the withdrawal period of the account
+func (k Keeper) AddAccountWithdrawal(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) {
+	moduleParams := k.GetParams(ctx)
+	if moduleParams.WithdrawalPeriod == 0 {
+		return
+	}
+
+	withdrawal := k.GetAccountWithdrawal(ctx, addr).At(ctx.BlockHeight(), moduleParams.WithdrawalPeriod)
+	withdrawal.Amount = withdrawal.Amount.Add(amt...)
+	k.SetAccountWithdrawal(ctx, withdrawal)
+}
+
 // IsEligibleAccount checks if addr exists in the eligible to withdraw account pool
 func (k Keeper) IsEligibleAccount(ctx sdk.Context, addr string) bool {
 	params := k.GetParams(ctx)
@@ -176,12 +272,19 @@ func (k Keeper) IsEligibleAccount(ctx sdk.Context, addr string) bool {
 	return false
 }
 
-// WithdrawCoinsFromTreasury transfers coins from treasury pool to receiver account
-func (k Keeper) WithdrawCoinsFromTreasury(ctx sdk.Context, receiver sdk.AccAddress, amount sdk.Coins) error {
+// WithdrawCoinsFromTreasury transfers coins from treasury pool to receiver account, vesting them on the schedule if
+// one is given, and records the withdrawal in the history
+func (k Keeper) WithdrawCoinsFromTreasury(
+	ctx sdk.Context,
+	sender string,
+	receiver sdk.AccAddress,
+	amount sdk.Coins,
+	vesting *minttypes.VestingSchedule,
+) (minttypes.TreasuryWithdrawal, error) {
 	mintPool := k.GetMintPool(ctx)
 
-	if amount.IsAllGT(mintPool.TreasuryPool) {
-		return sdkerrors.Wrapf(
+	if !mintPool.TreasuryPool.IsAllGTE(amount) {
+		return minttypes.TreasuryWithdrawal{}, sdkerrors.Wrapf(
 			minttypes.ErrWithdrawalAmountExceedsModuleBalance,
 			"withdrawal amount: %s exceeds %s module balance",
 			amount.String(),
@@ -189,8 +292,14 @@ func (k Keeper) WithdrawCoinsFromTreasury(ctx sdk.Context, receiver sdk.AccAddre
 		)
 	}
 
+	if vesting != nil {
+		if err := k.addVestingGrant(ctx, receiver, amount, vesting); err != nil {
+			return minttypes.TreasuryWithdrawal{}, err
+		}
+	}
+
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, receiver, amount); err != nil {
-		return sdkerrors.Wrapf(
+		return minttypes.TreasuryWithdrawal{}, sdkerrors.Wrapf(
 			err,
 			"failed to withdraw %s from %s module account",
 			amount.String(),
@@ -201,6 +310,39 @@ func (k Keeper) WithdrawCoinsFromTreasury(ctx sdk.Context, receiver sdk.AccAddre
 	mintPool.TreasuryPool = mintPool.TreasuryPool.Sub(amount)
 	k.SetMintPool(ctx, mintPool)
 
+	withdrawal := minttypes.TreasuryWithdrawal{
+		ID:       k.GetTreasuryWithdrawalCount(ctx) + 1,
+		Sender:   sender,
+		Receiver: receiver.String(),
+		Amount:   amount,
+		Vesting:  vesting,
+		Height:   ctx.BlockHeight(),
+		Time:     ctx.BlockTime(),
+	}
+	k.SetTreasuryWithdrawal(ctx, withdrawal)
+	k.SetTreasuryWithdrawalCount(ctx, withdrawal.ID)
+
+	return withdrawal, nil
+}
+
+// addVestingGrant makes the receiver account vest the amount on the schedule from now, creating the account if it
+// does not exist yet. An existing account keeps its balance and what it already vests.
+func (k Keeper) addVestingGrant(
+	ctx sdk.Context,
+	receiver sdk.AccAddress,
+	amount sdk.Coins,
+	vesting *minttypes.VestingSchedule,
+) error {
+	account := k.authKeeper.GetAccount(ctx, receiver)
+	if account == nil {
+		account = k.authKeeper.NewAccountWithAddress(ctx, receiver)
+	}
+
+	vestingAccount, err := vesting.AddToAccount(account, amount, ctx.BlockTime())
+	if err != nil {
+		return sdkerrors.Wrapf(err, "account: %s", receiver)
+	}
+	k.authKeeper.SetAccount(ctx, vestingAccount)
 	return nil
 }
 
@@ -255,7 +397,7 @@ func (k Keeper) MintNewCoins(ctx sdk.Context, amount sdk.Coins) error {
 	mintPool.TreasuryPool = mintPool.TreasuryPool.Add(amount...)
 	k.SetMintPool(ctx, mintPool)
 
-	minter.CurrentMintVolume = minter.CurrentMintVolume.Add(amount...)
+	minter.AddMintVolume(amount, ctx.BlockHeight(), ctx.BlockTime(), k.GetParams(ctx).MintVolumeWindow)
 	k.SetMinter(ctx, minter)
 
 	return nil
@@ -0,0 +1,38 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/GeoDB-Limited/odin-core/x/common/testapp"
+	minttypes "github.com/GeoDB-Limited/odin-core/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestWithdrawCoinsFromTreasuryExceedsPool(t *testing.T) {
+	app, ctx, _ := testapp.CreateTestInput(true)
+	ctx = ctx.WithBlockTime(time.Unix(1600000000, 0))
+	treasury := sdk.NewCoins(sdk.NewInt64Coin("loki", 1000), sdk.NewInt64Coin("minigeo", 1000))
+
+	// Mint the treasury directly, the wrapped bank keeper takes minted coins from the community pool.
+	require.NoError(t, app.BankKeeper.Keeper.MintCoins(ctx, minttypes.ModuleName, treasury))
+	mintPool := app.MintKeeper.GetMintPool(ctx)
+	mintPool.TreasuryPool = mintPool.TreasuryPool.Add(treasury...)
+	app.MintKeeper.SetMintPool(ctx, mintPool)
+
+	recipient := sdk.AccAddress("recipient___________")
+	vesting := minttypes.NewVestingSchedule(minttypes.VestingTypePeriodic, 0, 100*time.Second, 2)
+	for _, amount := range []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin("loki", 500), sdk.NewInt64Coin("minigeo", 1500)),
+		sdk.NewCoins(sdk.NewInt64Coin("loki", 500), sdk.NewInt64Coin("odin", 1)),
+	} {
+		_, err := app.MintKeeper.WithdrawCoinsFromTreasury(ctx, "sender", recipient, amount, vesting)
+		require.ErrorIs(t, err, minttypes.ErrWithdrawalAmountExceedsModuleBalance)
+		require.Nil(t, app.AccountKeeper.GetAccount(ctx, recipient))
+		require.True(t, app.BankKeeper.GetAllBalances(ctx, recipient).IsZero())
+		require.Equal(t, mintPool, app.MintKeeper.GetMintPool(ctx))
+		require.Zero(t, app.MintKeeper.GetTreasuryWithdrawalCount(ctx))
+	}
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	minttypes "github.com/GeoDB-Limited/odin-core/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. It sets the mint volume window to its default, keeps the treasury
+// withdrawal limits if they are already set and disables them otherwise, and opens a mint volume window for each
+// denom already minted, so their current mint volume resets once the window passes.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaults := minttypes.DefaultParams()
+	m.keeper.paramSpace.Set(ctx, minttypes.KeyMintVolumeWindow, defaults.MintVolumeWindow)
+
+	var withdrawalPeriod uint64
+	maxWithdrawalPerPeriod := sdk.NewCoins()
+	m.keeper.paramSpace.GetIfExists(ctx, minttypes.KeyWithdrawalPeriod, &withdrawalPeriod)
+	m.keeper.paramSpace.GetIfExists(ctx, minttypes.KeyMaxWithdrawalPerPeriod, &maxWithdrawalPerPeriod)
+	m.keeper.paramSpace.Set(ctx, minttypes.KeyWithdrawalPeriod, withdrawalPeriod)
+	m.keeper.paramSpace.Set(ctx, minttypes.KeyMaxWithdrawalPerPeriod, maxWithdrawalPerPeriod)
+
+	minter := m.keeper.GetMinter(ctx)
+	minter.MintVolumeWindows = nil
+	minter.OpenMintVolumeWindows(ctx.BlockHeight(), ctx.BlockTime(), defaults.MintVolumeWindow)
+	m.keeper.SetMinter(ctx, minter)
+	return nil
+}
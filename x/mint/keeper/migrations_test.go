@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/GeoDB-Limited/odin-core/x/common/testapp"
+	mintkeeper "github.com/GeoDB-Limited/odin-core/x/mint/keeper"
+	minttypes "github.com/GeoDB-Limited/odin-core/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	app, ctx, _ := testapp.CreateTestInput(true)
+	blockTime := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeight(100).WithBlockTime(blockTime)
+
+	params := app.MintKeeper.GetParams(ctx)
+	params.MintVolumeWindow = 0
+	params.WithdrawalPeriod = 42
+	params.MaxWithdrawalPerPeriod = sdk.NewCoins(sdk.NewInt64Coin("minigeo", 5))
+	app.MintKeeper.SetParams(ctx, params)
+	minter := app.MintKeeper.GetMinter(ctx)
+	minter.CurrentMintVolume = sdk.NewCoins(sdk.NewInt64Coin("loki", 10), sdk.NewInt64Coin("minigeo", 20))
+	minter.MintVolumeWindows = nil
+	app.MintKeeper.SetMinter(ctx, minter)
+
+	require.NoError(t, mintkeeper.NewMigrator(app.MintKeeper).Migrate1to2(ctx))
+
+	params = app.MintKeeper.GetParams(ctx)
+	require.Equal(t, minttypes.DefaultParams().MintVolumeWindow, params.MintVolumeWindow)
+	require.Equal(t, uint64(42), params.WithdrawalPeriod)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("minigeo", 5)), params.MaxWithdrawalPerPeriod)
+	require.Equal(t, []minttypes.MintVolumeWindow{
+		{Denom: "loki", StartHeight: 100, StartTime: blockTime},
+		{Denom: "minigeo", StartHeight: 100, StartTime: blockTime},
+	}, app.MintKeeper.GetMinter(ctx).MintVolumeWindows)
+}
+
+func TestMigrate1to2DisablesMissingWithdrawalLimit(t *testing.T) {
+	app, ctx, _ := testapp.CreateTestInput(true)
+
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(minttypes.ModuleName+"/"))
+	store.Delete(minttypes.KeyMintVolumeWindow)
+	store.Delete(minttypes.KeyWithdrawalPeriod)
+	store.Delete(minttypes.KeyMaxWithdrawalPerPeriod)
+
+	require.NoError(t, mintkeeper.NewMigrator(app.MintKeeper).Migrate1to2(ctx))
+
+	params := app.MintKeeper.GetParams(ctx)
+	require.Equal(t, minttypes.DefaultParams().MintVolumeWindow, params.MintVolumeWindow)
+	require.Zero(t, params.WithdrawalPeriod)
+	require.True(t, params.MaxWithdrawalPerPeriod.IsZero())
+	require.False(t, app.MintKeeper.WithdrawalPeriodLimitExceeded(ctx, testapp.Alice.Address, sdk.NewCoins(sdk.NewInt64Coin("loki", 1000000))))
+}
@@ -30,7 +30,20 @@ func (k msgServer) WithdrawCoinsToAccFromTreasury(
 	}
 
 	if k.LimitExceeded(ctx, msg.Amount) {
-		return nil, sdkerrors.Wrapf(minttypes.ErrExceedsWithdrawalLimitPerTime, "amount: %s", msg.Amount.String())
+		return nil, sdkerrors.Wrapf(
+			minttypes.ErrExceedsWithdrawalLimitPerTime,
+			"amount: %s, larger spends require a treasury spend proposal",
+			msg.Amount.String(),
+		)
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to parse sender address %s", msg.Sender)
+	}
+
+	if k.WithdrawalPeriodLimitExceeded(ctx, sender, msg.Amount) {
+		return nil, sdkerrors.Wrapf(minttypes.ErrExceedsWithdrawalLimitPerPeriod, "amount: %s", msg.Amount.String())
 	}
 
 	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
@@ -38,16 +51,14 @@ func (k msgServer) WithdrawCoinsToAccFromTreasury(
 		return nil, sdkerrors.Wrapf(err, "failed to parse receiver address %s", msg.Receiver)
 	}
 
-	if err := k.WithdrawCoinsFromTreasury(ctx, receiver, msg.Amount); err != nil {
+	withdrawal, err := k.WithdrawCoinsFromTreasury(ctx, msg.Sender, receiver, msg.Amount, msg.Vesting)
+	if err != nil {
 		return nil, sdkerrors.Wrapf(err, "failed to mint %s coins to account %s", msg.Amount, msg.Receiver)
 	}
 
-	ctx.EventManager().EmitEvent(sdk.NewEvent(
-		minttypes.EventTypeWithdrawal,
-		sdk.NewAttribute(minttypes.AttributeKeyWithdrawalAmount, msg.Amount.String()),
-		sdk.NewAttribute(minttypes.AttributeKeyReceiver, msg.Receiver),
-		sdk.NewAttribute(minttypes.AttributeKeySender, msg.Sender),
-	))
+	k.AddAccountWithdrawal(ctx, sender, msg.Amount)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(minttypes.EventTypeWithdrawal, withdrawal.Attributes()...))
 
 	return &minttypes.MsgWithdrawCoinsToAccFromTreasuryResponse{}, nil
 }
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	minttypes "github.com/GeoDB-Limited/odin-core/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// HandleTreasurySpendProposal is a handler for executing a passed treasury spend proposal
+func HandleTreasurySpendProposal(ctx sdk.Context, k Keeper, p *minttypes.TreasurySpendProposal) error {
+	recipient, err := sdk.AccAddressFromBech32(p.Recipient)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to parse recipient address %s", p.Recipient)
+	}
+
+	withdrawal, err := k.WithdrawCoinsFromTreasury(ctx, "", recipient, p.Amount, p.Vesting)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to spend %s coins to account %s", p.Amount, p.Recipient)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(minttypes.EventTypeTreasurySpend, withdrawal.Attributes()...))
+
+	k.Logger(ctx).Info("transferred from the treasury pool to recipient", "amount", p.Amount.String(), "recipient", p.Recipient)
+	return nil
+}
@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/GeoDB-Limited/odin-core/x/common/testapp"
+	mintkeeper "github.com/GeoDB-Limited/odin-core/x/mint/keeper"
+	minttypes "github.com/GeoDB-Limited/odin-core/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestHandleTreasurySpendProposalFundedRecipient(t *testing.T) {
+	app, ctx, _ := testapp.CreateTestInput(true)
+	ctx = ctx.WithBlockTime(time.Unix(1600000000, 0))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("loki", 1000))
+	dust := sdk.NewCoins(sdk.NewInt64Coin("loki", 1))
+
+	// Mint the treasury directly, the wrapped bank keeper takes minted coins from the community pool.
+	require.NoError(t, app.BankKeeper.Keeper.MintCoins(ctx, minttypes.ModuleName, amount.Add(amount...)))
+	mintPool := app.MintKeeper.GetMintPool(ctx)
+	mintPool.TreasuryPool = mintPool.TreasuryPool.Add(amount.Add(amount...)...)
+	app.MintKeeper.SetMintPool(ctx, mintPool)
+
+	// Anyone can fund the recipient between the submission and the execution of the proposal.
+	recipient := sdk.AccAddress("recipient___________")
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, testapp.Alice.Address, recipient, dust))
+
+	vesting := minttypes.NewVestingSchedule(minttypes.VestingTypePeriodic, 0, 100*time.Second, 2)
+	proposal := minttypes.NewTreasurySpendProposal("title", "description", recipient, amount, "rationale", vesting)
+	require.NoError(t, mintkeeper.HandleTreasurySpendProposal(ctx, app.MintKeeper, proposal))
+
+	account, ok := app.AccountKeeper.GetAccount(ctx, recipient).(*vestingtypes.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, amount, account.OriginalVesting)
+	require.Equal(t, amount.Add(dust...), app.BankKeeper.GetAllBalances(ctx, recipient))
+	require.Equal(t, dust, app.BankKeeper.SpendableCoins(ctx, recipient))
+
+	// A later grant to the same recipient is added to its vesting periods.
+	require.NoError(t, mintkeeper.HandleTreasurySpendProposal(ctx, app.MintKeeper, proposal))
+	account, ok = app.AccountKeeper.GetAccount(ctx, recipient).(*vestingtypes.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, amount.Add(amount...), account.OriginalVesting)
+	require.Equal(t, dust, app.BankKeeper.SpendableCoins(ctx, recipient))
+}
@@ -3,7 +3,11 @@ package keeper
 import (
 	"context"
 	minttypes "github.com/GeoDB-Limited/odin-core/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ minttypes.QueryServer = Keeper{}
@@ -67,6 +71,49 @@ func (k Keeper) CurrentMintVolume(
 ) (*minttypes.QueryCurrentMintVolumeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+
+	return &minttypes.QueryCurrentMintVolumeResponse{
+		CurrentMintVolume:   minter.CurrentMintVolume,
+		MintVolumeWindows:   minter.MintVolumeWindows,
+		RemainingMintVolume: minter.RemainingMintVolume(params.MaxAllowedMintVolume),
+	}, nil
+}
+
+// TreasuryWithdrawals returns the history of withdrawals from the treasury pool
+func (k Keeper) TreasuryWithdrawals(
+	c context.Context,
+	req *minttypes.QueryTreasuryWithdrawalsRequest,
+) (*minttypes.QueryTreasuryWithdrawalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Receiver != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Receiver); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid receiver address: %s", req.Receiver)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), minttypes.TreasuryWithdrawalKeyPrefix)
+
+	var withdrawals []minttypes.TreasuryWithdrawal
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var withdrawal minttypes.TreasuryWithdrawal
+		if err := k.cdc.Unmarshal(value, &withdrawal); err != nil {
+			return false, err
+		}
+		if req.Receiver != "" && withdrawal.Receiver != req.Receiver {
+			return false, nil
+		}
+		if accumulate {
+			withdrawals = append(withdrawals, withdrawal)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	return &minttypes.QueryCurrentMintVolumeResponse{CurrentMintVolume: minter.CurrentMintVolume}, nil
+	return &minttypes.QueryTreasuryWithdrawalsResponse{Withdrawals: withdrawals, Pagination: pageRes}, nil
 }
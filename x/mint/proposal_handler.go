@@ -0,0 +1,21 @@
+package mint
+
+import (
+	mintkeeper "github.com/GeoDB-Limited/odin-core/x/mint/keeper"
+	minttypes "github.com/GeoDB-Limited/odin-core/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewTreasurySpendProposalHandler creates the handler of treasury spend proposals, routed by the gov module.
+func NewTreasurySpendProposalHandler(k mintkeeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *minttypes.TreasurySpendProposal:
+			return mintkeeper.HandleTreasurySpendProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", minttypes.ModuleName, c)
+		}
+	}
+}
@@ -95,6 +95,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 	maxAllowedMintVolume := sdk.Coins{sdk.NewCoin("minigeo", sdk.NewInt(100000000))}
 	allowedMintDenoms := []string{"minigeo"}
 	allowedMinter := []string{"odin1pl07tk6hcpp2an3rug75as4dfgd743qp80g63g"}
+	mintVolumeWindow := uint64(17280)
+	withdrawalPeriod := uint64(17280)
+	maxWithdrawalPerPeriod := sdk.Coins{sdk.NewCoin("loki", sdk.NewInt(1000))}
 
 	params := minttypes.NewParams(
 		mintDenom,
@@ -110,8 +113,11 @@ func RandomizedGenState(simState *module.SimulationState) {
 		maxAllowedMintVolume,
 		allowedMintDenoms,
 		allowedMinter,
+		mintVolumeWindow,
+		withdrawalPeriod,
+		maxWithdrawalPerPeriod,
 	)
-	mintGenesis := minttypes.NewGenesisState(minttypes.InitialMinter(inflation), params, minttypes.InitialMintPool(), sdk.AccAddress("odin13jp4udqlxknzrpsk9jkr3hpmp6gy242xm0s2kq"))
+	mintGenesis := minttypes.NewGenesisState(minttypes.InitialMinter(inflation), params, minttypes.InitialMintPool(), sdk.AccAddress("odin13jp4udqlxknzrpsk9jkr3hpmp6gy242xm0s2kq"), nil, nil)
 
 	bz, err := json.MarshalIndent(&mintGenesis, "", " ")
 	if err != nil {
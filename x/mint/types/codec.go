@@ -6,12 +6,14 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/staking interfaces and concrete types
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWithdrawCoinsToAccFromTreasury{}, "mint/WithdrawCoinsToAccFromTreasury", nil)
+	cdc.RegisterConcrete(&TreasurySpendProposal{}, "mint/TreasurySpendProposal", nil)
 }
 
 // RegisterInterfaces register the mint module interfaces to protobuf Any.
@@ -19,6 +21,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgWithdrawCoinsToAccFromTreasury{},
 	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&TreasurySpendProposal{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
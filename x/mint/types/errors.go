@@ -9,4 +9,7 @@ var (
 	ErrExceedsWithdrawalLimitPerTime        = sdkerrors.Register(ModuleName, 124, "The given amount exceeds the withdrawal limit per time")
 	ErrWithdrawalAmountExceedsModuleBalance = sdkerrors.Register(ModuleName, 125, "The given amount to withdraw exceeds module balance")
 	ErrMintVolumeExceedsLimit               = sdkerrors.Register(ModuleName, 126, "The given volume to mint exceeds allowed mint volume")
+	ErrExceedsWithdrawalLimitPerPeriod      = sdkerrors.Register(ModuleName, 127, "The given amount exceeds the withdrawal limit per period of the account")
+	ErrInvalidVestingSchedule               = sdkerrors.Register(ModuleName, 128, "The given vesting schedule is invalid")
+	ErrInvalidVestingAccount                = sdkerrors.Register(ModuleName, 129, "The given account cannot receive vesting coins")
 )
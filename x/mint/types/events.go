@@ -1,9 +1,11 @@
 package types
 
 const (
-	EventTypeMint       = ModuleName
-	EventTypeWithdrawal = "withdrawal"
-	EventTypeMinting    = "minting"
+	EventTypeMint            = ModuleName
+	EventTypeWithdrawal      = "withdrawal"
+	EventTypeMinting         = "minting"
+	EventTypeResetMintVolume = "reset_mint_volume"
+	EventTypeTreasurySpend   = "treasury_spend"
 
 	AttributeKeyBondedRatio      = "bonded_ratio"
 	AttributeKeyInflation        = "inflation"
@@ -12,4 +14,10 @@ const (
 	AttributeKeyReceiver         = "receiver"
 	AttributeKeyWithdrawalAmount = "withdrawal_amount"
 	AttributeKeyMintingVolume    = "minting_volume"
+	AttributeKeyWithdrawalID     = "withdrawal_id"
+	AttributeKeyVestingType      = "vesting_type"
+	AttributeKeyVestingCliff     = "vesting_cliff"
+	AttributeKeyVestingDuration  = "vesting_duration"
+	AttributeKeyVestingPeriods   = "vesting_periods"
+	AttributeKeyVestingEndTime   = "vesting_end_time"
 )
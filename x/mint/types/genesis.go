@@ -3,12 +3,21 @@ package types
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(minter Minter, params Params, mintPool MintPool, mintModuleCoinsAccount sdk.AccAddress) *GenesisState {
+func NewGenesisState(
+	minter Minter,
+	params Params,
+	mintPool MintPool,
+	mintModuleCoinsAccount sdk.AccAddress,
+	accountWithdrawals []AccountWithdrawal,
+	treasuryWithdrawals []TreasuryWithdrawal,
+) *GenesisState {
 	return &GenesisState{
-		Minter:             minter,
-		Params:             params,
-		MintPool:           mintPool,
-		ModuleCoinsAccount: mintModuleCoinsAccount.String(),
+		Minter:              minter,
+		Params:              params,
+		MintPool:            mintPool,
+		ModuleCoinsAccount:  mintModuleCoinsAccount.String(),
+		AccountWithdrawals:  accountWithdrawals,
+		TreasuryWithdrawals: treasuryWithdrawals,
 	}
 }
 
@@ -27,6 +36,16 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
+	for _, withdrawal := range data.AccountWithdrawals {
+		if err := withdrawal.ValidateGenesis(); err != nil {
+			return err
+		}
+	}
+	for _, withdrawal := range data.TreasuryWithdrawals {
+		if err := withdrawal.ValidateGenesis(); err != nil {
+			return err
+		}
+	}
 
 	return ValidateMinter(data.Minter)
 }
@@ -32,6 +32,12 @@ type GenesisState struct {
 	// mint_pool defines the pool of eligible accounts and treasury pool
 	MintPool           MintPool `protobuf:"bytes,3,opt,name=mint_pool,json=mintPool,proto3" json:"mint_pool"`
 	ModuleCoinsAccount string   `protobuf:"bytes,4,opt,name=module_coins_account,json=moduleCoinsAccount,proto3" json:"module_coins_account,omitempty" yaml:"module_coins_account"`
+	// account_withdrawals defines the treasury withdrawals of the accounts in
+	// their current withdrawal period
+	AccountWithdrawals []AccountWithdrawal `protobuf:"bytes,5,rep,name=account_withdrawals,json=accountWithdrawals,proto3" json:"account_withdrawals" yaml:"account_withdrawals"`
+	// treasury_withdrawals defines the history of the disbursements from the
+	// treasury pool
+	TreasuryWithdrawals []TreasuryWithdrawal `protobuf:"bytes,6,rep,name=treasury_withdrawals,json=treasuryWithdrawals,proto3" json:"treasury_withdrawals" yaml:"treasury_withdrawals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -95,6 +101,20 @@ func (m *GenesisState) GetModuleCoinsAccount() string {
 	return ""
 }
 
+func (m *GenesisState) GetAccountWithdrawals() []AccountWithdrawal {
+	if m != nil {
+		return m.AccountWithdrawals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTreasuryWithdrawals() []TreasuryWithdrawal {
+	if m != nil {
+		return m.TreasuryWithdrawals
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "mint.GenesisState")
 }
@@ -102,26 +122,31 @@ func init() {
 func init() { proto.RegisterFile("mint/genesis.proto", fileDescriptor_50813f2cd53c1776) }
 
 var fileDescriptor_50813f2cd53c1776 = []byte{
-	// 297 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x31, 0x4b, 0xc3, 0x40,
-	0x14, 0xc7, 0x73, 0x5a, 0x8a, 0x8d, 0x45, 0xf1, 0xe8, 0x10, 0x2a, 0x5c, 0x4a, 0xa6, 0x22, 0x34,
-	0x87, 0xba, 0xb9, 0x19, 0x85, 0x22, 0x28, 0xd4, 0xba, 0xb9, 0x84, 0x34, 0x39, 0xe2, 0x41, 0x2e,
-	0x2f, 0x24, 0x17, 0xb0, 0xdf, 0xc2, 0x8f, 0xd5, 0xb1, 0xa3, 0x53, 0x91, 0x64, 0x75, 0xf2, 0x13,
-	0xc8, 0xdd, 0x45, 0x74, 0x70, 0x39, 0xee, 0xfd, 0xde, 0xef, 0xfd, 0x87, 0xbf, 0x8d, 0x05, 0xcf,
-	0x25, 0x4d, 0x59, 0xce, 0x2a, 0x5e, 0xf9, 0x45, 0x09, 0x12, 0x70, 0x4f, 0xb1, 0xf1, 0x28, 0x85,
-	0x14, 0x34, 0xa0, 0xea, 0x67, 0x76, 0xe3, 0x63, 0xed, 0xab, 0xa7, 0x03, 0x27, 0x1a, 0x14, 0x51,
-	0x19, 0x89, 0xee, 0xde, 0xfb, 0x44, 0xf6, 0x70, 0x6e, 0x12, 0x9f, 0x64, 0x24, 0x19, 0x3e, 0xb3,
-	0xfb, 0xca, 0x62, 0xa5, 0x83, 0x26, 0x68, 0x7a, 0x78, 0x31, 0xf4, 0x75, 0xc0, 0x83, 0x66, 0x41,
-	0x6f, 0xb3, 0x73, 0xad, 0x65, 0x67, 0x28, 0xd7, 0x84, 0x39, 0x7b, 0x7f, 0xdd, 0x85, 0x66, 0x3f,
-	0xae, 0x31, 0xf0, 0xb9, 0x3d, 0x50, 0xcb, 0xb0, 0x00, 0xc8, 0x9c, 0x7d, 0xad, 0x1f, 0xfd, 0x46,
-	0x2f, 0x00, 0xb2, 0xee, 0xe0, 0x40, 0x74, 0x33, 0x7e, 0xb4, 0x47, 0x02, 0x92, 0x3a, 0x63, 0x61,
-	0x0c, 0x3c, 0xaf, 0xc2, 0x28, 0x8e, 0xa1, 0xce, 0xa5, 0xd3, 0x9b, 0xa0, 0xe9, 0x20, 0x70, 0xbf,
-	0x76, 0xee, 0xe9, 0x3a, 0x12, 0xd9, 0x95, 0xf7, 0x9f, 0xe5, 0x2d, 0xb1, 0xc1, 0x37, 0x8a, 0x5e,
-	0x1b, 0x18, 0xdc, 0x6d, 0x1a, 0x82, 0xb6, 0x0d, 0x41, 0x1f, 0x0d, 0x41, 0x6f, 0x2d, 0xb1, 0xb6,
-	0x2d, 0xb1, 0xde, 0x5b, 0x62, 0x3d, 0xd3, 0x94, 0xcb, 0x97, 0x7a, 0xe5, 0xc7, 0x20, 0xe8, 0x9c,
-	0xc1, 0x6d, 0x30, 0xbb, 0xe7, 0x82, 0x4b, 0x96, 0x50, 0x48, 0x78, 0x3e, 0x8b, 0xa1, 0x64, 0xf4,
-	0x55, 0x97, 0x49, 0xe5, 0xba, 0x60, 0xd5, 0xaa, 0xaf, 0x0b, 0xbc, 0xfc, 0x0e, 0x00, 0x00, 0xff,
-	0xff, 0xa4, 0x9b, 0x95, 0xc1, 0x96, 0x01, 0x00, 0x00,
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x4a, 0xe3, 0x40,
+	0x1c, 0xc7, 0x93, 0x6d, 0xb7, 0x6c, 0xd3, 0xb2, 0xcb, 0x4e, 0x0b, 0x1b, 0xba, 0x90, 0x94, 0x78,
+	0x29, 0x42, 0x1b, 0xac, 0x37, 0x6f, 0x46, 0xa1, 0x08, 0x0a, 0x35, 0x0a, 0x82, 0x97, 0x30, 0x4d,
+	0x86, 0x74, 0x20, 0xc9, 0x84, 0xcc, 0x84, 0xda, 0xb7, 0xf0, 0xb1, 0x7a, 0xf0, 0xd0, 0xa3, 0xa7,
+	0x22, 0xed, 0x1b, 0xf4, 0x09, 0x64, 0xfe, 0xa8, 0x55, 0x7b, 0x09, 0xc9, 0xe7, 0xf7, 0xf9, 0x7d,
+	0xbf, 0x13, 0x18, 0x03, 0xa4, 0x38, 0x63, 0x6e, 0x8c, 0x32, 0x44, 0x31, 0x1d, 0xe4, 0x05, 0x61,
+	0x04, 0x54, 0x39, 0xeb, 0xb4, 0x63, 0x12, 0x13, 0x01, 0x5c, 0xfe, 0x26, 0x67, 0x9d, 0x3f, 0xc2,
+	0xe7, 0x0f, 0x05, 0xfe, 0x0a, 0x90, 0xc3, 0x02, 0xa6, 0x6a, 0xdf, 0x79, 0xaa, 0x18, 0xcd, 0x91,
+	0x4c, 0xbc, 0x61, 0x90, 0x21, 0x70, 0x68, 0xd4, 0xb8, 0x85, 0x0a, 0x53, 0xef, 0xea, 0xbd, 0xc6,
+	0xb0, 0x39, 0x10, 0x01, 0x57, 0x82, 0x79, 0xd5, 0xc5, 0xca, 0xd6, 0x7c, 0x65, 0x70, 0x57, 0x86,
+	0x99, 0x3f, 0x76, 0xdd, 0xb1, 0x60, 0x6f, 0xae, 0x34, 0xc0, 0x91, 0x51, 0xe7, 0xc3, 0x20, 0x27,
+	0x24, 0x31, 0x2b, 0x42, 0xff, 0xfd, 0x11, 0x3d, 0x26, 0x24, 0x51, 0x0b, 0xbf, 0x52, 0xf5, 0x0d,
+	0xae, 0x8d, 0x76, 0x4a, 0xa2, 0x32, 0x41, 0x41, 0x48, 0x70, 0x46, 0x03, 0x18, 0x86, 0xa4, 0xcc,
+	0x98, 0x59, 0xed, 0xea, 0xbd, 0xba, 0x67, 0x6f, 0x57, 0xf6, 0xff, 0x39, 0x4c, 0x93, 0x13, 0x67,
+	0x9f, 0xe5, 0xf8, 0x40, 0xe2, 0x33, 0x4e, 0x4f, 0x25, 0x04, 0x89, 0xd1, 0x52, 0xf3, 0x60, 0x86,
+	0xd9, 0x34, 0x2a, 0xe0, 0x0c, 0x26, 0xd4, 0xfc, 0xd9, 0xad, 0xf4, 0x1a, 0xc3, 0x7f, 0xf2, 0x3c,
+	0xca, 0xbd, 0x7b, 0x9f, 0x7b, 0x0e, 0x3f, 0xd8, 0x76, 0x65, 0x77, 0x64, 0xdd, 0x9e, 0x04, 0xc7,
+	0x07, 0xf0, 0xeb, 0x1a, 0x05, 0xb9, 0xd1, 0x66, 0x05, 0x82, 0xb4, 0x2c, 0xe6, 0x9f, 0xea, 0x6a,
+	0xa2, 0xce, 0x94, 0x75, 0xb7, 0xca, 0xd8, 0xe9, 0x3b, 0x50, 0x7d, 0xea, 0xf7, 0xf6, 0x65, 0x38,
+	0x7e, 0x8b, 0x7d, 0x5b, 0xa4, 0xde, 0xc5, 0x62, 0x6d, 0xe9, 0xcb, 0xb5, 0xa5, 0xbf, 0xac, 0x2d,
+	0xfd, 0x71, 0x63, 0x69, 0xcb, 0x8d, 0xa5, 0x3d, 0x6f, 0x2c, 0xed, 0xde, 0x8d, 0x31, 0x9b, 0x96,
+	0x93, 0x41, 0x48, 0x52, 0x77, 0x84, 0xc8, 0xb9, 0xd7, 0xbf, 0xc4, 0x29, 0x66, 0x28, 0x72, 0x49,
+	0x84, 0xb3, 0x7e, 0x48, 0x0a, 0xe4, 0x3e, 0x88, 0xcb, 0xe2, 0xb2, 0x79, 0x8e, 0xe8, 0xa4, 0x26,
+	0x2e, 0xc8, 0xf1, 0xeb, 0x00, 0xfd, 0xc3, 0x32, 0xc7, 0x76, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -144,6 +169,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TreasuryWithdrawals) > 0 {
+		for iNdEx := len(m.TreasuryWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TreasuryWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AccountWithdrawals) > 0 {
+		for iNdEx := len(m.AccountWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.ModuleCoinsAccount) > 0 {
 		i -= len(m.ModuleCoinsAccount)
 		copy(dAtA[i:], m.ModuleCoinsAccount)
@@ -211,6 +264,18 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.AccountWithdrawals) > 0 {
+		for _, e := range m.AccountWithdrawals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TreasuryWithdrawals) > 0 {
+		for _, e := range m.TreasuryWithdrawals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -380,6 +445,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.ModuleCoinsAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountWithdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountWithdrawals = append(m.AccountWithdrawals, AccountWithdrawal{})
+			if err := m.AccountWithdrawals[len(m.AccountWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryWithdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryWithdrawals = append(m.TreasuryWithdrawals, TreasuryWithdrawal{})
+			if err := m.TreasuryWithdrawals[len(m.TreasuryWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
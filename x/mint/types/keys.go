@@ -1,11 +1,16 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName
 	ModuleName = "mint"
 
 	// ModuleVersion defines the current module version
-	ModuleVersion = 1
+	ModuleVersion = 2
 
 	// StoreKey is the default store key for mint
 	StoreKey = ModuleName
@@ -37,4 +42,20 @@ var (
 	// MintPoolStoreKey is the key for global mint pool state
 	MintPoolStoreKey          = append(GlobalStoreKeyPrefix, []byte("MintPool")...)
 	MintModuleCoinsAccountKey = append(GlobalStoreKeyPrefix, []byte("MintModuleCoinsAccount")...)
+	// TreasuryWithdrawalCountKey is the key for the number of withdrawals from the treasury pool
+	TreasuryWithdrawalCountKey = append(GlobalStoreKeyPrefix, []byte("TreasuryWithdrawalCount")...)
+	// AccountWithdrawalKeyPrefix is used as prefix for the treasury withdrawals of the accounts
+	AccountWithdrawalKeyPrefix = []byte{0x01}
+	// TreasuryWithdrawalKeyPrefix is used as prefix for the history of withdrawals from the treasury pool
+	TreasuryWithdrawalKeyPrefix = []byte{0x02}
 )
+
+// TreasuryWithdrawalStoreKey returns the key to retrieve the treasury withdrawal with the given id.
+func TreasuryWithdrawalStoreKey(id uint64) []byte {
+	return append(TreasuryWithdrawalKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// AccountWithdrawalStoreKey returns the key to retrieve the treasury withdrawal of the account.
+func AccountWithdrawalStoreKey(addr sdk.AccAddress) []byte {
+	return append(AccountWithdrawalKeyPrefix, address.MustLengthPrefix(addr)...)
+}
@@ -10,16 +10,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,6 +31,39 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// VestingType defines the type of vesting account a treasury disbursement is
+// made into.
+type VestingType int32
+
+const (
+	// VESTING_TYPE_UNSPECIFIED defines an invalid vesting type.
+	VestingTypeUnspecified VestingType = 0
+	// VESTING_TYPE_CONTINUOUS defines a continuous vesting account.
+	VestingTypeContinuous VestingType = 1
+	// VESTING_TYPE_PERIODIC defines a periodic vesting account.
+	VestingTypePeriodic VestingType = 2
+)
+
+var VestingType_name = map[int32]string{
+	0: "VESTING_TYPE_UNSPECIFIED",
+	1: "VESTING_TYPE_CONTINUOUS",
+	2: "VESTING_TYPE_PERIODIC",
+}
+
+var VestingType_value = map[string]int32{
+	"VESTING_TYPE_UNSPECIFIED": 0,
+	"VESTING_TYPE_CONTINUOUS":  1,
+	"VESTING_TYPE_PERIODIC":    2,
+}
+
+func (x VestingType) String() string {
+	return proto.EnumName(VestingType_name, int32(x))
+}
+
+func (VestingType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{0}
+}
+
 type MintPool struct {
 	// treasury pool
 	TreasuryPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=treasury_pool,json=treasuryPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"treasury_pool" yaml:"treasury_pool"`
@@ -80,6 +117,8 @@ type Minter struct {
 	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions" yaml:"annual_provisions"`
 	// current mint volume
 	CurrentMintVolume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=current_mint_volume,json=currentMintVolume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"current_mint_volume" yaml:"current_mint_volume"`
+	// mint volume windows currently open, one per minted denom
+	MintVolumeWindows []MintVolumeWindow `protobuf:"bytes,4,rep,name=mint_volume_windows,json=mintVolumeWindows,proto3" json:"mint_volume_windows" yaml:"mint_volume_windows"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -122,43 +161,433 @@ func (m *Minter) GetCurrentMintVolume() github_com_cosmos_cosmos_sdk_types.Coins
 	return nil
 }
 
+func (m *Minter) GetMintVolumeWindows() []MintVolumeWindow {
+	if m != nil {
+		return m.MintVolumeWindows
+	}
+	return nil
+}
+
+// MintVolumeWindow represents the window the mint volume of a denom is accounted over.
+type MintVolumeWindow struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// height of the block the window started at
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// time of the block the window started at
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+}
+
+func (m *MintVolumeWindow) Reset()         { *m = MintVolumeWindow{} }
+func (m *MintVolumeWindow) String() string { return proto.CompactTextString(m) }
+func (*MintVolumeWindow) ProtoMessage()    {}
+func (*MintVolumeWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{2}
+}
+func (m *MintVolumeWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintVolumeWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintVolumeWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintVolumeWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintVolumeWindow.Merge(m, src)
+}
+func (m *MintVolumeWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintVolumeWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintVolumeWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintVolumeWindow proto.InternalMessageInfo
+
+func (m *MintVolumeWindow) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MintVolumeWindow) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *MintVolumeWindow) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+// AccountWithdrawal represents the amount an account withdrew from the treasury
+// pool in its current withdrawal period.
+type AccountWithdrawal struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// height of the block the withdrawal period started at
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// amount withdrawn in the withdrawal period
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *AccountWithdrawal) Reset()         { *m = AccountWithdrawal{} }
+func (m *AccountWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*AccountWithdrawal) ProtoMessage()    {}
+func (*AccountWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{3}
+}
+func (m *AccountWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountWithdrawal.Merge(m, src)
+}
+func (m *AccountWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountWithdrawal proto.InternalMessageInfo
+
+func (m *AccountWithdrawal) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountWithdrawal) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *AccountWithdrawal) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// TreasurySpendProposal details a proposal for spending coins from the
+// treasury pool.
+type TreasurySpendProposal struct {
+	Title       string                                   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Recipient   string                                   `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Rationale   string                                   `protobuf:"bytes,5,opt,name=rationale,proto3" json:"rationale,omitempty"`
+	// vesting schedule to disburse the amount with, liquid if unset
+	Vesting *VestingSchedule `protobuf:"bytes,6,opt,name=vesting,proto3" json:"vesting,omitempty"`
+}
+
+func (m *TreasurySpendProposal) Reset()      { *m = TreasurySpendProposal{} }
+func (*TreasurySpendProposal) ProtoMessage() {}
+func (*TreasurySpendProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{4}
+}
+func (m *TreasurySpendProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasurySpendProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasurySpendProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TreasurySpendProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasurySpendProposal.Merge(m, src)
+}
+func (m *TreasurySpendProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasurySpendProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasurySpendProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreasurySpendProposal proto.InternalMessageInfo
+
+// VestingSchedule defines how a treasury disbursement vests. Nothing vests
+// before the cliff has passed, after which the amount vests over the duration,
+// either continuously or in equal periods.
+type VestingSchedule struct {
+	Type VestingType `protobuf:"varint,1,opt,name=type,proto3,enum=mint.VestingType" json:"type,omitempty"`
+	// time after the disbursement before the amount starts vesting
+	Cliff time.Duration `protobuf:"bytes,2,opt,name=cliff,proto3,stdduration" json:"cliff"`
+	// time the amount vests over after the cliff
+	Duration time.Duration `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration"`
+	// number of equal periods of a periodic vesting schedule
+	Periods uint32 `protobuf:"varint,4,opt,name=periods,proto3" json:"periods,omitempty"`
+}
+
+func (m *VestingSchedule) Reset()         { *m = VestingSchedule{} }
+func (m *VestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*VestingSchedule) ProtoMessage()    {}
+func (*VestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{5}
+}
+func (m *VestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingSchedule.Merge(m, src)
+}
+func (m *VestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingSchedule proto.InternalMessageInfo
+
+func (m *VestingSchedule) GetType() VestingType {
+	if m != nil {
+		return m.Type
+	}
+	return VestingTypeUnspecified
+}
+
+func (m *VestingSchedule) GetCliff() time.Duration {
+	if m != nil {
+		return m.Cliff
+	}
+	return 0
+}
+
+func (m *VestingSchedule) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *VestingSchedule) GetPeriods() uint32 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
+// TreasuryWithdrawal represents a disbursement from the treasury pool.
+type TreasuryWithdrawal struct {
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// account that withdrew the amount, empty for treasury spend proposals
+	Sender   string                                   `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver string                                   `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Amount   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// vesting schedule the amount was disbursed with, unset if liquid
+	Vesting *VestingSchedule `protobuf:"bytes,5,opt,name=vesting,proto3" json:"vesting,omitempty"`
+	Height  int64            `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Time    time.Time        `protobuf:"bytes,7,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *TreasuryWithdrawal) Reset()         { *m = TreasuryWithdrawal{} }
+func (m *TreasuryWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*TreasuryWithdrawal) ProtoMessage()    {}
+func (*TreasuryWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{6}
+}
+func (m *TreasuryWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasuryWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TreasuryWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryWithdrawal.Merge(m, src)
+}
+func (m *TreasuryWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreasuryWithdrawal proto.InternalMessageInfo
+
+func (m *TreasuryWithdrawal) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *TreasuryWithdrawal) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *TreasuryWithdrawal) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *TreasuryWithdrawal) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *TreasuryWithdrawal) GetVesting() *VestingSchedule {
+	if m != nil {
+		return m.Vesting
+	}
+	return nil
+}
+
+func (m *TreasuryWithdrawal) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TreasuryWithdrawal) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 func init() {
+	proto.RegisterEnum("mint.VestingType", VestingType_name, VestingType_value)
 	proto.RegisterType((*MintPool)(nil), "mint.MintPool")
 	proto.RegisterType((*Minter)(nil), "mint.Minter")
+	proto.RegisterType((*MintVolumeWindow)(nil), "mint.MintVolumeWindow")
+	proto.RegisterType((*AccountWithdrawal)(nil), "mint.AccountWithdrawal")
+	proto.RegisterType((*TreasurySpendProposal)(nil), "mint.TreasurySpendProposal")
+	proto.RegisterType((*VestingSchedule)(nil), "mint.VestingSchedule")
+	proto.RegisterType((*TreasuryWithdrawal)(nil), "mint.TreasuryWithdrawal")
 }
 
 func init() { proto.RegisterFile("mint/mint.proto", fileDescriptor_e1b9fbb701b2a577) }
 
 var fileDescriptor_e1b9fbb701b2a577 = []byte{
-	// 434 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3d, 0x6f, 0xd4, 0x30,
-	0x18, 0xc7, 0xcf, 0x6d, 0x55, 0xb5, 0x01, 0x04, 0x0d, 0x1d, 0xd2, 0x1b, 0xe2, 0x2a, 0x03, 0xba,
-	0xe5, 0x62, 0x15, 0xb6, 0x1b, 0x43, 0x25, 0x5e, 0x54, 0x50, 0x75, 0x03, 0x03, 0x4b, 0xe4, 0xe4,
-	0xdc, 0x60, 0x11, 0xfb, 0x89, 0x6c, 0xe7, 0x20, 0xdf, 0x80, 0x91, 0x11, 0x26, 0x3a, 0xf3, 0x49,
-	0x3a, 0x76, 0x44, 0x0c, 0x01, 0xdd, 0x2d, 0xac, 0xf4, 0x13, 0x20, 0xc7, 0xe1, 0x1d, 0x89, 0xde,
-	0x92, 0x38, 0xcf, 0xcb, 0xef, 0xff, 0xe4, 0xef, 0xc7, 0xbb, 0x2e, 0xb8, 0x34, 0xc4, 0x3e, 0xe2,
-	0x4a, 0x81, 0x01, 0x7f, 0xc3, 0x9e, 0x87, 0xbb, 0x05, 0x14, 0xd0, 0x05, 0x88, 0x3d, 0xb9, 0xdc,
-	0x10, 0x17, 0x00, 0x45, 0xc9, 0x48, 0xf7, 0x95, 0xd5, 0x27, 0xc4, 0x70, 0xc1, 0xb4, 0xa1, 0xa2,
-	0xea, 0x0b, 0xf6, 0xfe, 0x2c, 0xa0, 0xb2, 0xe9, 0x53, 0x61, 0x0e, 0x5a, 0x80, 0x26, 0x19, 0xd5,
-	0x8c, 0xcc, 0x0f, 0x32, 0x66, 0xe8, 0x01, 0xc9, 0x81, 0x4b, 0x97, 0x8f, 0xde, 0x21, 0x6f, 0xeb,
-	0x11, 0x97, 0xe6, 0x18, 0xa0, 0xf4, 0x5f, 0x21, 0xef, 0x9a, 0x51, 0x8c, 0xea, 0x5a, 0x35, 0x69,
-	0x05, 0x50, 0x06, 0x68, 0x7f, 0x7d, 0x74, 0xe5, 0xf6, 0x5e, 0xec, 0x28, 0xb1, 0xa5, 0xc4, 0x3d,
-	0x25, 0xbe, 0x0b, 0x5c, 0x26, 0xf7, 0xcf, 0x5a, 0x3c, 0xb8, 0x68, 0xf1, 0x6e, 0x43, 0x45, 0x39,
-	0x89, 0x7e, 0xeb, 0x8e, 0xde, 0x7f, 0xc2, 0xa3, 0x82, 0x9b, 0x67, 0x75, 0x16, 0xe7, 0x20, 0x48,
-	0x3f, 0x8a, 0x7b, 0x8d, 0xf5, 0xec, 0x39, 0x31, 0x4d, 0xc5, 0x74, 0x07, 0xd2, 0xd3, 0xab, 0xdf,
-	0x7b, 0xed, 0x28, 0x93, 0xad, 0x37, 0xa7, 0x18, 0x7d, 0x39, 0xc5, 0x28, 0xfa, 0xba, 0xe6, 0x6d,
-	0xda, 0x09, 0x99, 0xf2, 0x8f, 0xbc, 0x6d, 0x2e, 0x4f, 0x4a, 0x6a, 0x38, 0xc8, 0x00, 0xed, 0xa3,
-	0xd1, 0x76, 0x12, 0x5b, 0xfd, 0x8f, 0x2d, 0xbe, 0x75, 0x09, 0x9d, 0x43, 0x96, 0x4f, 0x7f, 0x02,
-	0xfc, 0x17, 0xde, 0x0e, 0x95, 0xb2, 0xa6, 0x65, 0x5a, 0x29, 0x98, 0x73, 0xcd, 0x41, 0xea, 0x60,
-	0xad, 0xa3, 0x3e, 0x5c, 0x8d, 0x7a, 0xd1, 0xe2, 0xc0, 0xfd, 0xff, 0x5f, 0xc0, 0x68, 0x7a, 0xc3,
-	0xc5, 0x8e, 0x7f, 0x84, 0xfc, 0xb7, 0xc8, 0xbb, 0x99, 0xd7, 0x4a, 0x31, 0x69, 0x52, 0x7b, 0xed,
-	0xe9, 0x1c, 0xca, 0x5a, 0xb0, 0x60, 0xfd, 0x7f, 0x66, 0x3f, 0xee, 0xcd, 0x1e, 0x3a, 0xb1, 0x7f,
-	0x30, 0x56, 0xb3, 0x7c, 0xa7, 0x27, 0x58, 0x7b, 0x9f, 0x74, 0xfd, 0x93, 0x0d, 0xeb, 0x79, 0xf2,
-	0xe0, 0x6c, 0x11, 0xa2, 0xf3, 0x45, 0x88, 0x3e, 0x2f, 0x42, 0xf4, 0x7a, 0x19, 0x0e, 0xce, 0x97,
-	0xe1, 0xe0, 0xc3, 0x32, 0x1c, 0x3c, 0x25, 0xbf, 0xc0, 0xef, 0x31, 0x38, 0x4c, 0xc6, 0x47, 0x5c,
-	0x70, 0xc3, 0x66, 0x04, 0x66, 0x5c, 0x8e, 0x73, 0x50, 0x8c, 0xbc, 0xec, 0x16, 0xdb, 0x29, 0x65,
-	0x9b, 0xdd, 0x9e, 0xdd, 0xf9, 0x16, 0x00, 0x00, 0xff, 0xff, 0x03, 0x0e, 0x7c, 0x51, 0xf2, 0x02,
-	0x00, 0x00,
+	// 1033 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x4f, 0x24, 0x45,
+	0x14, 0x9e, 0x9e, 0x19, 0x06, 0x28, 0x58, 0x97, 0x29, 0x7e, 0x35, 0x13, 0x9d, 0x9e, 0x4c, 0xa2,
+	0x21, 0x26, 0x74, 0xbb, 0x98, 0x98, 0x95, 0x8b, 0x71, 0x18, 0xdc, 0x1d, 0xb3, 0xc2, 0xa4, 0x19,
+	0x58, 0xf5, 0x32, 0x69, 0xba, 0x8b, 0xa1, 0xb4, 0xbb, 0xaa, 0xd3, 0x55, 0x0d, 0xf2, 0x1f, 0x10,
+	0x4e, 0x7b, 0xc4, 0x03, 0x91, 0xc4, 0x9b, 0x17, 0xcf, 0xde, 0x3d, 0xec, 0xc9, 0xec, 0xc1, 0x83,
+	0x31, 0x71, 0xd6, 0xc0, 0x65, 0xcf, 0xfc, 0x05, 0xa6, 0xaa, 0xab, 0xa1, 0x67, 0xdc, 0x04, 0x88,
+	0x71, 0x2f, 0xd0, 0xef, 0xbd, 0xfa, 0xbe, 0x7a, 0x3f, 0xbe, 0x57, 0x00, 0xee, 0x07, 0x98, 0x70,
+	0x4b, 0xfc, 0x30, 0xc3, 0x88, 0x72, 0x0a, 0x8b, 0xe2, 0xbb, 0x32, 0xd3, 0xa3, 0x3d, 0x2a, 0x1d,
+	0x96, 0xf8, 0x4a, 0x62, 0x15, 0xa3, 0x47, 0x69, 0xcf, 0x47, 0x96, 0xb4, 0x76, 0xe2, 0x5d, 0x8b,
+	0xe3, 0x00, 0x31, 0xee, 0x04, 0xa1, 0x3a, 0x50, 0x1d, 0x3e, 0xe0, 0xc5, 0x91, 0xc3, 0x31, 0x25,
+	0x2a, 0xbe, 0x30, 0x1c, 0x77, 0xc8, 0x61, 0x0a, 0x75, 0x29, 0x0b, 0x28, 0xb3, 0x76, 0x1c, 0x86,
+	0xac, 0xfd, 0x07, 0x3b, 0x88, 0x3b, 0x0f, 0x2c, 0x97, 0x62, 0x05, 0xad, 0xff, 0xa0, 0x81, 0xb1,
+	0x2f, 0x30, 0xe1, 0x6d, 0x4a, 0x7d, 0x78, 0xa4, 0x81, 0x7b, 0x3c, 0x42, 0x0e, 0x8b, 0xa3, 0xc3,
+	0x6e, 0x48, 0xa9, 0xaf, 0x6b, 0xb5, 0xc2, 0xe2, 0xc4, 0xf2, 0x82, 0x99, 0xb0, 0x98, 0x82, 0xc5,
+	0x54, 0x2c, 0xe6, 0x2a, 0xc5, 0xa4, 0xf1, 0xf8, 0x79, 0xdf, 0xc8, 0x5d, 0xf6, 0x8d, 0x99, 0x43,
+	0x27, 0xf0, 0x57, 0xea, 0x03, 0xe8, 0xfa, 0x4f, 0x2f, 0x8d, 0xc5, 0x1e, 0xe6, 0x7b, 0xf1, 0x8e,
+	0xe9, 0xd2, 0xc0, 0x52, 0xa9, 0x24, 0xbf, 0x96, 0x98, 0xf7, 0xad, 0xc5, 0x0f, 0x43, 0xc4, 0x24,
+	0x11, 0xb3, 0x27, 0x53, 0xac, 0x48, 0x65, 0x65, 0xec, 0xe4, 0xcc, 0xd0, 0x5e, 0x9d, 0x19, 0x5a,
+	0xfd, 0xaf, 0x02, 0x28, 0x89, 0x0c, 0x51, 0x04, 0x9f, 0x80, 0x71, 0x4c, 0x76, 0x7d, 0x59, 0xba,
+	0xae, 0xd5, 0xb4, 0xc5, 0xf1, 0x86, 0x29, 0xee, 0xff, 0xb3, 0x6f, 0xbc, 0x77, 0x8b, 0x7b, 0x9a,
+	0xc8, 0xb5, 0xaf, 0x09, 0xe0, 0x01, 0x28, 0x3b, 0x84, 0xc4, 0x8e, 0xdf, 0x0d, 0x23, 0xba, 0x8f,
+	0x19, 0xa6, 0x84, 0xe9, 0x79, 0xc9, 0xfa, 0xf9, 0xdd, 0x58, 0x2f, 0xfb, 0x86, 0x9e, 0xd4, 0xff,
+	0x2f, 0xc2, 0xba, 0x3d, 0x95, 0xf8, 0xda, 0x57, 0x2e, 0xf8, 0xbd, 0x06, 0xa6, 0xdd, 0x38, 0x8a,
+	0x10, 0xe1, 0x5d, 0x21, 0x8b, 0xee, 0x3e, 0xf5, 0xe3, 0x00, 0xe9, 0x85, 0x9b, 0x9a, 0xbd, 0xae,
+	0x9a, 0x5d, 0x49, 0x2e, 0x7b, 0x0d, 0xc7, 0xdd, 0x5a, 0x5e, 0x56, 0x0c, 0xa2, 0xbd, 0xdb, 0x12,
+	0x0f, 0xbf, 0x01, 0xd3, 0x19, 0xba, 0xee, 0x01, 0x26, 0x1e, 0x3d, 0x60, 0x7a, 0x51, 0xa6, 0x36,
+	0x67, 0x8a, 0x98, 0x79, 0x7d, 0xfc, 0xa9, 0x0c, 0x37, 0xea, 0x83, 0x79, 0xbd, 0x86, 0xa0, 0x6e,
+	0x97, 0x83, 0x21, 0x14, 0x5b, 0x29, 0xca, 0xf9, 0xfe, 0xaa, 0x81, 0xa9, 0x61, 0x46, 0x38, 0x03,
+	0x46, 0x3c, 0x44, 0x68, 0x90, 0x4c, 0xd9, 0x4e, 0x0c, 0xb8, 0x02, 0x26, 0x19, 0x77, 0x22, 0xde,
+	0xdd, 0x43, 0xb8, 0xb7, 0xc7, 0xe5, 0xb0, 0x0a, 0x8d, 0xf9, 0xcb, 0xbe, 0x31, 0x9d, 0xdc, 0x9c,
+	0x8d, 0xd6, 0xed, 0x09, 0x69, 0x3e, 0x96, 0x16, 0xfc, 0x12, 0x80, 0x24, 0x2a, 0x96, 0x4b, 0x2f,
+	0xd4, 0xb4, 0xc5, 0x89, 0xe5, 0x8a, 0x99, 0x2c, 0x8e, 0x99, 0x2e, 0x8e, 0xd9, 0x49, 0x37, 0xaf,
+	0xf1, 0x8e, 0xaa, 0xa9, 0x9c, 0x65, 0x16, 0xd8, 0xfa, 0xb3, 0x97, 0x86, 0x66, 0x8f, 0x4b, 0x87,
+	0x38, 0xae, 0xca, 0xf8, 0x5d, 0x03, 0xe5, 0x4f, 0x5d, 0x97, 0xc6, 0x84, 0x3f, 0xc5, 0x7c, 0xcf,
+	0x8b, 0x9c, 0x03, 0xc7, 0x87, 0x3a, 0x18, 0x75, 0x3c, 0x2f, 0x42, 0x8c, 0xa9, 0x4a, 0x52, 0xf3,
+	0x3f, 0xd5, 0xe2, 0x82, 0x92, 0x13, 0x88, 0x9b, 0x6e, 0x96, 0xcc, 0x07, 0xa2, 0x8c, 0x3b, 0x89,
+	0x42, 0x51, 0xab, 0xb2, 0x7e, 0xce, 0x83, 0xd9, 0x8e, 0x5a, 0xcc, 0xcd, 0x10, 0x11, 0xaf, 0x1d,
+	0xd1, 0x90, 0x32, 0xc7, 0x17, 0x23, 0xe2, 0x98, 0xfb, 0x28, 0x1d, 0x91, 0x34, 0x60, 0x0d, 0x4c,
+	0x78, 0x88, 0xb9, 0x11, 0x0e, 0xe5, 0x92, 0xca, 0x75, 0xb2, 0xb3, 0x2e, 0xf8, 0x36, 0x18, 0x8f,
+	0x90, 0x8b, 0x43, 0x8c, 0x64, 0xfe, 0x22, 0x7e, 0xed, 0xc8, 0x94, 0x56, 0xfc, 0xdf, 0x4a, 0x93,
+	0x29, 0xc8, 0x37, 0xc0, 0xf1, 0x91, 0x3e, 0xa2, 0x52, 0x48, 0x1d, 0xd0, 0x02, 0xa3, 0xfb, 0x88,
+	0x71, 0x4c, 0x7a, 0x7a, 0x49, 0xca, 0x64, 0x36, 0x91, 0xfd, 0x76, 0xe2, 0xdc, 0x74, 0xf7, 0x90,
+	0x17, 0xfb, 0xc8, 0x4e, 0x4f, 0xad, 0x4c, 0x1e, 0x9d, 0x19, 0xb9, 0x93, 0x33, 0x23, 0xf7, 0xea,
+	0xcc, 0xc8, 0x09, 0x21, 0xdc, 0x1f, 0x3a, 0x0a, 0xdf, 0x05, 0x45, 0x91, 0x87, 0x6c, 0xd5, 0x5b,
+	0xcb, 0xe5, 0x01, 0xbe, 0xce, 0x61, 0x88, 0x6c, 0x19, 0x86, 0x1f, 0x83, 0x11, 0xd7, 0xc7, 0xbb,
+	0xbb, 0xb2, 0x6d, 0xa2, 0xf6, 0x61, 0x79, 0x36, 0xd5, 0xbb, 0xdf, 0x18, 0x13, 0xb5, 0x9f, 0x08,
+	0x21, 0x26, 0x08, 0xf8, 0x09, 0x18, 0x4b, 0xff, 0x28, 0xe8, 0x85, 0xdb, 0xa3, 0xaf, 0x40, 0x42,
+	0xa9, 0x21, 0x8a, 0x30, 0xf5, 0xc4, 0xb2, 0x6b, 0x8b, 0xf7, 0xec, 0xd4, 0x54, 0x42, 0xf8, 0x2d,
+	0x0f, 0x60, 0x2a, 0x84, 0x8c, 0xc0, 0xe7, 0x40, 0x1e, 0x7b, 0xb2, 0xae, 0x62, 0xa3, 0x74, 0xde,
+	0x37, 0xf2, 0xad, 0xa6, 0x9d, 0xc7, 0x1e, 0x9c, 0x03, 0x25, 0x86, 0x88, 0x87, 0x22, 0x25, 0x01,
+	0x65, 0xc1, 0x0a, 0x18, 0x8b, 0x90, 0x8b, 0xf0, 0x3e, 0x8a, 0xd4, 0xf0, 0xaf, 0xec, 0x37, 0x33,
+	0xfb, 0xcc, 0x74, 0x47, 0x6e, 0x33, 0x5d, 0x51, 0x89, 0x5a, 0x51, 0xa1, 0x86, 0x82, 0xad, 0x2c,
+	0xf8, 0x10, 0x14, 0xe5, 0x53, 0x32, 0x7a, 0xe3, 0x53, 0x22, 0xdb, 0x2d, 0x5f, 0x0d, 0x89, 0x48,
+	0x1a, 0xfa, 0xfe, 0x2f, 0x1a, 0x98, 0xc8, 0x48, 0x00, 0x3e, 0x04, 0xfa, 0xf6, 0xda, 0x66, 0xa7,
+	0xb5, 0xfe, 0xa8, 0xdb, 0xf9, 0xaa, 0xbd, 0xd6, 0xdd, 0x5a, 0xdf, 0x6c, 0xaf, 0xad, 0xb6, 0x3e,
+	0x6b, 0xad, 0x35, 0xa7, 0x72, 0x95, 0xca, 0xf1, 0x69, 0x6d, 0x2e, 0x73, 0x7c, 0x8b, 0xb0, 0x10,
+	0xb9, 0x78, 0x17, 0x23, 0x0f, 0x7e, 0x04, 0xe6, 0x07, 0x90, 0xab, 0x1b, 0xeb, 0x9d, 0xd6, 0xfa,
+	0xd6, 0xc6, 0xd6, 0xe6, 0x94, 0x56, 0x59, 0x38, 0x3e, 0xad, 0xcd, 0x66, 0x80, 0xab, 0x94, 0x70,
+	0x4c, 0x62, 0x1a, 0x33, 0xb8, 0x0c, 0x66, 0x07, 0x70, 0xed, 0x35, 0xbb, 0xb5, 0xd1, 0x6c, 0xad,
+	0x4e, 0xe5, 0x2b, 0xf3, 0xc7, 0xa7, 0xb5, 0xe9, 0x0c, 0xaa, 0x2d, 0xb5, 0x80, 0xdd, 0x4a, 0xf1,
+	0xe8, 0xc7, 0x6a, 0xae, 0xd1, 0x7a, 0x7e, 0x5e, 0xd5, 0x5e, 0x9c, 0x57, 0xb5, 0xbf, 0xcf, 0xab,
+	0xda, 0xb3, 0x8b, 0x6a, 0xee, 0xc5, 0x45, 0x35, 0xf7, 0xc7, 0x45, 0x35, 0xf7, 0xb5, 0x95, 0x19,
+	0xc8, 0x23, 0x44, 0x9b, 0x8d, 0xa5, 0x27, 0x38, 0xc0, 0x1c, 0x79, 0x16, 0xf5, 0x30, 0x59, 0x72,
+	0x69, 0x84, 0xac, 0xef, 0xe4, 0x3f, 0x46, 0xc9, 0x74, 0x76, 0x4a, 0xb2, 0x61, 0x1f, 0xfe, 0x33,
+	0x00, 0x5b, 0xea, 0x08, 0x56, 0x32, 0x09, 0x00, 0x00,
 }
 
 func (this *MintPool) Equal(that interface{}) bool {
@@ -223,53 +652,206 @@ func (this *Minter) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.MintVolumeWindows) != len(that1.MintVolumeWindows) {
+		return false
+	}
+	for i := range this.MintVolumeWindows {
+		if !this.MintVolumeWindows[i].Equal(&that1.MintVolumeWindows[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (m *MintPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MintVolumeWindow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *MintPool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MintPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TreasuryPool) > 0 {
-		for iNdEx := len(m.TreasuryPool) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TreasuryPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMint(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*MintVolumeWindow)
+	if !ok {
+		that2, ok := that.(MintVolumeWindow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Minter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	return true
+}
+func (this *AccountWithdrawal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountWithdrawal)
+	if !ok {
+		that2, ok := that.(AccountWithdrawal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *VestingSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VestingSchedule)
+	if !ok {
+		that2, ok := that.(VestingSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Cliff != that1.Cliff {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	if this.Periods != that1.Periods {
+		return false
+	}
+	return true
+}
+func (this *TreasuryWithdrawal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TreasuryWithdrawal)
+	if !ok {
+		that2, ok := that.(TreasuryWithdrawal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.Receiver != that1.Receiver {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if !this.Vesting.Equal(that1.Vesting) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	return true
+}
+func (m *MintPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TreasuryPool) > 0 {
+		for iNdEx := len(m.TreasuryPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TreasuryPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Minter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
 func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
@@ -282,6 +864,20 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MintVolumeWindows) > 0 {
+		for iNdEx := len(m.MintVolumeWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintVolumeWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.CurrentMintVolume) > 0 {
 		for iNdEx := len(m.CurrentMintVolume) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -292,85 +888,1306 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i -= size
 				i = encodeVarintMint(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	{
-		size := m.AnnualProvisions.Size()
-		i -= size
-		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Inflation.Size()
-		i -= size
-		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMint(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MintPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.TreasuryPool) > 0 {
-		for _, e := range m.TreasuryPool {
-			l = e.Size()
-			n += 1 + l + sovMint(uint64(l))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MintVolumeWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintVolumeWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintVolumeWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMint(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TreasurySpendProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreasurySpendProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasurySpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Vesting != nil {
+		{
+			size, err := m.Vesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Rationale) > 0 {
+		i -= len(m.Rationale)
+		copy(dAtA[i:], m.Rationale)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Rationale)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Periods != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Periods))
+		i--
+		dAtA[i] = 0x20
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintMint(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Cliff, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Cliff):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintMint(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.Type != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TreasuryWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreasuryWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasuryWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintMint(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x3a
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Vesting != nil {
+		{
+			size, err := m.Vesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MintPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TreasuryPool) > 0 {
+		for _, e := range m.TreasuryPool {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.CurrentMintVolume) > 0 {
+		for _, e := range m.CurrentMintVolume {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if len(m.MintVolumeWindows) > 0 {
+		for _, e := range m.MintVolumeWindows {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintVolumeWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *AccountWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TreasurySpendProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = len(m.Rationale)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.Vesting != nil {
+		l = m.Vesting.Size()
+		n += 1 + l + sovMint(uint64(l))
+	}
+	return n
+}
+
+func (m *VestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovMint(uint64(m.Type))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Cliff)
+	n += 1 + l + sovMint(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovMint(uint64(l))
+	if m.Periods != 0 {
+		n += 1 + sovMint(uint64(m.Periods))
+	}
+	return n
+}
+
+func (m *TreasuryWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovMint(uint64(m.ID))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.Vesting != nil {
+		l = m.Vesting.Size()
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func sovMint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMint(x uint64) (n int) {
+	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MintPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryPool = append(m.TreasuryPool, types.Coin{})
+			if err := m.TreasuryPool[len(m.TreasuryPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentMintVolume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentMintVolume = append(m.CurrentMintVolume, types.Coin{})
+			if err := m.CurrentMintVolume[len(m.CurrentMintVolume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintVolumeWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintVolumeWindows = append(m.MintVolumeWindows, MintVolumeWindow{})
+			if err := m.MintVolumeWindows[len(m.MintVolumeWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintVolumeWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintVolumeWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintVolumeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TreasurySpendProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreasurySpendProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreasurySpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rationale", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rationale = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vesting == nil {
+				m.Vesting = &VestingSchedule{}
+			}
+			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *Minter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Inflation.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.AnnualProvisions.Size()
-	n += 1 + l + sovMint(uint64(l))
-	if len(m.CurrentMintVolume) > 0 {
-		for _, e := range m.CurrentMintVolume {
-			l = e.Size()
-			n += 1 + l + sovMint(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovMint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMint(x uint64) (n int) {
-	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MintPool) Unmarshal(dAtA []byte) error {
+func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -393,15 +2210,34 @@ func (m *MintPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MintPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MintPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VestingType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cliff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -428,11 +2264,62 @@ func (m *MintPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TreasuryPool = append(m.TreasuryPool, types.Coin{})
-			if err := m.TreasuryPool[len(m.TreasuryPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Cliff, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -454,7 +2341,7 @@ func (m *MintPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Minter) Unmarshal(dAtA []byte) error {
+func (m *TreasuryWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -477,15 +2364,34 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+			return fmt.Errorf("proto: TreasuryWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TreasuryWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -513,13 +2419,11 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -547,13 +2451,45 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentMintVolume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -580,8 +2516,62 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentMintVolume = append(m.CurrentMintVolume, types.Coin{})
-			if err := m.CurrentMintVolume[len(m.CurrentMintVolume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Vesting == nil {
+				m.Vesting = &VestingSchedule{}
+			}
+			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
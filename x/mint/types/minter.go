@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -79,3 +81,69 @@ func (m Minter) BlockProvision(params Params) sdk.Coin {
 	provisionAmt := m.AnnualProvisions.QuoInt(sdk.NewInt(int64(params.BlocksPerYear)))
 	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
 }
+
+// AddMintVolume adds the minted amount to the current mint volume, opening a new window at the given height and
+// time for each denom that has none open if the mint volume window is enabled.
+func (m *Minter) AddMintVolume(amount sdk.Coins, height int64, blockTime time.Time, window uint64) {
+	m.CurrentMintVolume = m.CurrentMintVolume.Add(amount...)
+	m.OpenMintVolumeWindows(height, blockTime, window)
+}
+
+// OpenMintVolumeWindows opens a new window at the given height and time for each denom of the current mint volume
+// that has none open if the mint volume window is enabled, e.g. for volume minted while it was disabled.
+func (m *Minter) OpenMintVolumeWindows(height int64, blockTime time.Time, window uint64) {
+	if window == 0 {
+		return
+	}
+	for _, coin := range m.CurrentMintVolume {
+		if _, ok := m.MintVolumeWindow(coin.Denom); ok {
+			continue
+		}
+		m.MintVolumeWindows = append(m.MintVolumeWindows, MintVolumeWindow{
+			Denom:       coin.Denom,
+			StartHeight: height,
+			StartTime:   blockTime,
+		})
+	}
+}
+
+// MintVolumeWindow returns the open mint volume window of the given denom.
+func (m Minter) MintVolumeWindow(denom string) (MintVolumeWindow, bool) {
+	for _, w := range m.MintVolumeWindows {
+		if w.Denom == denom {
+			return w, true
+		}
+	}
+	return MintVolumeWindow{}, false
+}
+
+// ResetExpiredMintVolume closes the mint volume windows that lasted the given number of blocks at the given height
+// and resets the current mint volume of their denoms, returning the reset volume.
+func (m *Minter) ResetExpiredMintVolume(height int64, window uint64) sdk.Coins {
+	reset := sdk.NewCoins()
+	if window == 0 {
+		return reset
+	}
+	open := make([]MintVolumeWindow, 0, len(m.MintVolumeWindows))
+	for _, w := range m.MintVolumeWindows {
+		if height-w.StartHeight < int64(window) {
+			open = append(open, w)
+			continue
+		}
+		reset = reset.Add(sdk.NewCoin(w.Denom, m.CurrentMintVolume.AmountOf(w.Denom)))
+	}
+	m.MintVolumeWindows = open
+	m.CurrentMintVolume = m.CurrentMintVolume.Sub(reset)
+	return reset
+}
+
+// RemainingMintVolume returns the volume that can still be minted before reaching the given maximum.
+func (m Minter) RemainingMintVolume(maxAllowedMintVolume sdk.Coins) sdk.Coins {
+	remaining := sdk.NewCoins()
+	for _, coin := range maxAllowedMintVolume {
+		if left := coin.Amount.Sub(m.CurrentMintVolume.AmountOf(coin.Denom)); left.IsPositive() {
+			remaining = remaining.Add(sdk.NewCoin(coin.Denom, left))
+		}
+	}
+	return remaining
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -129,3 +130,64 @@ func BenchmarkNextAnnualProvisions(b *testing.B) {
 	}
 
 }
+
+func TestMintVolumeWindow(t *testing.T) {
+	minter := DefaultInitialMinter()
+	start := time.Unix(1600000000, 0).UTC()
+
+	minter.AddMintVolume(sdk.NewCoins(sdk.NewInt64Coin("loki", 10)), 10, start, 100)
+	minter.AddMintVolume(sdk.NewCoins(sdk.NewInt64Coin("loki", 5), sdk.NewInt64Coin("minigeo", 7)), 50, start.Add(time.Hour), 100)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("loki", 15), sdk.NewInt64Coin("minigeo", 7)), minter.CurrentMintVolume)
+	require.Equal(t, []MintVolumeWindow{
+		{Denom: "loki", StartHeight: 10, StartTime: start},
+		{Denom: "minigeo", StartHeight: 50, StartTime: start.Add(time.Hour)},
+	}, minter.MintVolumeWindows)
+
+	max := sdk.NewCoins(sdk.NewInt64Coin("loki", 20), sdk.NewInt64Coin("minigeo", 5))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("loki", 5)), minter.RemainingMintVolume(max))
+
+	require.True(t, minter.ResetExpiredMintVolume(109, 100).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("loki", 15)), minter.ResetExpiredMintVolume(110, 100))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("minigeo", 7)), minter.CurrentMintVolume)
+	require.Equal(t, []MintVolumeWindow{
+		{Denom: "minigeo", StartHeight: 50, StartTime: start.Add(time.Hour)},
+	}, minter.MintVolumeWindows)
+
+	minter.AddMintVolume(sdk.NewCoins(sdk.NewInt64Coin("loki", 3)), 120, start.Add(2*time.Hour), 100)
+	loki, ok := minter.MintVolumeWindow("loki")
+	require.True(t, ok)
+	require.Equal(t, int64(120), loki.StartHeight)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("minigeo", 7)), minter.ResetExpiredMintVolume(150, 100))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("loki", 3)), minter.ResetExpiredMintVolume(220, 100))
+	require.Empty(t, minter.MintVolumeWindows)
+	require.True(t, minter.CurrentMintVolume.IsZero())
+}
+
+func TestMintVolumeWindowDisabled(t *testing.T) {
+	minter := DefaultInitialMinter()
+
+	minter.AddMintVolume(sdk.NewCoins(sdk.NewInt64Coin("loki", 10)), 10, time.Now(), 0)
+	require.Empty(t, minter.MintVolumeWindows)
+	require.True(t, minter.ResetExpiredMintVolume(1000000, 0).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("loki", 10)), minter.CurrentMintVolume)
+}
+
+func TestMintVolumeWindowEnabled(t *testing.T) {
+	minter := DefaultInitialMinter()
+	start := time.Unix(1600000000, 0).UTC()
+
+	minter.AddMintVolume(sdk.NewCoins(sdk.NewInt64Coin("loki", 20)), 10, start, 0)
+	max := sdk.NewCoins(sdk.NewInt64Coin("loki", 20))
+	require.True(t, minter.RemainingMintVolume(max).IsZero())
+
+	minter.OpenMintVolumeWindows(30, start.Add(time.Hour), 100)
+	require.Equal(t, []MintVolumeWindow{
+		{Denom: "loki", StartHeight: 30, StartTime: start.Add(time.Hour)},
+	}, minter.MintVolumeWindows)
+	minter.OpenMintVolumeWindows(31, start.Add(2*time.Hour), 100)
+	require.Len(t, minter.MintVolumeWindows, 1)
+
+	require.True(t, minter.ResetExpiredMintVolume(129, 100).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("loki", 20)), minter.ResetExpiredMintVolume(130, 100))
+	require.Equal(t, max, minter.RemainingMintVolume(max))
+}
@@ -15,11 +15,13 @@ func NewMsgWithdrawCoinsToAccFromTreasury(
 	amt sdk.Coins,
 	receiver sdk.AccAddress,
 	sender sdk.AccAddress,
+	vesting *VestingSchedule,
 ) MsgWithdrawCoinsToAccFromTreasury {
 	return MsgWithdrawCoinsToAccFromTreasury{
 		Amount:   amt,
 		Receiver: receiver.String(),
 		Sender:   sender.String(),
+		Vesting:  vesting,
 	}
 }
 
@@ -55,6 +57,11 @@ func (msg MsgWithdrawCoinsToAccFromTreasury) ValidateBasic() error {
 	if msg.Amount.IsAnyNegative() {
 		return sdkerrors.Wrapf(ErrInvalidWithdrawalAmount, "amount: %s", msg.Amount.String())
 	}
+	if msg.Vesting != nil {
+		if err := msg.Vesting.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -10,19 +10,22 @@ import (
 
 // Parameter store keys
 var (
-	KeyMintDenom            = []byte("MintDenom")
-	KeyInflationRateChange  = []byte("InflationRateChange")
-	KeyInflationMax         = []byte("InflationMax")
-	KeyInflationMin         = []byte("InflationMin")
-	KeyGoalBonded           = []byte("GoalBonded")
-	KeyBlocksPerYear        = []byte("BlocksPerYear")
-	KeyMintAir              = []byte("MintAir")
-	KeyIntegrationAddresses = []byte("IntegrationAddresses")
-	KeyMaxWithdrawalPerTime = []byte("MaxWithdrawalPerTime")
-	KeyEligibleAccountsPool = []byte("EligibleAccountsPool")
-	KeyMaxAllowedMintVolume = []byte("MaxAllowedMintVolume")
-	KeyAllowedMintDenoms    = []byte("AllowedMintDenoms")
-	KeyAllowedMinter        = []byte("AllowedMinter")
+	KeyMintDenom              = []byte("MintDenom")
+	KeyInflationRateChange    = []byte("InflationRateChange")
+	KeyInflationMax           = []byte("InflationMax")
+	KeyInflationMin           = []byte("InflationMin")
+	KeyGoalBonded             = []byte("GoalBonded")
+	KeyBlocksPerYear          = []byte("BlocksPerYear")
+	KeyMintAir                = []byte("MintAir")
+	KeyIntegrationAddresses   = []byte("IntegrationAddresses")
+	KeyMaxWithdrawalPerTime   = []byte("MaxWithdrawalPerTime")
+	KeyEligibleAccountsPool   = []byte("EligibleAccountsPool")
+	KeyMaxAllowedMintVolume   = []byte("MaxAllowedMintVolume")
+	KeyAllowedMintDenoms      = []byte("AllowedMintDenoms")
+	KeyAllowedMinter          = []byte("AllowedMinter")
+	KeyMintVolumeWindow       = []byte("MintVolumeWindow")
+	KeyWithdrawalPeriod       = []byte("WithdrawalPeriod")
+	KeyMaxWithdrawalPerPeriod = []byte("MaxWithdrawalPerPeriod")
 )
 
 // ParamTable for minting module.
@@ -41,42 +44,50 @@ func NewParams(
 	maxAllowedMintVolume sdk.Coins,
 	allowedMintDenoms []string,
 	AllowedMinter []string,
-
+	mintVolumeWindow uint64,
+	withdrawalPeriod uint64,
+	maxWithdrawalPerPeriod sdk.Coins,
 ) Params {
 
 	return Params{
-		MintDenom:            mintDenom,
-		InflationRateChange:  inflationRateChange,
-		InflationMax:         inflationMax,
-		InflationMin:         inflationMin,
-		GoalBonded:           goalBonded,
-		BlocksPerYear:        blocksPerYear,
-		MintAir:              mintAir,
-		IntegrationAddresses: integrationAddresses,
-		MaxWithdrawalPerTime: MaxWithdrawalPerTime,
-		EligibleAccountsPool: eligibleAccountsPool,
-		MaxAllowedMintVolume: maxAllowedMintVolume,
-		AllowedMintDenoms:    allowedMintDenoms,
-		AllowedMinter:        AllowedMinter,
+		MintDenom:              mintDenom,
+		InflationRateChange:    inflationRateChange,
+		InflationMax:           inflationMax,
+		InflationMin:           inflationMin,
+		GoalBonded:             goalBonded,
+		BlocksPerYear:          blocksPerYear,
+		MintAir:                mintAir,
+		IntegrationAddresses:   integrationAddresses,
+		MaxWithdrawalPerTime:   MaxWithdrawalPerTime,
+		EligibleAccountsPool:   eligibleAccountsPool,
+		MaxAllowedMintVolume:   maxAllowedMintVolume,
+		AllowedMintDenoms:      allowedMintDenoms,
+		AllowedMinter:          AllowedMinter,
+		MintVolumeWindow:       mintVolumeWindow,
+		WithdrawalPeriod:       withdrawalPeriod,
+		MaxWithdrawalPerPeriod: maxWithdrawalPerPeriod,
 	}
 }
 
 // default minting module parameters
 func DefaultParams() Params {
 	return Params{
-		MintDenom:            sdk.DefaultBondDenom,
-		InflationRateChange:  sdk.NewDecWithPrec(13, 2),
-		InflationMax:         sdk.NewDecWithPrec(20, 2),
-		InflationMin:         sdk.NewDecWithPrec(7, 2),
-		GoalBonded:           sdk.NewDecWithPrec(67, 2),
-		BlocksPerYear:        uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
-		MintAir:              false,
-		IntegrationAddresses: map[string]string{}, // default value (might be invalid for actual use)
-		MaxWithdrawalPerTime: sdk.Coins{sdk.NewCoin("loki", sdk.NewInt(100))},
-		EligibleAccountsPool: []string{"odin1pl07tk6hcpp2an3rug75as4dfgd743qp80g63g"},
-		MaxAllowedMintVolume: sdk.Coins{sdk.NewCoin("minigeo", sdk.NewInt(100000000))},
-		AllowedMintDenoms:    []string{"minigeo"},
-		AllowedMinter:        []string{"odin1pl07tk6hcpp2an3rug75as4dfgd743qp80g63g"},
+		MintDenom:              sdk.DefaultBondDenom,
+		InflationRateChange:    sdk.NewDecWithPrec(13, 2),
+		InflationMax:           sdk.NewDecWithPrec(20, 2),
+		InflationMin:           sdk.NewDecWithPrec(7, 2),
+		GoalBonded:             sdk.NewDecWithPrec(67, 2),
+		BlocksPerYear:          uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		MintAir:                false,
+		IntegrationAddresses:   map[string]string{}, // default value (might be invalid for actual use)
+		MaxWithdrawalPerTime:   sdk.Coins{sdk.NewCoin("loki", sdk.NewInt(100))},
+		EligibleAccountsPool:   []string{"odin1pl07tk6hcpp2an3rug75as4dfgd743qp80g63g"},
+		MaxAllowedMintVolume:   sdk.Coins{sdk.NewCoin("minigeo", sdk.NewInt(100000000))},
+		AllowedMintDenoms:      []string{"minigeo"},
+		AllowedMinter:          []string{"odin1pl07tk6hcpp2an3rug75as4dfgd743qp80g63g"},
+		MintVolumeWindow:       uint64(60 * 60 * 24 / 5), // a day, assuming 5 second block times
+		WithdrawalPeriod:       uint64(60 * 60 * 24 / 5), // a day, assuming 5 second block times
+		MaxWithdrawalPerPeriod: sdk.Coins{sdk.NewCoin("loki", sdk.NewInt(1000))},
 	}
 }
 
@@ -121,6 +132,15 @@ func (p Params) Validate() error {
 	if err := validateAllowedMinter(p.AllowedMinter); err != nil {
 		return err
 	}
+	if err := validateMintVolumeWindow(p.MintVolumeWindow); err != nil {
+		return err
+	}
+	if err := validateWithdrawalPeriod(p.WithdrawalPeriod); err != nil {
+		return err
+	}
+	if err := validateMaxWithdrawalPerPeriod(p.MaxWithdrawalPerPeriod); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
@@ -143,9 +163,13 @@ func (p Params) String() string {
   Integration Addresses: 	%s
   Max Withdrawal Per Time:	%s
   Eligible Accounts Pool: 	%s
+  Mint Volume Window:     	%d
+  Withdrawal Period:      	%d
+  Max Withdrawal Per Period:	%s
 `,
 		p.MintDenom, p.InflationRateChange, p.InflationMax, p.InflationMin, p.GoalBonded,
 		p.BlocksPerYear, p.IntegrationAddresses, p.MaxWithdrawalPerTime, p.EligibleAccountsPool,
+		p.MintVolumeWindow, p.WithdrawalPeriod, p.MaxWithdrawalPerPeriod,
 	)
 }
 
@@ -165,6 +189,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMaxAllowedMintVolume, &p.MaxAllowedMintVolume, validateMaxAllowedMintVolume),
 		paramtypes.NewParamSetPair(KeyAllowedMintDenoms, &p.AllowedMintDenoms, validateAllowedMintDenoms),
 		paramtypes.NewParamSetPair(KeyAllowedMinter, &p.AllowedMinter, validateAllowedMinter),
+		paramtypes.NewParamSetPair(KeyMintVolumeWindow, &p.MintVolumeWindow, validateMintVolumeWindow),
+		paramtypes.NewParamSetPair(KeyWithdrawalPeriod, &p.WithdrawalPeriod, validateWithdrawalPeriod),
+		paramtypes.NewParamSetPair(KeyMaxWithdrawalPerPeriod, &p.MaxWithdrawalPerPeriod, validateMaxWithdrawalPerPeriod),
 	}
 }
 
@@ -334,3 +361,36 @@ func validateAllowedMinter(i interface{}) error {
 
 	return nil
 }
+
+func validateMintVolumeWindow(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateWithdrawalPeriod(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxWithdrawalPerPeriod(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.IsValid() {
+		return fmt.Errorf("max withdrawal per period parameter is not valid: %s", v)
+	}
+	if v.IsAnyNegative() {
+		return fmt.Errorf("max withdrawal per period cannot be negative: %s", v)
+	}
+
+	return nil
+}
@@ -53,6 +53,14 @@ type Params struct {
 	AllowedMintDenoms []string `protobuf:"bytes,12,rep,name=allowed_mint_denoms,json=allowedMintDenoms,proto3" json:"allowed_mint_denoms,omitempty" yaml:"allowed_mint_denoms"`
 	// allowed minter
 	AllowedMinter []string `protobuf:"bytes,13,rep,name=allowed_minter,json=allowedMinter,proto3" json:"allowed_minter,omitempty" yaml:"allowed_minter"`
+	// number of blocks the mint volume of a denom is accounted over before it
+	// resets, zero to never reset it
+	MintVolumeWindow uint64 `protobuf:"varint,14,opt,name=mint_volume_window,json=mintVolumeWindow,proto3" json:"mint_volume_window,omitempty" yaml:"mint_volume_window"`
+	// number of blocks the withdrawals of an account are accounted over, zero to
+	// not limit them
+	WithdrawalPeriod uint64 `protobuf:"varint,15,opt,name=withdrawal_period,json=withdrawalPeriod,proto3" json:"withdrawal_period,omitempty" yaml:"withdrawal_period"`
+	// max amount an account can withdraw per withdrawal period
+	MaxWithdrawalPerPeriod github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,16,rep,name=max_withdrawal_per_period,json=maxWithdrawalPerPeriod,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_withdrawal_per_period" yaml:"max_withdrawal_per_period"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -150,6 +158,27 @@ func (m *Params) GetAllowedMinter() []string {
 	return nil
 }
 
+func (m *Params) GetMintVolumeWindow() uint64 {
+	if m != nil {
+		return m.MintVolumeWindow
+	}
+	return 0
+}
+
+func (m *Params) GetWithdrawalPeriod() uint64 {
+	if m != nil {
+		return m.WithdrawalPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxWithdrawalPerPeriod() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxWithdrawalPerPeriod
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "mint.Params")
 	proto.RegisterMapType((map[string]string)(nil), "mint.Params.IntegrationAddressesEntry")
@@ -158,54 +187,59 @@ func init() {
 func init() { proto.RegisterFile("mint/params.proto", fileDescriptor_04d03971f940ff2c) }
 
 var fileDescriptor_04d03971f940ff2c = []byte{
-	// 740 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x4f, 0xdb, 0x48,
-	0x14, 0xc7, 0x63, 0xc2, 0x8f, 0x64, 0x80, 0x65, 0x31, 0x81, 0x35, 0xd1, 0x62, 0x67, 0x7d, 0x40,
-	0xb9, 0x60, 0x8b, 0xdd, 0xcb, 0x8a, 0x5b, 0x4c, 0x76, 0x11, 0xd2, 0xb2, 0x8a, 0xac, 0xaa, 0xa8,
-	0xbd, 0x58, 0x13, 0xfb, 0x35, 0x8c, 0x62, 0x7b, 0xa2, 0xb1, 0x03, 0xc9, 0xa1, 0x97, 0xfe, 0x05,
-	0x3d, 0xf6, 0xc8, 0xa1, 0xa7, 0xfe, 0x25, 0x1c, 0x39, 0x56, 0x3d, 0xb8, 0x15, 0x5c, 0x7a, 0x8e,
-	0xfa, 0x07, 0x54, 0x33, 0x63, 0x42, 0xa0, 0x41, 0x2a, 0x55, 0x2f, 0xc9, 0xcc, 0x7b, 0xdf, 0xf9,
-	0x7c, 0xe7, 0xc7, 0xf3, 0x43, 0xab, 0x11, 0x89, 0x53, 0xbb, 0x87, 0x19, 0x8e, 0x12, 0xab, 0xc7,
-	0x68, 0x4a, 0xd5, 0x59, 0x1e, 0xaa, 0x56, 0x3a, 0xb4, 0x43, 0x45, 0xc0, 0xe6, 0x23, 0x99, 0xab,
-	0xea, 0x3e, 0x4d, 0x22, 0x9a, 0xd8, 0x6d, 0x9c, 0x80, 0x7d, 0xba, 0xdb, 0x86, 0x14, 0xef, 0xda,
-	0x3e, 0x25, 0x71, 0x9e, 0x5f, 0x11, 0x38, 0xfe, 0x23, 0x03, 0xe6, 0x17, 0x84, 0xe6, 0x5b, 0x82,
-	0xae, 0x6e, 0x21, 0xc4, 0x13, 0x5e, 0x00, 0x31, 0x8d, 0x34, 0xa5, 0xa6, 0xd4, 0xcb, 0x6e, 0x99,
-	0x47, 0x9a, 0x3c, 0xa0, 0xbe, 0x52, 0xd0, 0x3a, 0x89, 0x5f, 0x84, 0x38, 0x25, 0x34, 0xf6, 0x18,
-	0x4e, 0xc1, 0xf3, 0x4f, 0x70, 0xdc, 0x01, 0x6d, 0x86, 0x4b, 0x9d, 0xff, 0x2f, 0x32, 0xa3, 0xf0,
-	0x21, 0x33, 0xb6, 0x3b, 0x24, 0x3d, 0xe9, 0xb7, 0x2d, 0x9f, 0x46, 0x76, 0xbe, 0x1b, 0xf9, 0xb7,
-	0x93, 0x04, 0x5d, 0x3b, 0x1d, 0xf6, 0x20, 0xb1, 0x9a, 0xe0, 0x8f, 0x32, 0xe3, 0xf7, 0x21, 0x8e,
-	0xc2, 0x3d, 0x73, 0x2a, 0xd4, 0x74, 0xd7, 0xc6, 0x71, 0x17, 0xa7, 0xb0, 0x2f, 0xa2, 0x6a, 0x17,
-	0x2d, 0xdf, 0xca, 0x23, 0x3c, 0xd0, 0x8a, 0xc2, 0xfb, 0xdf, 0x47, 0x7b, 0x57, 0xee, 0x7b, 0x47,
-	0x78, 0x60, 0xba, 0x4b, 0xe3, 0xf9, 0x11, 0x1e, 0xdc, 0x33, 0x23, 0xb1, 0x36, 0xfb, 0xd3, 0xcc,
-	0x48, 0x7c, 0xc7, 0x8c, 0xc4, 0x2a, 0xa0, 0xc5, 0x0e, 0xc5, 0xa1, 0xd7, 0xa6, 0x71, 0x00, 0x81,
-	0x36, 0x27, 0xac, 0x9a, 0x8f, 0xb6, 0x52, 0xa5, 0xd5, 0x04, 0xca, 0x74, 0x11, 0x9f, 0x39, 0x62,
-	0xa2, 0x3a, 0x68, 0xa5, 0x1d, 0x52, 0xbf, 0x9b, 0x78, 0x3d, 0x60, 0xde, 0x10, 0x30, 0xd3, 0xe6,
-	0x6b, 0x4a, 0x7d, 0xd6, 0xa9, 0x8e, 0x32, 0x63, 0x43, 0x2e, 0xbe, 0x27, 0x30, 0xdd, 0x65, 0x19,
-	0x69, 0x01, 0x7b, 0x06, 0x98, 0xa9, 0x6f, 0x15, 0xf4, 0x5b, 0x84, 0x07, 0xde, 0x19, 0x49, 0x4f,
-	0x02, 0x86, 0xcf, 0x70, 0x28, 0xb4, 0x29, 0x89, 0x40, 0x5b, 0xa8, 0x15, 0xeb, 0x8b, 0x7f, 0x6e,
-	0x5a, 0x72, 0x7b, 0x16, 0xaf, 0x43, 0x2b, 0xaf, 0x43, 0x6b, 0x9f, 0x92, 0xd8, 0x71, 0xf9, 0x91,
-	0x46, 0x99, 0xa1, 0x4b, 0xaf, 0x07, 0x38, 0xe6, 0xbb, 0x8f, 0x46, 0xfd, 0x3b, 0x0e, 0xcd, 0x91,
-	0x89, 0x5b, 0x89, 0xf0, 0xe0, 0x78, 0x0c, 0x69, 0x01, 0x7b, 0x42, 0x22, 0x50, 0x5f, 0xf2, 0x7a,
-	0x4d, 0xa1, 0xc3, 0xe4, 0x9d, 0xe3, 0x20, 0x60, 0x90, 0x24, 0x90, 0x68, 0x25, 0xb1, 0xc7, 0x6d,
-	0x4b, 0x7c, 0x06, 0xb2, 0xf8, 0xad, 0xc3, 0x5b, 0x65, 0xe3, 0x46, 0xf8, 0x4f, 0x9c, 0xb2, 0xa1,
-	0x53, 0x9b, 0xac, 0xd4, 0x29, 0x38, 0xd3, 0xad, 0x90, 0x29, 0x8b, 0x55, 0x0b, 0x95, 0xc4, 0xe7,
-	0x84, 0x09, 0xd3, 0xca, 0x35, 0xa5, 0x5e, 0x72, 0xd6, 0x46, 0x99, 0xb1, 0x92, 0x1f, 0x3b, 0xcf,
-	0x98, 0xee, 0x02, 0x1f, 0x36, 0x08, 0x53, 0x8f, 0xd1, 0x06, 0x84, 0xa4, 0x43, 0xda, 0x21, 0x78,
-	0xd8, 0xf7, 0x69, 0x3f, 0x4e, 0x13, 0xaf, 0x47, 0x69, 0xa8, 0xa1, 0x5a, 0xb1, 0x5e, 0x76, 0xfe,
-	0x18, 0x65, 0xc6, 0x96, 0x5c, 0x3d, 0x5d, 0x67, 0xba, 0x95, 0x9b, 0x44, 0x23, 0x8f, 0xb7, 0x28,
-	0x0d, 0xc7, 0xcf, 0x85, 0xc3, 0x90, 0x9e, 0x41, 0xe0, 0x09, 0xef, 0x53, 0x1a, 0xf6, 0x23, 0xd0,
-	0x16, 0x7f, 0xe0, 0xb9, 0xa6, 0x70, 0x1e, 0xff, 0x5c, 0x0d, 0x09, 0x39, 0x22, 0x71, 0xfa, 0x54,
-	0x20, 0x54, 0x17, 0xad, 0xdd, 0x21, 0x8b, 0x36, 0x94, 0x68, 0x4b, 0xe2, 0xf0, 0xe6, 0x45, 0x66,
-	0x28, 0xa3, 0xcc, 0xa8, 0xca, 0x6d, 0x4c, 0x11, 0x9a, 0xee, 0x2a, 0xbe, 0x65, 0x8a, 0x96, 0x95,
-	0xa8, 0x4d, 0xf4, 0xcb, 0xa4, 0x14, 0x98, 0xb6, 0x2c, 0x70, 0x5b, 0x39, 0x6e, 0xfd, 0x5b, 0x1c,
-	0xf0, 0x7a, 0x9f, 0x20, 0x01, 0xab, 0x1e, 0xa0, 0xcd, 0x07, 0xcb, 0x43, 0xfd, 0x15, 0x15, 0xbb,
-	0x30, 0xcc, 0xdb, 0x25, 0x1f, 0xaa, 0x15, 0x34, 0x77, 0x8a, 0xc3, 0x7e, 0xde, 0x17, 0x5d, 0x39,
-	0xd9, 0x9b, 0xf9, 0x5b, 0xd9, 0x2b, 0xbd, 0x39, 0x37, 0x0a, 0x9f, 0xcf, 0x0d, 0xc5, 0x39, 0xbc,
-	0xb8, 0xd2, 0x95, 0xcb, 0x2b, 0x5d, 0xf9, 0x74, 0xa5, 0x2b, 0xaf, 0xaf, 0xf5, 0xc2, 0xe5, 0xb5,
-	0x5e, 0x78, 0x7f, 0xad, 0x17, 0x9e, 0xdb, 0x13, 0xd7, 0x78, 0x00, 0xb4, 0xe9, 0xec, 0xfc, 0x47,
-	0x22, 0x92, 0x42, 0x60, 0xd3, 0x80, 0xc4, 0x3b, 0x3e, 0x65, 0x60, 0x0f, 0x44, 0x07, 0x97, 0x77,
-	0xda, 0x9e, 0x17, 0x8d, 0xfc, 0xaf, 0xaf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x35, 0x9c, 0x66, 0x4c,
-	0x2a, 0x06, 0x00, 0x00,
+	// 825 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x31, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x63, 0xc7, 0xb1, 0xcf, 0x51, 0x24, 0x9f, 0x15, 0xf7, 0x24, 0xc4, 0xa4, 0xca, 0x21,
+	0xd0, 0x62, 0x12, 0x69, 0x97, 0xc2, 0x53, 0xc5, 0xa8, 0x0d, 0x8c, 0x36, 0x81, 0x40, 0x04, 0x35,
+	0xda, 0x85, 0x38, 0x91, 0x57, 0xf9, 0x20, 0xf2, 0x4e, 0x38, 0x52, 0x96, 0x34, 0x74, 0xe9, 0x2f,
+	0xe8, 0xd8, 0x31, 0x43, 0xa7, 0x6c, 0xfd, 0x17, 0x19, 0x33, 0x16, 0x1d, 0xd8, 0xc2, 0x5e, 0x3a,
+	0xf3, 0x17, 0x14, 0xbc, 0x63, 0x64, 0x4a, 0x96, 0xd1, 0xaa, 0xe8, 0x62, 0xdf, 0x7d, 0xf7, 0xdd,
+	0xf7, 0xbd, 0xa7, 0xf7, 0x1e, 0x0f, 0x1c, 0x44, 0x94, 0x25, 0xf6, 0x18, 0x0b, 0x1c, 0xc5, 0xd6,
+	0x58, 0xf0, 0x84, 0xc3, 0xed, 0x1c, 0x6a, 0x35, 0x86, 0x7c, 0xc8, 0x25, 0x60, 0xe7, 0x2b, 0x75,
+	0xd6, 0xd2, 0x7d, 0x1e, 0x47, 0x3c, 0xb6, 0x07, 0x38, 0x26, 0xf6, 0xe5, 0xb3, 0x01, 0x49, 0xf0,
+	0x33, 0xdb, 0xe7, 0x94, 0x15, 0xe7, 0x35, 0x29, 0x97, 0xff, 0x51, 0x80, 0xf9, 0x6b, 0x15, 0xec,
+	0xf4, 0xa5, 0x3a, 0x3c, 0x06, 0x20, 0x3f, 0xf0, 0x02, 0xc2, 0x78, 0x84, 0xb4, 0xb6, 0xd6, 0xd9,
+	0x73, 0xf7, 0x72, 0xa4, 0x97, 0x03, 0xf0, 0x47, 0x0d, 0x3c, 0xa6, 0xec, 0xfb, 0x10, 0x27, 0x94,
+	0x33, 0x4f, 0xe0, 0x84, 0x78, 0xfe, 0x05, 0x66, 0x43, 0x82, 0xee, 0xe5, 0x54, 0xe7, 0xd5, 0xbb,
+	0xd4, 0xa8, 0xfc, 0x9e, 0x1a, 0x4f, 0x87, 0x34, 0xb9, 0x98, 0x0c, 0x2c, 0x9f, 0x47, 0x76, 0x11,
+	0x8d, 0xfa, 0x77, 0x12, 0x07, 0x23, 0x3b, 0x99, 0x8f, 0x49, 0x6c, 0xf5, 0x88, 0x9f, 0xa5, 0xc6,
+	0x93, 0x39, 0x8e, 0xc2, 0x53, 0x73, 0xad, 0xa8, 0xe9, 0x1e, 0x2e, 0x70, 0x17, 0x27, 0xe4, 0xb9,
+	0x44, 0xe1, 0x08, 0x54, 0x6f, 0xe8, 0x11, 0x9e, 0xa1, 0x2d, 0xe9, 0xfd, 0xe5, 0xc6, 0xde, 0x8d,
+	0x55, 0xef, 0x08, 0xcf, 0x4c, 0xf7, 0xe1, 0x62, 0xff, 0x12, 0xcf, 0x56, 0xcc, 0x28, 0x43, 0xdb,
+	0xff, 0x9b, 0x19, 0x65, 0x4b, 0x66, 0x94, 0x41, 0x02, 0xf6, 0x87, 0x1c, 0x87, 0xde, 0x80, 0xb3,
+	0x80, 0x04, 0xe8, 0xbe, 0xb4, 0xea, 0x6d, 0x6c, 0x05, 0x95, 0x55, 0x49, 0xca, 0x74, 0x41, 0xbe,
+	0x73, 0xe4, 0x06, 0x3a, 0xa0, 0x36, 0x08, 0xb9, 0x3f, 0x8a, 0xbd, 0x31, 0x11, 0xde, 0x9c, 0x60,
+	0x81, 0x76, 0xda, 0x5a, 0x67, 0xdb, 0x69, 0x65, 0xa9, 0x71, 0xa4, 0x2e, 0xaf, 0x10, 0x4c, 0xb7,
+	0xaa, 0x90, 0x3e, 0x11, 0xdf, 0x12, 0x2c, 0xe0, 0x2f, 0x1a, 0xf8, 0x28, 0xc2, 0x33, 0x6f, 0x4a,
+	0x93, 0x8b, 0x40, 0xe0, 0x29, 0x0e, 0x25, 0x37, 0xa1, 0x11, 0x41, 0x0f, 0xda, 0x5b, 0x9d, 0xfd,
+	0x4f, 0x9a, 0x96, 0x0a, 0xcf, 0xca, 0xfb, 0xd0, 0x2a, 0xfa, 0xd0, 0x7a, 0xce, 0x29, 0x73, 0xdc,
+	0x3c, 0xa5, 0x2c, 0x35, 0x74, 0xe5, 0x75, 0x87, 0x8e, 0xf9, 0xf6, 0x0f, 0xa3, 0xf3, 0x2f, 0x92,
+	0xce, 0x25, 0x63, 0xb7, 0x11, 0xe1, 0xd9, 0xf9, 0x42, 0xa4, 0x4f, 0xc4, 0x6b, 0x1a, 0x11, 0xf8,
+	0x43, 0xde, 0xaf, 0x09, 0x19, 0x0a, 0xf5, 0x9b, 0xe3, 0x20, 0x10, 0x24, 0x8e, 0x49, 0x8c, 0x76,
+	0x65, 0x8c, 0x4f, 0x2d, 0x39, 0x06, 0xaa, 0xf9, 0xad, 0xb3, 0x1b, 0x66, 0xf7, 0x03, 0xf1, 0x0b,
+	0x96, 0x88, 0xb9, 0xd3, 0x2e, 0x77, 0xea, 0x1a, 0x39, 0xd3, 0x6d, 0xd0, 0x35, 0x97, 0xa1, 0x05,
+	0x76, 0xe5, 0x38, 0x61, 0x2a, 0xd0, 0x5e, 0x5b, 0xeb, 0xec, 0x3a, 0x87, 0x59, 0x6a, 0xd4, 0x8a,
+	0xb4, 0x8b, 0x13, 0xd3, 0x7d, 0x90, 0x2f, 0xbb, 0x54, 0xc0, 0x73, 0x70, 0x44, 0x42, 0x3a, 0xa4,
+	0x83, 0x90, 0x78, 0xd8, 0xf7, 0xf9, 0x84, 0x25, 0xb1, 0x37, 0xe6, 0x3c, 0x44, 0xa0, 0xbd, 0xd5,
+	0xd9, 0x73, 0x3e, 0xce, 0x52, 0xe3, 0x58, 0xdd, 0x5e, 0xcf, 0x33, 0xdd, 0xc6, 0x87, 0x83, 0x6e,
+	0x81, 0xf7, 0x39, 0x0f, 0x17, 0xe5, 0xc2, 0x61, 0xc8, 0xa7, 0x24, 0xf0, 0xa4, 0xf7, 0x25, 0x0f,
+	0x27, 0x11, 0x41, 0xfb, 0xff, 0xa1, 0x5c, 0x6b, 0x74, 0x36, 0x2f, 0x57, 0x57, 0x89, 0xbc, 0xa4,
+	0x2c, 0xf9, 0x46, 0x4a, 0xc0, 0x57, 0xe0, 0x70, 0x49, 0x59, 0x7e, 0x86, 0x62, 0xf4, 0x50, 0x26,
+	0xaf, 0x67, 0xa9, 0xd1, 0x52, 0x21, 0xac, 0x21, 0x99, 0xee, 0x01, 0xbe, 0xd1, 0x93, 0x9f, 0xab,
+	0x18, 0x7e, 0x0e, 0x1e, 0x95, 0xa9, 0x44, 0xa0, 0xaa, 0x94, 0x6a, 0x66, 0xa9, 0xf1, 0xf8, 0xb6,
+	0x14, 0xc9, 0xfb, 0xbc, 0xa4, 0x42, 0x04, 0xfc, 0x0a, 0xc0, 0x52, 0x8e, 0xde, 0x94, 0xb2, 0x80,
+	0x4f, 0xd1, 0x23, 0x39, 0x2e, 0xc7, 0x59, 0x6a, 0x34, 0x4b, 0xb5, 0x5c, 0xe2, 0x98, 0x6e, 0x3d,
+	0x5a, 0x24, 0x76, 0x2e, 0x21, 0x78, 0x06, 0x0e, 0x96, 0xfb, 0x9c, 0xf2, 0x00, 0xd5, 0xa4, 0xd6,
+	0x93, 0x2c, 0x35, 0x90, 0xd2, 0xba, 0x45, 0x31, 0xdd, 0xfa, 0xb4, 0xdc, 0xd9, 0x94, 0x07, 0xf0,
+	0xad, 0x06, 0x9a, 0x6b, 0xe6, 0xa6, 0xd0, 0xac, 0xff, 0x53, 0x49, 0x5f, 0x17, 0x25, 0x6d, 0xdf,
+	0x39, 0x81, 0x85, 0xf5, 0x46, 0x45, 0x3d, 0x5a, 0x9d, 0x41, 0x15, 0x6c, 0xeb, 0x05, 0x68, 0xde,
+	0x39, 0x5b, 0xb0, 0x0e, 0xb6, 0x46, 0x64, 0x5e, 0xbc, 0x35, 0xf9, 0x12, 0x36, 0xc0, 0xfd, 0x4b,
+	0x1c, 0x4e, 0x8a, 0x47, 0xc5, 0x55, 0x9b, 0xd3, 0x7b, 0x9f, 0x69, 0xa7, 0xbb, 0x3f, 0xbf, 0x31,
+	0x2a, 0x7f, 0xbd, 0x31, 0x34, 0xe7, 0xec, 0xdd, 0x95, 0xae, 0xbd, 0xbf, 0xd2, 0xb5, 0x3f, 0xaf,
+	0x74, 0xed, 0xa7, 0x6b, 0xbd, 0xf2, 0xfe, 0x5a, 0xaf, 0xfc, 0x76, 0xad, 0x57, 0xbe, 0xb3, 0x4b,
+	0xe1, 0xbe, 0x20, 0xbc, 0xe7, 0x9c, 0x7c, 0x4d, 0x23, 0x9a, 0x90, 0xc0, 0xe6, 0x01, 0x65, 0x27,
+	0x3e, 0x17, 0xc4, 0x9e, 0xc9, 0xe7, 0x4f, 0xc5, 0x3e, 0xd8, 0x91, 0xaf, 0xe0, 0xa7, 0x7f, 0x0f,
+	0x00, 0xa0, 0x79, 0xcb, 0x05, 0x67, 0x07, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -296,6 +330,20 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.MintVolumeWindow != that1.MintVolumeWindow {
+		return false
+	}
+	if this.WithdrawalPeriod != that1.WithdrawalPeriod {
+		return false
+	}
+	if len(this.MaxWithdrawalPerPeriod) != len(that1.MaxWithdrawalPerPeriod) {
+		return false
+	}
+	for i := range this.MaxWithdrawalPerPeriod {
+		if !this.MaxWithdrawalPerPeriod[i].Equal(&that1.MaxWithdrawalPerPeriod[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -318,6 +366,32 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaxWithdrawalPerPeriod) > 0 {
+		for iNdEx := len(m.MaxWithdrawalPerPeriod) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxWithdrawalPerPeriod[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.WithdrawalPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WithdrawalPeriod))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MintVolumeWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MintVolumeWindow))
+		i--
+		dAtA[i] = 0x70
+	}
 	if len(m.AllowedMinter) > 0 {
 		for iNdEx := len(m.AllowedMinter) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedMinter[iNdEx])
@@ -530,6 +604,18 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.MintVolumeWindow != 0 {
+		n += 1 + sovParams(uint64(m.MintVolumeWindow))
+	}
+	if m.WithdrawalPeriod != 0 {
+		n += 1 + sovParams(uint64(m.WithdrawalPeriod))
+	}
+	if len(m.MaxWithdrawalPerPeriod) > 0 {
+		for _, e := range m.MaxWithdrawalPerPeriod {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1066,6 +1152,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedMinter = append(m.AllowedMinter, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintVolumeWindow", wireType)
+			}
+			m.MintVolumeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintVolumeWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalPeriod", wireType)
+			}
+			m.WithdrawalPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWithdrawalPerPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxWithdrawalPerPeriod = append(m.MaxWithdrawalPerPeriod, types.Coin{})
+			if err := m.MaxWithdrawalPerPeriod[len(m.MaxWithdrawalPerPeriod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeTreasurySpend defines the type for a TreasurySpendProposal
+	ProposalTypeTreasurySpend = "TreasurySpend"
+)
+
+// Assert TreasurySpendProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &TreasurySpendProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeTreasurySpend)
+	govtypes.RegisterProposalTypeCodec(&TreasurySpendProposal{}, "mint/TreasurySpendProposal")
+}
+
+// NewTreasurySpendProposal creates a new treasury spend proposal.
+func NewTreasurySpendProposal(
+	title, description string,
+	recipient sdk.AccAddress,
+	amount sdk.Coins,
+	rationale string,
+	vesting *VestingSchedule,
+) *TreasurySpendProposal {
+	return &TreasurySpendProposal{
+		Title:       title,
+		Description: description,
+		Recipient:   recipient.String(),
+		Amount:      amount,
+		Rationale:   rationale,
+		Vesting:     vesting,
+	}
+}
+
+// GetTitle returns the title of a treasury spend proposal.
+func (p *TreasurySpendProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a treasury spend proposal.
+func (p *TreasurySpendProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a treasury spend proposal.
+func (p *TreasurySpendProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a treasury spend proposal.
+func (p *TreasurySpendProposal) ProposalType() string { return ProposalTypeTreasurySpend }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *TreasurySpendProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "recipient: %s", p.Recipient)
+	}
+	if !p.Amount.IsValid() || p.Amount.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidWithdrawalAmount, "amount: %s", p.Amount.String())
+	}
+	if strings.TrimSpace(p.Rationale) == "" {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, "proposal rationale cannot be blank")
+	}
+	if p.Vesting != nil {
+		if err := p.Vesting.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p TreasurySpendProposal) String() string {
+	return fmt.Sprintf(`Treasury Spend Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+  Amount:      %s
+  Rationale:   %s
+  Vesting:     %s
+`, p.Title, p.Description, p.Recipient, p.Amount, p.Rationale, p.Vesting)
+}
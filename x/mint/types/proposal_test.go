@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTreasurySpendProposalValidateBasic(t *testing.T) {
+	recipient := sdk.AccAddress("recipient___________")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("loki", 1000))
+
+	tests := []struct {
+		name     string
+		proposal *TreasurySpendProposal
+		expPass  bool
+	}{
+		{"valid", NewTreasurySpendProposal("title", "description", recipient, amount, "rationale", nil), true},
+		{"empty title", NewTreasurySpendProposal("", "description", recipient, amount, "rationale", nil), false},
+		{"empty recipient", NewTreasurySpendProposal("title", "description", nil, amount, "rationale", nil), false},
+		{"empty amount", NewTreasurySpendProposal("title", "description", recipient, sdk.NewCoins(), "rationale", nil), false},
+		{"vesting", NewTreasurySpendProposal("title", "description", recipient, amount, "rationale",
+			NewVestingSchedule(VestingTypeContinuous, 0, time.Hour, 0)), true},
+		{"invalid vesting", NewTreasurySpendProposal("title", "description", recipient, amount, "rationale",
+			NewVestingSchedule(VestingTypePeriodic, 0, time.Hour, 0)), false},
+		{"blank rationale", NewTreasurySpendProposal("title", "description", recipient, amount, " ", nil), false},
+	}
+
+	for _, tc := range tests {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
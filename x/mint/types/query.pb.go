@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -487,6 +488,10 @@ var xxx_messageInfo_QueryCurrentMintVolumeRequest proto.InternalMessageInfo
 // RPC method.
 type QueryCurrentMintVolumeResponse struct {
 	CurrentMintVolume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=current_mint_volume,json=currentMintVolume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"current_mint_volume"`
+	// mint_volume_windows are the windows the current mint volume is accounted over
+	MintVolumeWindows []MintVolumeWindow `protobuf:"bytes,2,rep,name=mint_volume_windows,json=mintVolumeWindows,proto3" json:"mint_volume_windows"`
+	// remaining_mint_volume is the volume that can still be minted until the windows reset
+	RemainingMintVolume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=remaining_mint_volume,json=remainingMintVolume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining_mint_volume"`
 }
 
 func (m *QueryCurrentMintVolumeResponse) Reset()         { *m = QueryCurrentMintVolumeResponse{} }
@@ -529,6 +534,129 @@ func (m *QueryCurrentMintVolumeResponse) GetCurrentMintVolume() github_com_cosmo
 	return nil
 }
 
+func (m *QueryCurrentMintVolumeResponse) GetMintVolumeWindows() []MintVolumeWindow {
+	if m != nil {
+		return m.MintVolumeWindows
+	}
+	return nil
+}
+
+func (m *QueryCurrentMintVolumeResponse) GetRemainingMintVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RemainingMintVolume
+	}
+	return nil
+}
+
+// QueryTreasuryWithdrawalsRequest is request type for the
+// Query/TreasuryWithdrawals RPC method.
+type QueryTreasuryWithdrawalsRequest struct {
+	// receiver filters the withdrawals by the receiving account if set
+	Receiver   string             `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTreasuryWithdrawalsRequest) Reset()         { *m = QueryTreasuryWithdrawalsRequest{} }
+func (m *QueryTreasuryWithdrawalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryWithdrawalsRequest) ProtoMessage()    {}
+func (*QueryTreasuryWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{12}
+}
+func (m *QueryTreasuryWithdrawalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryWithdrawalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryWithdrawalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasuryWithdrawalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryWithdrawalsRequest.Merge(m, src)
+}
+func (m *QueryTreasuryWithdrawalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryWithdrawalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryWithdrawalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryWithdrawalsRequest proto.InternalMessageInfo
+
+func (m *QueryTreasuryWithdrawalsRequest) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *QueryTreasuryWithdrawalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTreasuryWithdrawalsResponse is response type for the
+// Query/TreasuryWithdrawals RPC method.
+type QueryTreasuryWithdrawalsResponse struct {
+	Withdrawals []TreasuryWithdrawal `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals"`
+	Pagination  *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTreasuryWithdrawalsResponse) Reset()         { *m = QueryTreasuryWithdrawalsResponse{} }
+func (m *QueryTreasuryWithdrawalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryWithdrawalsResponse) ProtoMessage()    {}
+func (*QueryTreasuryWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{13}
+}
+func (m *QueryTreasuryWithdrawalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryWithdrawalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryWithdrawalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasuryWithdrawalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryWithdrawalsResponse.Merge(m, src)
+}
+func (m *QueryTreasuryWithdrawalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryWithdrawalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryWithdrawalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryWithdrawalsResponse proto.InternalMessageInfo
+
+func (m *QueryTreasuryWithdrawalsResponse) GetWithdrawals() []TreasuryWithdrawal {
+	if m != nil {
+		return m.Withdrawals
+	}
+	return nil
+}
+
+func (m *QueryTreasuryWithdrawalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "mint.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "mint.QueryParamsResponse")
@@ -542,58 +670,72 @@ func init() {
 	proto.RegisterType((*QueryTreasuryPoolResponse)(nil), "mint.QueryTreasuryPoolResponse")
 	proto.RegisterType((*QueryCurrentMintVolumeRequest)(nil), "mint.QueryCurrentMintVolumeRequest")
 	proto.RegisterType((*QueryCurrentMintVolumeResponse)(nil), "mint.QueryCurrentMintVolumeResponse")
+	proto.RegisterType((*QueryTreasuryWithdrawalsRequest)(nil), "mint.QueryTreasuryWithdrawalsRequest")
+	proto.RegisterType((*QueryTreasuryWithdrawalsResponse)(nil), "mint.QueryTreasuryWithdrawalsResponse")
 }
 
 func init() { proto.RegisterFile("mint/query.proto", fileDescriptor_3082aecef156f565) }
 
 var fileDescriptor_3082aecef156f565 = []byte{
-	// 733 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x51, 0x4f, 0x13, 0x4d,
-	0x14, 0xed, 0x7e, 0x1f, 0x90, 0x30, 0x54, 0x6d, 0xa7, 0x20, 0xed, 0x82, 0x5b, 0x58, 0xd1, 0x10,
-	0x22, 0x3b, 0x52, 0x7f, 0x01, 0x85, 0xc4, 0x90, 0xa0, 0xc1, 0x6a, 0x7c, 0xd0, 0x87, 0x3a, 0x6d,
-	0x87, 0x3a, 0xa1, 0x3b, 0xb3, 0xec, 0xcc, 0xa2, 0x04, 0x7c, 0xd0, 0x77, 0x13, 0x13, 0xe3, 0xa3,
-	0x7f, 0xc0, 0x1f, 0x62, 0x78, 0x24, 0xf1, 0xc5, 0xf8, 0x80, 0x06, 0xfc, 0x21, 0x66, 0x67, 0xa6,
-	0xb0, 0xb4, 0x5b, 0x34, 0x26, 0xbe, 0x40, 0x73, 0xcf, 0x9d, 0x73, 0xce, 0xbd, 0xbd, 0x27, 0x05,
-	0x39, 0x9f, 0x32, 0x89, 0xb6, 0x23, 0x12, 0xee, 0x7a, 0x41, 0xc8, 0x25, 0x87, 0x43, 0x71, 0xc5,
-	0x1e, 0x6f, 0xf3, 0x36, 0x57, 0x05, 0x14, 0x7f, 0xd2, 0x98, 0x3d, 0xdd, 0xe6, 0xbc, 0xdd, 0x21,
-	0x08, 0x07, 0x14, 0x61, 0xc6, 0xb8, 0xc4, 0x92, 0x72, 0x26, 0x0c, 0xea, 0x34, 0xb9, 0xf0, 0xb9,
-	0x40, 0x0d, 0x2c, 0x08, 0xda, 0x59, 0x6a, 0x10, 0x89, 0x97, 0x50, 0x93, 0x53, 0x66, 0xf0, 0x2b,
-	0x4a, 0x2b, 0xfe, 0x63, 0x0a, 0x79, 0x55, 0x08, 0x70, 0x88, 0x7d, 0xc3, 0xe1, 0x8e, 0x03, 0xf8,
-	0x20, 0x36, 0xb3, 0xa1, 0x8a, 0x35, 0xb2, 0x1d, 0x11, 0x21, 0xdd, 0x65, 0x50, 0x38, 0x57, 0x15,
-	0x01, 0x67, 0x82, 0xc0, 0x05, 0x30, 0xa2, 0x1f, 0x17, 0xad, 0x19, 0x6b, 0x7e, 0xac, 0x92, 0xf5,
-	0x14, 0xb9, 0xee, 0xaa, 0x0e, 0x1d, 0x1c, 0x95, 0x33, 0x35, 0xd3, 0xe1, 0x4e, 0x82, 0x09, 0x45,
-	0xb1, 0xc6, 0x36, 0x3b, 0xca, 0x75, 0x97, 0x7b, 0x13, 0x5c, 0xed, 0x05, 0x0c, 0xfd, 0x3a, 0x18,
-	0xa5, 0xdd, 0xa2, 0x52, 0x18, 0xad, 0x7a, 0x31, 0xe7, 0xb7, 0xa3, 0xf2, 0xcd, 0x36, 0x95, 0xcf,
-	0xa3, 0x86, 0xd7, 0xe4, 0x3e, 0x32, 0x53, 0xeb, 0x7f, 0x8b, 0xa2, 0xb5, 0x85, 0xe4, 0x6e, 0x40,
-	0x84, 0xb7, 0x4a, 0x9a, 0xb5, 0x33, 0x02, 0xd7, 0x01, 0xd3, 0x4a, 0x67, 0x99, 0xb1, 0x08, 0x77,
-	0x36, 0x42, 0xbe, 0x43, 0x45, 0xbc, 0xbc, 0xae, 0x8f, 0x7d, 0x70, 0x6d, 0x00, 0x6e, 0xec, 0x3c,
-	0x05, 0x79, 0xac, 0xb0, 0x7a, 0x70, 0x0a, 0xfe, 0xa5, 0xad, 0x1c, 0xee, 0x11, 0x71, 0x57, 0x80,
-	0x63, 0xb6, 0x20, 0x49, 0x3b, 0x54, 0x8e, 0x97, 0x5b, 0xad, 0x90, 0x88, 0xae, 0x3f, 0x38, 0x0b,
-	0xb2, 0x8c, 0xc8, 0x17, 0x3c, 0xdc, 0xaa, 0x33, 0xec, 0x13, 0xad, 0x5c, 0x1b, 0x33, 0xb5, 0xfb,
-	0xd8, 0x27, 0x6e, 0x0d, 0x94, 0x07, 0x92, 0x98, 0x21, 0x10, 0x28, 0xd0, 0x33, 0xb4, 0x8e, 0x35,
-	0x6c, 0xc8, 0x20, 0xed, 0x7b, 0xe8, 0xda, 0xa0, 0xa8, 0x38, 0x1f, 0x85, 0x04, 0x8b, 0x28, 0xdc,
-	0xdd, 0xe0, 0xbc, 0xd3, 0x5d, 0xd9, 0x5b, 0x0b, 0x94, 0x52, 0x40, 0x23, 0x15, 0x80, 0x4b, 0xd2,
-	0xd4, 0xeb, 0x01, 0xe7, 0x9d, 0xa2, 0x35, 0xf3, 0xff, 0xfc, 0x58, 0xa5, 0xe4, 0xe9, 0x95, 0x78,
-	0xf1, 0x99, 0x7a, 0xe6, 0x4c, 0xbd, 0x15, 0x4e, 0x59, 0xf5, 0x76, 0xbc, 0xc6, 0x4f, 0xdf, 0xcb,
-	0xf3, 0x7f, 0xb0, 0xc6, 0xf8, 0x81, 0xa8, 0x65, 0x65, 0x42, 0xd9, 0x2d, 0x9b, 0xaf, 0x70, 0x25,
-	0x0a, 0x43, 0xc2, 0xe4, 0x3d, 0xca, 0xe4, 0x63, 0xde, 0x89, 0x7c, 0xd2, 0x35, 0xfc, 0xd1, 0x32,
-	0x6b, 0x4e, 0xe9, 0x30, 0xae, 0xf7, 0x40, 0xa1, 0xa9, 0xc1, 0x7a, 0x7c, 0xcc, 0xf5, 0x1d, 0x05,
-	0xff, 0x0b, 0xef, 0xf9, 0x66, 0xaf, 0x89, 0xca, 0xe7, 0x61, 0x30, 0xac, 0xfc, 0xc1, 0x87, 0x60,
-	0x44, 0xc7, 0x08, 0x16, 0x75, 0xa8, 0xfa, 0x53, 0x69, 0x97, 0x52, 0x10, 0x3d, 0x85, 0x3b, 0xfe,
-	0xe6, 0xcb, 0xcf, 0xf7, 0xff, 0x5d, 0x86, 0x59, 0x94, 0x88, 0x38, 0x7c, 0x06, 0x46, 0x4f, 0x53,
-	0x06, 0xa7, 0x12, 0xaf, 0x7b, 0x43, 0x69, 0x4f, 0xa7, 0x83, 0x86, 0xbd, 0xa8, 0xd8, 0x21, 0xcc,
-	0x25, 0xd8, 0x89, 0x24, 0xa1, 0x80, 0xfb, 0x20, 0xd7, 0x9b, 0x1f, 0xe8, 0x26, 0xb8, 0x06, 0x84,
-	0xcf, 0xbe, 0x7e, 0x61, 0x8f, 0x91, 0x2d, 0x2b, 0xd9, 0x12, 0x9c, 0xd4, 0xb2, 0x7d, 0x61, 0x84,
-	0x1f, 0x2c, 0x00, 0xfb, 0x6f, 0x1f, 0xce, 0x9d, 0x1b, 0x66, 0x40, 0xbe, 0xec, 0x1b, 0xbf, 0xe9,
-	0x32, 0x26, 0x2a, 0xca, 0xc4, 0x2d, 0xb8, 0xa0, 0x4d, 0xa4, 0x84, 0x89, 0x08, 0xb4, 0x97, 0x4c,
-	0xea, 0x2b, 0xd8, 0x01, 0xd9, 0x64, 0x42, 0xa0, 0x93, 0x90, 0x4a, 0xc9, 0x95, 0x5d, 0x1e, 0x88,
-	0x1b, 0x13, 0x53, 0xca, 0xc4, 0x04, 0x2c, 0x68, 0x13, 0xe7, 0x62, 0x06, 0x5f, 0x5b, 0x20, 0xdf,
-	0x77, 0xdf, 0x30, 0xb9, 0xe1, 0x41, 0xf9, 0xb0, 0xe7, 0x2e, 0x6e, 0x32, 0xea, 0xb3, 0x4a, 0x7d,
-	0x0a, 0x96, 0xb4, 0x7a, 0x4a, 0x5c, 0xaa, 0x6b, 0x07, 0xc7, 0x8e, 0x75, 0x78, 0xec, 0x58, 0x3f,
-	0x8e, 0x1d, 0xeb, 0xdd, 0x89, 0x93, 0x39, 0x3c, 0x71, 0x32, 0x5f, 0x4f, 0x9c, 0xcc, 0x13, 0x94,
-	0xc8, 0xc7, 0x5d, 0xc2, 0x57, 0xab, 0x8b, 0xeb, 0xd4, 0xa7, 0x92, 0xb4, 0x10, 0x6f, 0x51, 0xb6,
-	0xd8, 0xe4, 0x21, 0x41, 0x2f, 0xcd, 0x58, 0x71, 0x58, 0x1a, 0x23, 0xea, 0x87, 0xe9, 0xce, 0xaf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xa7, 0xb5, 0x96, 0x80, 0x2a, 0x07, 0x00, 0x00,
+	// 922 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xd1, 0x6e, 0x1b, 0x45,
+	0x14, 0xcd, 0xa6, 0x25, 0x22, 0x37, 0x06, 0xe2, 0x71, 0xd2, 0xda, 0x9b, 0xb0, 0x4e, 0x97, 0x36,
+	0x44, 0x11, 0xd9, 0xa5, 0xe1, 0x07, 0x88, 0x53, 0x51, 0x55, 0x0a, 0x28, 0x18, 0x44, 0x25, 0x78,
+	0x30, 0x13, 0x7b, 0xba, 0x1d, 0xd5, 0x3b, 0xb3, 0x9d, 0x19, 0xdb, 0x44, 0x2d, 0x42, 0x20, 0x21,
+	0xf1, 0x82, 0x84, 0x84, 0xf8, 0x09, 0x24, 0xfe, 0xa3, 0x8f, 0x45, 0xbc, 0x20, 0x1e, 0x0a, 0x4a,
+	0x78, 0xe4, 0x23, 0xd0, 0xce, 0x8c, 0xed, 0xb1, 0xbd, 0x6e, 0x10, 0x82, 0x97, 0xc4, 0xbe, 0xe7,
+	0xce, 0x39, 0x67, 0xee, 0xcc, 0x1c, 0x19, 0x56, 0x53, 0xca, 0x54, 0xfc, 0xb0, 0x47, 0xc4, 0x69,
+	0x94, 0x09, 0xae, 0x38, 0xba, 0x9c, 0x57, 0xfc, 0xb5, 0x84, 0x27, 0x5c, 0x17, 0xe2, 0xfc, 0x93,
+	0xc1, 0xfc, 0xcd, 0x84, 0xf3, 0xa4, 0x4b, 0x62, 0x9c, 0xd1, 0x18, 0x33, 0xc6, 0x15, 0x56, 0x94,
+	0x33, 0x69, 0xd1, 0xa0, 0xcd, 0x65, 0xca, 0x65, 0x7c, 0x82, 0x25, 0x89, 0xfb, 0x37, 0x4f, 0x88,
+	0xc2, 0x37, 0xe3, 0x36, 0xa7, 0xcc, 0xe2, 0xbb, 0x2e, 0xae, 0x25, 0x47, 0x5d, 0x19, 0x4e, 0x28,
+	0xd3, 0x64, 0xb6, 0xf7, 0x15, 0xed, 0x2b, 0xff, 0x63, 0x0b, 0x65, 0x5d, 0xc8, 0xb0, 0xc0, 0xa9,
+	0xd5, 0x0b, 0xd7, 0x00, 0xbd, 0x9f, 0xb3, 0x1c, 0xeb, 0x62, 0x93, 0x3c, 0xec, 0x11, 0xa9, 0xc2,
+	0x03, 0xa8, 0x4c, 0x54, 0x65, 0xc6, 0x99, 0x24, 0x68, 0x17, 0x96, 0xcc, 0xe2, 0xaa, 0xb7, 0xe5,
+	0xed, 0xac, 0xec, 0x97, 0x22, 0x4d, 0x6e, 0xba, 0x1a, 0x97, 0x9f, 0x3c, 0xab, 0x2f, 0x34, 0x6d,
+	0x47, 0x78, 0x15, 0xd6, 0x35, 0xc5, 0x1d, 0x76, 0xaf, 0xab, 0x4d, 0x0d, 0xb9, 0xef, 0xc1, 0x95,
+	0x69, 0xc0, 0xd2, 0x1f, 0xc1, 0x32, 0x1d, 0x16, 0xb5, 0xc2, 0x72, 0x23, 0xca, 0x39, 0x7f, 0x7b,
+	0x56, 0xdf, 0x4e, 0xa8, 0xba, 0xdf, 0x3b, 0x89, 0xda, 0x3c, 0x8d, 0xed, 0x04, 0xcc, 0xbf, 0x3d,
+	0xd9, 0x79, 0x10, 0xab, 0xd3, 0x8c, 0xc8, 0xe8, 0x16, 0x69, 0x37, 0xc7, 0x04, 0x61, 0x00, 0x9b,
+	0x5a, 0xe7, 0x80, 0xb1, 0x1e, 0xee, 0x1e, 0x0b, 0xde, 0xa7, 0x32, 0x1f, 0xf4, 0xd0, 0xc7, 0x63,
+	0x78, 0x75, 0x0e, 0x6e, 0xed, 0x7c, 0x02, 0x65, 0xac, 0xb1, 0x56, 0x36, 0x02, 0xff, 0xa5, 0xad,
+	0x55, 0x3c, 0x25, 0x12, 0x1e, 0x42, 0x60, 0xa7, 0xa0, 0x48, 0x22, 0xb4, 0xe3, 0x83, 0x4e, 0x47,
+	0x10, 0x39, 0xf4, 0x87, 0xae, 0x41, 0x89, 0x11, 0x35, 0xe0, 0xe2, 0x41, 0x8b, 0xe1, 0x94, 0x18,
+	0xe5, 0xe6, 0x8a, 0xad, 0xbd, 0x87, 0x53, 0x12, 0x36, 0xa1, 0x3e, 0x97, 0xc4, 0x6e, 0x22, 0x86,
+	0x0a, 0x1d, 0xa3, 0x2d, 0x6c, 0x60, 0x4b, 0x86, 0xe8, 0xcc, 0xc2, 0xd0, 0x87, 0xaa, 0xe6, 0xfc,
+	0x50, 0x10, 0x2c, 0x7b, 0xe2, 0xf4, 0x98, 0xf3, 0xee, 0x70, 0x64, 0xdf, 0x7a, 0x50, 0x2b, 0x00,
+	0xad, 0x54, 0x06, 0x2f, 0x29, 0x5b, 0x6f, 0x65, 0x9c, 0x77, 0xab, 0xde, 0xd6, 0xa5, 0x9d, 0x95,
+	0xfd, 0x5a, 0x64, 0x46, 0x12, 0xe5, 0x57, 0x36, 0xb2, 0x97, 0x35, 0x3a, 0xe4, 0x94, 0x35, 0xde,
+	0xcc, 0xc7, 0xf8, 0xe3, 0xef, 0xf5, 0x9d, 0x7f, 0x30, 0xc6, 0x7c, 0x81, 0x6c, 0x96, 0x94, 0xa3,
+	0x1c, 0xd6, 0xed, 0x11, 0x1e, 0xf6, 0x84, 0x20, 0x4c, 0xbd, 0x4b, 0x99, 0xfa, 0x88, 0x77, 0x7b,
+	0x29, 0x19, 0x1a, 0xfe, 0x6b, 0x11, 0x82, 0x79, 0x1d, 0xd6, 0xf5, 0x23, 0xa8, 0xb4, 0x0d, 0xd8,
+	0xca, 0x2f, 0x73, 0xab, 0xaf, 0xe1, 0xff, 0xc3, 0x7b, 0xb9, 0x3d, 0x6d, 0x02, 0x1d, 0x41, 0xc5,
+	0x11, 0x6d, 0x0d, 0x28, 0xeb, 0xf0, 0x81, 0xac, 0x2e, 0x6a, 0xf1, 0x2b, 0xe6, 0x75, 0x8d, 0xdb,
+	0xef, 0x6a, 0xd8, 0xbe, 0xb3, 0x72, 0x3a, 0x55, 0x97, 0xe8, 0x0b, 0x58, 0x17, 0x24, 0xc5, 0x94,
+	0x51, 0x96, 0x4c, 0x6c, 0xe6, 0xd2, 0x7f, 0xbf, 0x99, 0xca, 0x48, 0x69, 0xec, 0x2f, 0xfc, 0xda,
+	0x83, 0xfa, 0xc4, 0xfd, 0xb8, 0x4b, 0xd5, 0xfd, 0x8e, 0xc0, 0x03, 0xdc, 0x1d, 0x5d, 0x6b, 0x1f,
+	0x5e, 0x14, 0xa4, 0x4d, 0x68, 0x9f, 0x08, 0x7b, 0x0b, 0x47, 0xdf, 0xd1, 0x3b, 0x00, 0xe3, 0x10,
+	0xab, 0x2e, 0xea, 0x8c, 0xd9, 0x9e, 0x70, 0x6d, 0x42, 0x76, 0xe8, 0xfd, 0x18, 0x27, 0xc3, 0xa3,
+	0x6e, 0x3a, 0x2b, 0xc3, 0x9f, 0x3c, 0xd8, 0x9a, 0xef, 0xc3, 0x1e, 0xfc, 0xdb, 0xb0, 0x32, 0x18,
+	0x97, 0xed, 0x81, 0x57, 0xcd, 0xcc, 0x67, 0xd7, 0xd9, 0xa9, 0xbb, 0x4b, 0xd0, 0xed, 0x02, 0xbb,
+	0xaf, 0x5f, 0x68, 0xd7, 0xc8, 0xbb, 0x7e, 0xf7, 0x7f, 0x5e, 0x82, 0x17, 0xb4, 0x5f, 0xf4, 0x01,
+	0x2c, 0x99, 0x34, 0x45, 0xd6, 0xc9, 0x6c, 0x38, 0xfb, 0xb5, 0x02, 0xc4, 0x90, 0x86, 0x6b, 0x5f,
+	0xfd, 0xf2, 0xe7, 0xf7, 0x8b, 0x2f, 0xa3, 0x52, 0xec, 0x24, 0x3d, 0xfa, 0x14, 0x96, 0x47, 0x61,
+	0x8b, 0x36, 0x9c, 0xd5, 0xd3, 0xd9, 0xec, 0x6f, 0x16, 0x83, 0x96, 0xbd, 0xaa, 0xd9, 0x11, 0x5a,
+	0x75, 0xd8, 0x89, 0x22, 0x42, 0xa2, 0xc7, 0xb0, 0x3a, 0x1d, 0xa3, 0x28, 0x74, 0xb8, 0xe6, 0x64,
+	0xb0, 0xff, 0xda, 0x73, 0x7b, 0xac, 0x6c, 0x5d, 0xcb, 0xd6, 0xd0, 0x55, 0x23, 0x3b, 0x93, 0xc9,
+	0xe8, 0x07, 0x0f, 0xd0, 0x6c, 0x04, 0xa2, 0xeb, 0x13, 0x9b, 0x99, 0x13, 0xb3, 0xfe, 0x8d, 0x0b,
+	0xba, 0xac, 0x89, 0x7d, 0x6d, 0xe2, 0x0d, 0xb4, 0x6b, 0x4c, 0x14, 0x64, 0x2a, 0x91, 0xf1, 0x23,
+	0x37, 0xb0, 0x3f, 0x47, 0x5d, 0x28, 0xb9, 0x41, 0x89, 0x02, 0x47, 0xaa, 0x20, 0x5e, 0xfd, 0xfa,
+	0x5c, 0xdc, 0x9a, 0xd8, 0xd0, 0x26, 0xd6, 0x51, 0xc5, 0x98, 0x98, 0x48, 0x5b, 0xf4, 0xa5, 0x07,
+	0xe5, 0x99, 0x98, 0x43, 0xee, 0x84, 0xe7, 0xc5, 0xa4, 0x7f, 0xfd, 0xf9, 0x4d, 0x56, 0xfd, 0x9a,
+	0x56, 0xdf, 0x40, 0x35, 0xa3, 0x5e, 0x90, 0x9a, 0xe8, 0x1b, 0x0f, 0x2a, 0x05, 0x6f, 0x0e, 0xdd,
+	0x28, 0xd8, 0xd9, 0x6c, 0x36, 0xf8, 0xdb, 0x17, 0xb5, 0x59, 0x27, 0xa1, 0x76, 0xb2, 0x89, 0xfc,
+	0xa9, 0x39, 0x38, 0x8f, 0xb3, 0x71, 0xe7, 0xc9, 0x59, 0xe0, 0x3d, 0x3d, 0x0b, 0xbc, 0x3f, 0xce,
+	0x02, 0xef, 0xbb, 0xf3, 0x60, 0xe1, 0xe9, 0x79, 0xb0, 0xf0, 0xeb, 0x79, 0xb0, 0xf0, 0x71, 0xec,
+	0x84, 0xdc, 0x6d, 0xc2, 0x6f, 0x35, 0xf6, 0x8e, 0x68, 0x4a, 0x15, 0xe9, 0xc4, 0xbc, 0x43, 0xd9,
+	0x5e, 0x9b, 0x0b, 0x12, 0x7f, 0x66, 0x99, 0xf3, 0xc4, 0x3b, 0x59, 0xd2, 0x3f, 0x95, 0xde, 0xfa,
+	0x7b, 0x00, 0x28, 0xcc, 0xec, 0x67, 0xe8, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -620,6 +762,9 @@ type QueryClient interface {
 	TreasuryPool(ctx context.Context, in *QueryTreasuryPoolRequest, opts ...grpc.CallOption) (*QueryTreasuryPoolResponse, error)
 	// CurrentMintVolume returns current minted coins volume.
 	CurrentMintVolume(ctx context.Context, in *QueryCurrentMintVolumeRequest, opts ...grpc.CallOption) (*QueryCurrentMintVolumeResponse, error)
+	// TreasuryWithdrawals returns the history of disbursements from the treasury
+	// pool.
+	TreasuryWithdrawals(ctx context.Context, in *QueryTreasuryWithdrawalsRequest, opts ...grpc.CallOption) (*QueryTreasuryWithdrawalsResponse, error)
 }
 
 type queryClient struct {
@@ -684,6 +829,15 @@ func (c *queryClient) CurrentMintVolume(ctx context.Context, in *QueryCurrentMin
 	return out, nil
 }
 
+func (c *queryClient) TreasuryWithdrawals(ctx context.Context, in *QueryTreasuryWithdrawalsRequest, opts ...grpc.CallOption) (*QueryTreasuryWithdrawalsResponse, error) {
+	out := new(QueryTreasuryWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, "/mint.Query/TreasuryWithdrawals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -698,6 +852,9 @@ type QueryServer interface {
 	TreasuryPool(context.Context, *QueryTreasuryPoolRequest) (*QueryTreasuryPoolResponse, error)
 	// CurrentMintVolume returns current minted coins volume.
 	CurrentMintVolume(context.Context, *QueryCurrentMintVolumeRequest) (*QueryCurrentMintVolumeResponse, error)
+	// TreasuryWithdrawals returns the history of disbursements from the treasury
+	// pool.
+	TreasuryWithdrawals(context.Context, *QueryTreasuryWithdrawalsRequest) (*QueryTreasuryWithdrawalsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -722,6 +879,9 @@ func (*UnimplementedQueryServer) TreasuryPool(ctx context.Context, req *QueryTre
 func (*UnimplementedQueryServer) CurrentMintVolume(ctx context.Context, req *QueryCurrentMintVolumeRequest) (*QueryCurrentMintVolumeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentMintVolume not implemented")
 }
+func (*UnimplementedQueryServer) TreasuryWithdrawals(ctx context.Context, req *QueryTreasuryWithdrawalsRequest) (*QueryTreasuryWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasuryWithdrawals not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -835,6 +995,24 @@ func _Query_CurrentMintVolume_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TreasuryWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTreasuryWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TreasuryWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mint.Query/TreasuryWithdrawals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TreasuryWithdrawals(ctx, req.(*QueryTreasuryWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "mint.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -863,6 +1041,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentMintVolume",
 			Handler:    _Query_CurrentMintVolume_Handler,
 		},
+		{
+			MethodName: "TreasuryWithdrawals",
+			Handler:    _Query_TreasuryWithdrawals_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mint/query.proto",
@@ -1199,6 +1381,34 @@ func (m *QueryCurrentMintVolumeResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.RemainingMintVolume) > 0 {
+		for iNdEx := len(m.RemainingMintVolume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingMintVolume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MintVolumeWindows) > 0 {
+		for iNdEx := len(m.MintVolumeWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintVolumeWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.CurrentMintVolume) > 0 {
 		for iNdEx := len(m.CurrentMintVolume) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1216,6 +1426,97 @@ func (m *QueryCurrentMintVolumeResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTreasuryWithdrawalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryWithdrawalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryWithdrawalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryWithdrawalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryWithdrawalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryWithdrawalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Withdrawals) > 0 {
+		for iNdEx := len(m.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1358,6 +1659,54 @@ func (m *QueryCurrentMintVolumeResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.MintVolumeWindows) > 0 {
+		for _, e := range m.MintVolumeWindows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RemainingMintVolume) > 0 {
+		for _, e := range m.RemainingMintVolume {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTreasuryWithdrawalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTreasuryWithdrawalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Withdrawals) > 0 {
+		for _, e := range m.Withdrawals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2179,6 +2528,312 @@ func (m *QueryCurrentMintVolumeResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintVolumeWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintVolumeWindows = append(m.MintVolumeWindows, MintVolumeWindow{})
+			if err := m.MintVolumeWindows[len(m.MintVolumeWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingMintVolume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingMintVolume = append(m.RemainingMintVolume, types.Coin{})
+			if err := m.RemainingMintVolume[len(m.RemainingMintVolume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTreasuryWithdrawalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTreasuryWithdrawalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTreasuryWithdrawalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTreasuryWithdrawalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTreasuryWithdrawalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTreasuryWithdrawalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawals = append(m.Withdrawals, TreasuryWithdrawal{})
+			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Attributes returns the event attributes recording the treasury withdrawal and its vesting schedule if any.
+func (w TreasuryWithdrawal) Attributes() []sdk.Attribute {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(AttributeKeyWithdrawalID, fmt.Sprintf("%d", w.ID)),
+		sdk.NewAttribute(AttributeKeyWithdrawalAmount, w.Amount.String()),
+		sdk.NewAttribute(AttributeKeyReceiver, w.Receiver),
+	}
+	if w.Sender != "" {
+		attrs = append(attrs, sdk.NewAttribute(AttributeKeySender, w.Sender))
+	}
+	if w.Vesting != nil {
+		attrs = append(attrs,
+			sdk.NewAttribute(AttributeKeyVestingType, w.Vesting.Type.String()),
+			sdk.NewAttribute(AttributeKeyVestingCliff, w.Vesting.Cliff.String()),
+			sdk.NewAttribute(AttributeKeyVestingDuration, w.Vesting.Duration.String()),
+			sdk.NewAttribute(AttributeKeyVestingPeriods, fmt.Sprintf("%d", w.Vesting.Periods)),
+			sdk.NewAttribute(AttributeKeyVestingEndTime, w.Vesting.EndTime(w.Time).UTC().Format(sdk.SortableTimeFormat)),
+		)
+	}
+	return attrs
+}
+
+// ValidateGenesis validates the treasury withdrawal for a genesis state
+func (w TreasuryWithdrawal) ValidateGenesis() error {
+	if w.ID == 0 {
+		return fmt.Errorf("treasury withdrawal id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(w.Receiver); err != nil {
+		return fmt.Errorf("invalid receiver of treasury withdrawal %d: %w", w.ID, err)
+	}
+	if !w.Amount.IsValid() {
+		return fmt.Errorf("invalid amount of treasury withdrawal %d, is %v", w.ID, w.Amount)
+	}
+	if w.Vesting != nil {
+		if err := w.Vesting.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid vesting schedule of treasury withdrawal %d: %w", w.ID, err)
+		}
+	}
+
+	return nil
+}
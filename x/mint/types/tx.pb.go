@@ -41,6 +41,8 @@ type MsgWithdrawCoinsToAccFromTreasury struct {
 	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	// Sender is the message signer who submits this report transaction
 	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Vesting is the vesting schedule to disburse the coins with, liquid if unset
+	Vesting *VestingSchedule `protobuf:"bytes,4,opt,name=vesting,proto3" json:"vesting,omitempty"`
 }
 
 func (m *MsgWithdrawCoinsToAccFromTreasury) Reset()         { *m = MsgWithdrawCoinsToAccFromTreasury{} }
@@ -97,6 +99,13 @@ func (m *MsgWithdrawCoinsToAccFromTreasury) GetSender() string {
 	return ""
 }
 
+func (m *MsgWithdrawCoinsToAccFromTreasury) GetVesting() *VestingSchedule {
+	if m != nil {
+		return m.Vesting
+	}
+	return nil
+}
+
 // MsgWithdrawCoinsToAccFromTreasuryResponse
 type MsgWithdrawCoinsToAccFromTreasuryResponse struct {
 }
@@ -240,34 +249,36 @@ func init() {
 func init() { proto.RegisterFile("mint/tx.proto", fileDescriptor_6c467a85e368a1a7) }
 
 var fileDescriptor_6c467a85e368a1a7 = []byte{
-	// 426 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0xcd, 0x35, 0x55, 0x44, 0x0f, 0x10, 0x92, 0x55, 0x55, 0xa9, 0x87, 0x4b, 0xc9, 0x42, 0x10,
-	0x8a, 0x8f, 0x96, 0x0d, 0x26, 0x02, 0x02, 0x21, 0xe1, 0x25, 0xaa, 0x84, 0xc4, 0x66, 0x9f, 0x7f,
-	0x5c, 0x4f, 0x70, 0xf7, 0xb3, 0xee, 0xce, 0xa5, 0xe1, 0x53, 0xc0, 0x37, 0x60, 0xe6, 0x43, 0x30,
-	0x30, 0x75, 0xec, 0xc8, 0x04, 0x28, 0x59, 0xf8, 0x18, 0xc8, 0x67, 0x27, 0x8d, 0x00, 0x29, 0x4c,
-	0x2c, 0xb6, 0x7f, 0x7f, 0xde, 0xf3, 0x7b, 0xef, 0x6c, 0x7a, 0x5d, 0x2b, 0xe3, 0xb9, 0x3f, 0x4b,
-	0x4a, 0x8b, 0x1e, 0xa3, 0xed, 0xba, 0x8c, 0x77, 0x25, 0x4a, 0x0c, 0x0d, 0x5e, 0x3f, 0x35, 0xb3,
-	0x78, 0x20, 0x11, 0xe5, 0x1b, 0xe0, 0xa1, 0xca, 0xab, 0x57, 0xdc, 0x2b, 0x0d, 0xce, 0x67, 0xba,
-	0x6c, 0x17, 0xf6, 0x7f, 0x5f, 0xc8, 0xcc, 0xac, 0x1d, 0xdd, 0x08, 0xaf, 0xa9, 0x2f, 0x6d, 0x83,
-	0x09, 0x74, 0x1a, 0x1d, 0xcf, 0x33, 0x07, 0xfc, 0xf4, 0x30, 0x07, 0x9f, 0x1d, 0x72, 0x81, 0xca,
-	0x34, 0xf3, 0xe1, 0x17, 0x42, 0x6f, 0xa6, 0x4e, 0xbe, 0x50, 0xfe, 0xa4, 0xb0, 0xd9, 0xdb, 0x47,
-	0xa8, 0x8c, 0x3b, 0xc6, 0x87, 0x42, 0x3c, 0xb1, 0xa8, 0x8f, 0x2d, 0x64, 0xae, 0xb2, 0xb3, 0x48,
-	0xd0, 0x5e, 0xa6, 0xb1, 0x32, 0xbe, 0x4f, 0x0e, 0xba, 0xa3, 0xab, 0x47, 0xfb, 0x49, 0x43, 0x9b,
-	0xd4, 0xb4, 0x49, 0x4b, 0x9b, 0xd4, 0xe0, 0xc9, 0xdd, 0xf3, 0x6f, 0x83, 0xce, 0xa7, 0xef, 0x83,
-	0x91, 0x54, 0xfe, 0xa4, 0xca, 0x13, 0x81, 0x9a, 0xb7, 0x1a, 0x9a, 0xdb, 0xd8, 0x15, 0xaf, 0xb9,
-	0x9f, 0x95, 0xe0, 0x02, 0xc0, 0x4d, 0x5b, 0xea, 0x28, 0xa6, 0x57, 0x2c, 0x08, 0x50, 0xa7, 0x60,
-	0xfb, 0x5b, 0x07, 0x64, 0xb4, 0x33, 0x5d, 0xd5, 0xd1, 0x1e, 0xed, 0x39, 0x30, 0x05, 0xd8, 0x7e,
-	0x37, 0x4c, 0xda, 0xea, 0xfe, 0xf6, 0xcf, 0x8f, 0x03, 0x32, 0xbc, 0x43, 0x6f, 0x6f, 0xf4, 0x30,
-	0x05, 0x57, 0xa2, 0x71, 0x30, 0xfc, 0x40, 0xe8, 0xb5, 0xd4, 0xc9, 0x54, 0x19, 0x1f, 0x36, 0xff,
-	0x8f, 0xb9, 0x4b, 0x03, 0x5b, 0x7f, 0x31, 0xb0, 0x47, 0x77, 0xd7, 0x25, 0x2d, 0xb5, 0x1e, 0x7d,
-	0x26, 0xb4, 0x9b, 0x3a, 0x19, 0xbd, 0xa3, 0x6c, 0xc3, 0x09, 0xdd, 0x4a, 0xc2, 0xa1, 0x6f, 0x8c,
-	0x21, 0xe6, 0xff, 0xb8, 0xb8, 0xd4, 0x10, 0x3d, 0xa0, 0x3b, 0x97, 0x59, 0x45, 0x2b, 0xf4, 0xaa,
-	0x17, 0xc7, 0x7f, 0xf6, 0x96, 0xe0, 0xc9, 0xb3, 0xf3, 0x39, 0x23, 0x17, 0x73, 0x46, 0x7e, 0xcc,
-	0x19, 0x79, 0xbf, 0x60, 0x9d, 0x8b, 0x05, 0xeb, 0x7c, 0x5d, 0xb0, 0xce, 0x4b, 0xbe, 0x16, 0xe1,
-	0x53, 0xc0, 0xc7, 0x93, 0xf1, 0x73, 0xa5, 0x95, 0x87, 0x82, 0x63, 0xa1, 0xcc, 0x58, 0xa0, 0x05,
-	0x7e, 0xc6, 0x9b, 0xbf, 0xa6, 0xce, 0x33, 0xef, 0x85, 0x0f, 0xf6, 0xde, 0xaf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x14, 0x94, 0xcb, 0xce, 0x4a, 0x03, 0x00, 0x00,
+	// 452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0x3d, 0x8f, 0xd3, 0x40,
+	0x10, 0xcd, 0x5e, 0xa2, 0xc0, 0xed, 0x81, 0x90, 0xac, 0xe3, 0x94, 0x73, 0xe1, 0x84, 0x34, 0x04,
+	0xa1, 0x78, 0xb9, 0xd0, 0x41, 0x45, 0x40, 0x20, 0x24, 0xd2, 0x84, 0x13, 0x48, 0x74, 0xf6, 0x7a,
+	0xd8, 0xac, 0x38, 0xef, 0x44, 0x3b, 0xeb, 0x70, 0xe1, 0x57, 0xc0, 0x3f, 0xa0, 0xe6, 0x47, 0x50,
+	0x5f, 0x79, 0x25, 0x15, 0xa0, 0xa4, 0xa1, 0xe7, 0x0f, 0x20, 0x7f, 0x24, 0x17, 0x41, 0x11, 0xaa,
+	0x6b, 0x6c, 0xcf, 0x9b, 0x37, 0xe3, 0xf7, 0x9e, 0xbd, 0xfc, 0x7a, 0xaa, 0x8d, 0x13, 0xee, 0x34,
+	0x9c, 0x5a, 0x74, 0xe8, 0x35, 0xf2, 0xd2, 0xdf, 0x57, 0xa8, 0xb0, 0x00, 0x44, 0xfe, 0x54, 0xf6,
+	0xfc, 0xb6, 0x42, 0x54, 0x27, 0x20, 0x8a, 0x2a, 0xce, 0xde, 0x0a, 0xa7, 0x53, 0x20, 0x17, 0xa5,
+	0xd3, 0x8a, 0x70, 0xf8, 0x37, 0x21, 0x32, 0xf3, 0xaa, 0x75, 0xa3, 0x78, 0x4d, 0x7e, 0xa9, 0x80,
+	0x40, 0x22, 0xa5, 0x48, 0x22, 0x8e, 0x08, 0xc4, 0xec, 0x28, 0x06, 0x17, 0x1d, 0x09, 0x89, 0xda,
+	0x94, 0xfd, 0xee, 0x6f, 0xc6, 0x6f, 0x8d, 0x48, 0xbd, 0xd6, 0x6e, 0x92, 0xd8, 0xe8, 0xfd, 0x63,
+	0xd4, 0x86, 0x8e, 0xf1, 0x91, 0x94, 0x4f, 0x2d, 0xa6, 0xc7, 0x16, 0x22, 0xca, 0xec, 0xdc, 0x93,
+	0xbc, 0x19, 0xa5, 0x98, 0x19, 0xd7, 0x62, 0x9d, 0x7a, 0x6f, 0x6f, 0x70, 0x18, 0x96, 0x6b, 0xc3,
+	0x7c, 0x6d, 0x58, 0xad, 0x0d, 0xf3, 0xe1, 0xe1, 0xbd, 0xb3, 0xef, 0xed, 0xda, 0x97, 0x1f, 0xed,
+	0x9e, 0xd2, 0x6e, 0x92, 0xc5, 0xa1, 0xc4, 0x54, 0x54, 0x1a, 0xca, 0x5b, 0x9f, 0x92, 0x77, 0xc2,
+	0xcd, 0xa7, 0x40, 0xc5, 0x00, 0x8d, 0xab, 0xd5, 0x9e, 0xcf, 0xaf, 0x5a, 0x90, 0xa0, 0x67, 0x60,
+	0x5b, 0x3b, 0x1d, 0xd6, 0xdb, 0x1d, 0xaf, 0x6b, 0xef, 0x80, 0x37, 0x09, 0x4c, 0x02, 0xb6, 0x55,
+	0x2f, 0x3a, 0x55, 0xe5, 0x09, 0x7e, 0x65, 0x06, 0xe4, 0xb4, 0x51, 0xad, 0x46, 0x87, 0xf5, 0xf6,
+	0x06, 0x37, 0xc3, 0xc2, 0xfc, 0xab, 0x12, 0x7c, 0x29, 0x27, 0x90, 0x64, 0x27, 0x30, 0x5e, 0xb1,
+	0x1e, 0x34, 0x7e, 0x7d, 0x6e, 0xb3, 0xee, 0x5d, 0x7e, 0x67, 0xab, 0xe9, 0x31, 0xd0, 0x14, 0x0d,
+	0x41, 0xf7, 0x13, 0xe3, 0xd7, 0x46, 0xa4, 0x46, 0xda, 0xb8, 0x82, 0x79, 0x39, 0x69, 0x5c, 0x38,
+	0xde, 0xd9, 0x74, 0x5c, 0x19, 0x38, 0xe0, 0xfb, 0x9b, 0x92, 0x56, 0x5a, 0x07, 0x5f, 0x19, 0xaf,
+	0x8f, 0x48, 0x79, 0x1f, 0x78, 0xb0, 0xe5, 0x93, 0xde, 0x2e, 0x83, 0xda, 0x1a, 0x83, 0x2f, 0xfe,
+	0x93, 0xb8, 0xd2, 0xe0, 0x3d, 0xe4, 0xbb, 0x17, 0x59, 0x79, 0xeb, 0xe9, 0x35, 0xe6, 0xfb, 0xff,
+	0x62, 0xab, 0xe1, 0xe1, 0xf3, 0xb3, 0x45, 0xc0, 0xce, 0x17, 0x01, 0xfb, 0xb9, 0x08, 0xd8, 0xc7,
+	0x65, 0x50, 0x3b, 0x5f, 0x06, 0xb5, 0x6f, 0xcb, 0xa0, 0xf6, 0x46, 0x6c, 0x44, 0xf8, 0x0c, 0xf0,
+	0xc9, 0xb0, 0xff, 0x42, 0xa7, 0xda, 0x41, 0x22, 0x30, 0xd1, 0xa6, 0x2f, 0xd1, 0x82, 0x38, 0x15,
+	0xe5, 0x31, 0xcb, 0xf3, 0x8c, 0x9b, 0xc5, 0x1f, 0x7e, 0xff, 0xcf, 0x00, 0xdd, 0xa3, 0xa5, 0x40,
+	0x7b, 0x03, 0x00, 0x00,
 }
 
 func (this *MsgWithdrawCoinsToAccFromTreasury) Equal(that interface{}) bool {
@@ -303,6 +314,9 @@ func (this *MsgWithdrawCoinsToAccFromTreasury) Equal(that interface{}) bool {
 	if this.Sender != that1.Sender {
 		return false
 	}
+	if !this.Vesting.Equal(that1.Vesting) {
+		return false
+	}
 	return true
 }
 func (this *MsgMintCoins) Equal(that interface{}) bool {
@@ -480,6 +494,18 @@ func (m *MsgWithdrawCoinsToAccFromTreasury) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	if m.Vesting != nil {
+		{
+			size, err := m.Vesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -632,6 +658,10 @@ func (m *MsgWithdrawCoinsToAccFromTreasury) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Vesting != nil {
+		l = m.Vesting.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -805,6 +835,42 @@ func (m *MsgWithdrawCoinsToAccFromTreasury) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vesting == nil {
+				m.Vesting = &VestingSchedule{}
+			}
+			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,183 @@
+package types
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// MaxVestingPeriods is the maximum number of periods of a periodic vesting schedule. Proposals are executed without a
+// gas limit, so the number of periods written to the account has to be bounded.
+const MaxVestingPeriods = 1000
+
+// NewVestingSchedule returns a new VestingSchedule object
+func NewVestingSchedule(vestingType VestingType, cliff, duration time.Duration, periods uint32) *VestingSchedule {
+	return &VestingSchedule{
+		Type:     vestingType,
+		Cliff:    cliff,
+		Duration: duration,
+		Periods:  periods,
+	}
+}
+
+// ValidateBasic validates the vesting schedule
+func (s VestingSchedule) ValidateBasic() error {
+	switch s.Type {
+	case VestingTypeContinuous:
+		if s.Duration < time.Second {
+			return sdkerrors.Wrapf(ErrInvalidVestingSchedule, "duration must be at least a second, is %s", s.Duration)
+		}
+	case VestingTypePeriodic:
+		if s.Periods == 0 {
+			return sdkerrors.Wrap(ErrInvalidVestingSchedule, "periodic vesting needs at least one period")
+		}
+		if s.Periods > MaxVestingPeriods {
+			return sdkerrors.Wrapf(
+				ErrInvalidVestingSchedule,
+				"periodic vesting has at most %d periods, has %d",
+				MaxVestingPeriods,
+				s.Periods,
+			)
+		}
+		if s.periodLength() == 0 {
+			return sdkerrors.Wrapf(
+				ErrInvalidVestingSchedule,
+				"duration %s is too short for %d periods of at least a second",
+				s.Duration,
+				s.Periods,
+			)
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidVestingSchedule, "vesting type: %s", s.Type)
+	}
+	if s.Cliff < 0 {
+		return sdkerrors.Wrapf(ErrInvalidVestingSchedule, "cliff cannot be negative, is %s", s.Cliff)
+	}
+
+	return nil
+}
+
+// EndTime returns the time the amount disbursed at the given time is fully vested.
+func (s VestingSchedule) EndTime(startTime time.Time) time.Time {
+	return startTime.Add(s.Cliff).Add(s.Duration)
+}
+
+// NewVestingAccount returns a vesting account of the given base account, vesting the amount on the schedule from the
+// given start time.
+func (s VestingSchedule) NewVestingAccount(
+	baseAcc *authtypes.BaseAccount,
+	amount sdk.Coins,
+	startTime time.Time,
+) vestingexported.VestingAccount {
+	if s.Type == VestingTypePeriodic {
+		return vestingtypes.NewPeriodicVestingAccount(baseAcc, amount, startTime.Unix(), s.VestingPeriods(amount))
+	}
+
+	vestingStart := startTime.Add(s.Cliff)
+	return vestingtypes.NewContinuousVestingAccount(baseAcc, amount, vestingStart.Unix(), s.EndTime(startTime).Unix())
+}
+
+// AddToAccount returns the given existing account vesting the amount on the schedule from the given start time on
+// top of what it already vests. A plain account becomes a vesting account, and a periodic grant is merged into the
+// periods of a periodic vesting account. Other accounts cannot receive vesting coins.
+func (s VestingSchedule) AddToAccount(
+	account authtypes.AccountI,
+	amount sdk.Coins,
+	startTime time.Time,
+) (vestingexported.VestingAccount, error) {
+	switch account := account.(type) {
+	case *authtypes.BaseAccount:
+		return s.NewVestingAccount(account, amount, startTime), nil
+	case *vestingtypes.PeriodicVestingAccount:
+		if s.Type != VestingTypePeriodic {
+			return nil, sdkerrors.Wrapf(
+				ErrInvalidVestingAccount, "%s vesting cannot be added to a periodic vesting account", s.Type,
+			)
+		}
+		extended := *account
+		extended.StartTime, extended.EndTime, extended.VestingPeriods = mergePeriods(
+			account.StartTime, account.VestingPeriods, startTime.Unix(), s.VestingPeriods(amount),
+		)
+		extended.OriginalVesting = account.OriginalVesting.Add(amount...)
+		return &extended, nil
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidVestingAccount, "account type: %T", account)
+	}
+}
+
+// mergePeriods merges two vesting schedules starting at the given unix times into a single one, returning its start
+// and end times along with its periods.
+func mergePeriods(
+	startP int64,
+	p vestingtypes.Periods,
+	startQ int64,
+	q vestingtypes.Periods,
+) (int64, int64, vestingtypes.Periods) {
+	type vest struct {
+		time   int64
+		amount sdk.Coins
+	}
+	var vests []vest
+	for _, schedule := range []struct {
+		start   int64
+		periods vestingtypes.Periods
+	}{{startP, p}, {startQ, q}} {
+		vestTime := schedule.start
+		for _, period := range schedule.periods {
+			vestTime += period.Length
+			vests = append(vests, vest{time: vestTime, amount: period.Amount})
+		}
+	}
+	sort.SliceStable(vests, func(i, j int) bool { return vests[i].time < vests[j].time })
+
+	start := startP
+	if startQ < start {
+		start = startQ
+	}
+	end := start
+	merged := make(vestingtypes.Periods, 0, len(vests))
+	for _, v := range vests {
+		if len(merged) > 0 && v.time == end {
+			merged[len(merged)-1].Amount = merged[len(merged)-1].Amount.Add(v.amount...)
+			continue
+		}
+		merged = append(merged, vestingtypes.Period{Length: v.time - end, Amount: v.amount})
+		end = v.time
+	}
+	return start, end, merged
+}
+
+// VestingPeriods splits the amount into the equal periods of a periodic vesting schedule. The first period also lasts
+// the cliff and the last one vests whatever the division leaves over.
+func (s VestingSchedule) VestingPeriods(amount sdk.Coins) vestingtypes.Periods {
+	duration := int64(s.Duration.Seconds())
+	length := s.periodLength()
+
+	periods := make(vestingtypes.Periods, s.Periods)
+	remaining := amount
+	for i := range periods {
+		periodAmount := remaining
+		if i < len(periods)-1 {
+			periodAmount = sdk.NewCoins()
+			for _, coin := range amount {
+				periodAmount = periodAmount.Add(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(int64(s.Periods))))
+			}
+		}
+		remaining = remaining.Sub(periodAmount)
+		periods[i] = vestingtypes.Period{Length: length, Amount: periodAmount}
+	}
+	periods[0].Length += int64(s.Cliff.Seconds())
+	periods[len(periods)-1].Length += duration - length*int64(s.Periods)
+
+	return periods
+}
+
+// periodLength returns the length in seconds of the periods of a periodic vesting schedule, the last one aside.
+func (s VestingSchedule) periodLength() int64 {
+	return int64(s.Duration.Seconds()) / int64(s.Periods)
+}
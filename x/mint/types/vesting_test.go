@@ -0,0 +1,102 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestVestingScheduleValidateBasic(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule *VestingSchedule
+		expPass  bool
+	}{
+		{"continuous", NewVestingSchedule(VestingTypeContinuous, time.Hour, time.Hour, 0), true},
+		{"periodic", NewVestingSchedule(VestingTypePeriodic, 0, time.Hour, 4), true},
+		{"unspecified type", NewVestingSchedule(VestingTypeUnspecified, 0, time.Hour, 0), false},
+		{"no duration", NewVestingSchedule(VestingTypeContinuous, time.Hour, 0, 0), false},
+		{"negative cliff", NewVestingSchedule(VestingTypeContinuous, -time.Hour, time.Hour, 0), false},
+		{"no periods", NewVestingSchedule(VestingTypePeriodic, 0, time.Hour, 0), false},
+		{"periods too short", NewVestingSchedule(VestingTypePeriodic, 0, time.Second, 2), false},
+		{"periods rounding to zero", NewVestingSchedule(VestingTypePeriodic, 0, 1500*time.Millisecond, 2), false},
+		{"most periods", NewVestingSchedule(VestingTypePeriodic, 0, MaxVestingPeriods*time.Second, MaxVestingPeriods), true},
+		{"too many periods", NewVestingSchedule(VestingTypePeriodic, 0, 24*time.Hour, MaxVestingPeriods+1), false},
+	}
+
+	for _, tc := range tests {
+		err := tc.schedule.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestVestingScheduleNewVestingAccount(t *testing.T) {
+	start := time.Unix(1600000000, 0)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("loki", 1000))
+	baseAcc := authtypes.NewBaseAccountWithAddress(sdk.AccAddress("receiver____________"))
+
+	continuous := NewVestingSchedule(VestingTypeContinuous, time.Hour, 2*time.Hour, 0).NewVestingAccount(baseAcc, amount, start)
+	require.IsType(t, &vestingtypes.ContinuousVestingAccount{}, continuous)
+	require.Equal(t, start.Add(time.Hour).Unix(), continuous.GetStartTime())
+	require.Equal(t, start.Add(3*time.Hour).Unix(), continuous.GetEndTime())
+	require.Equal(t, amount, continuous.GetVestingCoins(start.Add(time.Hour)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("loki", 500)), continuous.GetVestingCoins(start.Add(2*time.Hour)))
+
+	periodic := NewVestingSchedule(VestingTypePeriodic, time.Hour, 100*time.Second, 3).NewVestingAccount(baseAcc, amount, start)
+	require.IsType(t, &vestingtypes.PeriodicVestingAccount{}, periodic)
+	require.Equal(t, start.Unix(), periodic.GetStartTime())
+	require.Equal(t, start.Add(time.Hour+100*time.Second).Unix(), periodic.GetEndTime())
+	require.Equal(t, []vestingtypes.Period{
+		{Length: 3600 + 33, Amount: sdk.NewCoins(sdk.NewInt64Coin("loki", 333))},
+		{Length: 33, Amount: sdk.NewCoins(sdk.NewInt64Coin("loki", 333))},
+		{Length: 34, Amount: sdk.NewCoins(sdk.NewInt64Coin("loki", 334))},
+	}, periodic.(*vestingtypes.PeriodicVestingAccount).VestingPeriods)
+	require.Equal(t, amount, periodic.GetVestingCoins(start.Add(time.Hour)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("loki", 667)), periodic.GetVestingCoins(start.Add(time.Hour+33*time.Second)))
+}
+
+func TestVestingScheduleAddToAccount(t *testing.T) {
+	start := time.Unix(1600000000, 0)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("loki", 1000))
+	baseAcc := authtypes.NewBaseAccountWithAddress(sdk.AccAddress("receiver____________"))
+	baseAcc.Sequence = 3
+	continuousSchedule := NewVestingSchedule(VestingTypeContinuous, 0, time.Hour, 0)
+	periodicSchedule := NewVestingSchedule(VestingTypePeriodic, 0, 100*time.Second, 2)
+
+	// An account funded before the disbursement keeps its state and becomes a vesting account.
+	continuous, err := continuousSchedule.AddToAccount(baseAcc, amount, start)
+	require.NoError(t, err)
+	require.IsType(t, &vestingtypes.ContinuousVestingAccount{}, continuous)
+	require.Equal(t, uint64(3), continuous.GetSequence())
+	require.Equal(t, amount, continuous.GetOriginalVesting())
+
+	periodic, err := periodicSchedule.AddToAccount(baseAcc, amount, start)
+	require.NoError(t, err)
+	periodic, err = periodicSchedule.AddToAccount(periodic, amount, start.Add(70*time.Second))
+	require.NoError(t, err)
+	require.IsType(t, &vestingtypes.PeriodicVestingAccount{}, periodic)
+	require.Equal(t, start.Unix(), periodic.GetStartTime())
+	require.Equal(t, start.Add(170*time.Second).Unix(), periodic.GetEndTime())
+	require.Equal(t, amount.Add(amount...), periodic.GetOriginalVesting())
+	require.Equal(t, []vestingtypes.Period{
+		{Length: 50, Amount: sdk.NewCoins(sdk.NewInt64Coin("loki", 500))},
+		{Length: 50, Amount: sdk.NewCoins(sdk.NewInt64Coin("loki", 500))},
+		{Length: 20, Amount: sdk.NewCoins(sdk.NewInt64Coin("loki", 500))},
+		{Length: 50, Amount: sdk.NewCoins(sdk.NewInt64Coin("loki", 500))},
+	}, periodic.(*vestingtypes.PeriodicVestingAccount).VestingPeriods)
+	require.Equal(t, amount, periodic.GetVestingCoins(start.Add(100*time.Second)))
+
+	_, err = continuousSchedule.AddToAccount(periodic, amount, start)
+	require.ErrorIs(t, err, ErrInvalidVestingAccount)
+	_, err = periodicSchedule.AddToAccount(continuous, amount, start)
+	require.ErrorIs(t, err, ErrInvalidVestingAccount)
+}
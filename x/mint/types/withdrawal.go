@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewAccountWithdrawal returns a new AccountWithdrawal object of the account with the given withdrawal period start
+// and withdrawn amount.
+func NewAccountWithdrawal(addr sdk.AccAddress, startHeight int64, amount sdk.Coins) AccountWithdrawal {
+	return AccountWithdrawal{
+		Address:     addr.String(),
+		StartHeight: startHeight,
+		Amount:      amount,
+	}
+}
+
+// At returns the withdrawal as of the given height, starting a new withdrawal period there if the account has not
+// withdrawn anything yet or its previous period has passed.
+func (w AccountWithdrawal) At(height int64, period uint64) AccountWithdrawal {
+	if w.Amount.Empty() || height-w.StartHeight >= int64(period) {
+		return AccountWithdrawal{Address: w.Address, StartHeight: height, Amount: sdk.NewCoins()}
+	}
+	return w
+}
+
+// ValidateGenesis validates the account withdrawal for a genesis state
+func (w AccountWithdrawal) ValidateGenesis() error {
+	if _, err := sdk.AccAddressFromBech32(w.Address); err != nil {
+		return fmt.Errorf("invalid account withdrawal address %s: %w", w.Address, err)
+	}
+	if !w.Amount.IsValid() {
+		return fmt.Errorf("invalid amount in account withdrawal of %s, is %v", w.Address, w.Amount)
+	}
+
+	return nil
+}
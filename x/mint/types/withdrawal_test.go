@@ -0,0 +1,20 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAccountWithdrawalAt(t *testing.T) {
+	addr := sdk.AccAddress("account_____________")
+
+	withdrawal := NewAccountWithdrawal(addr, 0, sdk.NewCoins()).At(10, 100)
+	require.Equal(t, NewAccountWithdrawal(addr, 10, sdk.NewCoins()), withdrawal)
+
+	withdrawal.Amount = withdrawal.Amount.Add(sdk.NewInt64Coin("loki", 50))
+	require.Equal(t, withdrawal, withdrawal.At(109, 100))
+	require.Equal(t, NewAccountWithdrawal(addr, 110, sdk.NewCoins()), withdrawal.At(110, 100))
+}
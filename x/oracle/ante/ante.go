@@ -24,39 +24,30 @@ func init() {
 	}
 }
 
-func checkValidReportMsg(ctx sdk.Context, oracleKeeper oraclekeeper.Keeper, rep *types.MsgReportData) bool {
-	validator, _ := sdk.ValAddressFromBech32(rep.Validator)
-	reporter, _ := sdk.AccAddressFromBech32(rep.Reporter)
-	if !oracleKeeper.IsReporter(ctx, validator, reporter) {
-		return false
-	}
-	if rep.RequestID <= oracleKeeper.GetRequestLastExpired(ctx) {
-		return false
-	}
+// keeperReportState implements types.ReportState on top of the oracle keeper.
+type keeperReportState struct {
+	ctx          sdk.Context
+	oracleKeeper oraclekeeper.Keeper
+}
 
-	req, err := oracleKeeper.GetRequest(ctx, rep.RequestID)
-	if err != nil {
-		return false
-	}
+func (s keeperReportState) IsReporter(validator sdk.ValAddress, reporter sdk.AccAddress) bool {
+	return s.oracleKeeper.IsReporter(s.ctx, validator, reporter)
+}
 
-	reqVals := make([]sdk.ValAddress, len(req.RequestedValidators))
-	for idx, reqVal := range req.RequestedValidators {
-		val, _ := sdk.ValAddressFromBech32(reqVal)
-		reqVals[idx] = val
-	}
+func (s keeperReportState) GetRequestLastExpired() types.RequestID {
+	return s.oracleKeeper.GetRequestLastExpired(s.ctx)
+}
 
-	if !oraclekeeper.ContainsVal(reqVals, validator) {
-		return false
-	}
-	if len(rep.RawReports) != len(req.RawRequests) {
-		return false
-	}
-	for _, report := range rep.RawReports {
-		if !oraclekeeper.ContainsEID(req.RawRequests, report.ExternalID) {
-			return false
-		}
-	}
-	return true
+func (s keeperReportState) GetRequest(id types.RequestID) (types.Request, error) {
+	return s.oracleKeeper.GetRequest(s.ctx, id)
+}
+
+func (s keeperReportState) GetMaxDataSize() uint64 {
+	return s.oracleKeeper.GetParamUint64(s.ctx, types.KeyMaxDataSize)
+}
+
+func checkValidReportMsg(ctx sdk.Context, oracleKeeper oraclekeeper.Keeper, rep *types.MsgReportData) bool {
+	return types.CheckReport(keeperReportState{ctx: ctx, oracleKeeper: oracleKeeper}, rep) == nil
 }
 
 // NewFeelessReportsAnteHandler returns a new ante handler that waives minimum gas price
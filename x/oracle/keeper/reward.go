@@ -1,6 +1,8 @@
 package oraclekeeper
 
 import (
+	"fmt"
+
 	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -68,6 +70,12 @@ func (k Keeper) AllocateRewardsToDataProviders(ctx sdk.Context, rid oracletypes.
 
 		// we are sure to have paid the reward to the provider, we can remove him now
 		k.ClearDataProviderAccumulatedReward(ctx, ownerAccAddr)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			oracletypes.EventTypeDataProviderReward,
+			sdk.NewAttribute(oracletypes.AttributeKeyID, fmt.Sprintf("%d", rid)),
+			sdk.NewAttribute(oracletypes.AttributeKeyDataProvider, ownerAccAddr.String()),
+			sdk.NewAttribute(oracletypes.AttributeKeyAmount, reward.String()),
+		))
 	}
 
 	k.distrKeeper.SetFeePool(ctx, feePool)
@@ -81,6 +81,19 @@ func (k Keeper) SetValidatorStatus(ctx sdk.Context, val sdk.ValAddress, status t
 	ctx.KVStore(k.storeKey).Set(types.ValidatorStatusStoreKey(val), k.cdc.MustMarshal(&status))
 }
 
+// IterateValidatorStatuses iterates over the statuses of the validators that were ever activated.
+func (k Keeper) IterateValidatorStatuses(ctx sdk.Context, cb func(val sdk.ValAddress, status types.ValidatorStatus) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ValidatorStatusKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var status types.ValidatorStatus
+		k.cdc.MustUnmarshal(iterator.Value(), &status)
+		if cb(sdk.ValAddress(iterator.Key()[len(types.ValidatorStatusKeyPrefix):]), status) {
+			break
+		}
+	}
+}
+
 // Activate changes the given validator's status to active. Returns error if the validator is
 // already active or was deactivated recently, as specified by InactivePenaltyDuration parameter.
 func (k Keeper) Activate(ctx sdk.Context, val sdk.ValAddress) error {
@@ -14,6 +14,7 @@ const (
 	EventTypeAddReporter        = "add_reporter"
 	EventTypeRemoveReporter     = "remove_reporter"
 	EventTypeResolve            = "resolve"
+	EventTypeDataProviderReward = "data_provider_reward"
 
 	AttributeKeyID             = "id"
 	AttributeKeyDataSourceID   = "data_source_id"
@@ -30,4 +31,6 @@ const (
 	AttributeKeyGasUsed        = "gas_used"
 	AttributeKeyResult         = "result"
 	AttributeKeyReason         = "reason"
+	AttributeKeyDataProvider   = "data_provider"
+	AttributeKeyAmount         = "amount"
 )
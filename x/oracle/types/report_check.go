@@ -0,0 +1,79 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ReportState provides the on-chain state needed to check whether a report can be accepted. It is implemented
+// on top of the keeper by the ante handler and on top of node queries by yoda.
+type ReportState interface {
+	IsReporter(validator sdk.ValAddress, reporter sdk.AccAddress) bool
+	GetRequestLastExpired() RequestID
+	GetRequest(id RequestID) (Request, error)
+	GetMaxDataSize() uint64
+}
+
+// CheckReportData returns an error if any raw report carries more data than maxDataSize allows.
+func CheckReportData(rep *MsgReportData, maxDataSize uint64) error {
+	for _, rawReport := range rep.RawReports {
+		if uint64(len(rawReport.Data)) > maxDataSize {
+			return ErrTooLargeRawReportData
+		}
+	}
+	return nil
+}
+
+// CheckReport returns an error if the report message can never succeed against the given state. The checks
+// mirror the ones performed by the report message handler that do not depend on other reports.
+func CheckReport(state ReportState, rep *MsgReportData) error {
+	validator, err := sdk.ValAddressFromBech32(rep.Validator)
+	if err != nil {
+		return err
+	}
+	reporter, err := sdk.AccAddressFromBech32(rep.Reporter)
+	if err != nil {
+		return err
+	}
+	if !state.IsReporter(validator, reporter) {
+		return ErrReporterNotAuthorized
+	}
+	if rep.RequestID <= state.GetRequestLastExpired() {
+		return ErrRequestAlreadyExpired
+	}
+	if err := CheckReportData(rep, state.GetMaxDataSize()); err != nil {
+		return err
+	}
+
+	req, err := state.GetRequest(rep.RequestID)
+	if err != nil {
+		return ErrRequestNotFound
+	}
+
+	isRequested := false
+	for _, reqVal := range req.RequestedValidators {
+		val, _ := sdk.ValAddressFromBech32(reqVal)
+		if val.Equals(validator) {
+			isRequested = true
+			break
+		}
+	}
+	if !isRequested {
+		return ErrValidatorNotRequested
+	}
+	if len(rep.RawReports) != len(req.RawRequests) {
+		return ErrInvalidReportSize
+	}
+	for _, rawReport := range rep.RawReports {
+		found := false
+		for _, rawRequest := range req.RawRequests {
+			if rawRequest.ExternalID == rawReport.ExternalID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return ErrRawRequestNotFound
+		}
+	}
+	return nil
+}
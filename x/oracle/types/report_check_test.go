@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockReportState struct {
+	reporters   map[string]bool
+	lastExpired RequestID
+	requests    map[RequestID]Request
+	maxDataSize uint64
+}
+
+func (s mockReportState) IsReporter(validator sdk.ValAddress, reporter sdk.AccAddress) bool {
+	return s.reporters[reporter.String()]
+}
+
+func (s mockReportState) GetRequestLastExpired() RequestID {
+	return s.lastExpired
+}
+
+func (s mockReportState) GetRequest(id RequestID) (Request, error) {
+	req, ok := s.requests[id]
+	if !ok {
+		return Request{}, ErrRequestNotFound
+	}
+	return req, nil
+}
+
+func (s mockReportState) GetMaxDataSize() uint64 {
+	return s.maxDataSize
+}
+
+func TestCheckReport(t *testing.T) {
+	state := mockReportState{
+		reporters:   map[string]bool{GoodTestAddr.String(): true},
+		lastExpired: 5,
+		requests: map[RequestID]Request{
+			6: {
+				RequestedValidators: []string{GoodTestValAddr.String()},
+				RawRequests:         []RawRequest{{ExternalID: 1}, {ExternalID: 2}},
+			},
+			7: {
+				RequestedValidators: []string{GoodTestValAddr2.String()},
+				RawRequests:         []RawRequest{{ExternalID: 1}},
+			},
+		},
+		maxDataSize: 4,
+	}
+	goodReports := []RawReport{NewRawReport(1, 0, []byte("beeb")), NewRawReport(2, 0, nil)}
+
+	require.NoError(t, CheckReport(state, NewMsgReportData(6, goodReports, GoodTestValAddr, GoodTestAddr)))
+	require.Equal(t, ErrReporterNotAuthorized,
+		CheckReport(state, NewMsgReportData(6, goodReports, GoodTestValAddr, GoodTestAddr2)))
+	require.Equal(t, ErrRequestAlreadyExpired,
+		CheckReport(state, NewMsgReportData(5, goodReports, GoodTestValAddr, GoodTestAddr)))
+	require.Equal(t, ErrRequestNotFound,
+		CheckReport(state, NewMsgReportData(8, goodReports, GoodTestValAddr, GoodTestAddr)))
+	require.Equal(t, ErrValidatorNotRequested,
+		CheckReport(state, NewMsgReportData(7, goodReports[:1], GoodTestValAddr, GoodTestAddr)))
+	require.Equal(t, ErrInvalidReportSize,
+		CheckReport(state, NewMsgReportData(6, goodReports[:1], GoodTestValAddr, GoodTestAddr)))
+	require.Equal(t, ErrRawRequestNotFound, CheckReport(state, NewMsgReportData(
+		6, []RawReport{NewRawReport(1, 0, nil), NewRawReport(3, 0, nil)}, GoodTestValAddr, GoodTestAddr,
+	)))
+	require.Equal(t, ErrTooLargeRawReportData, CheckReport(state, NewMsgReportData(
+		6, []RawReport{NewRawReport(1, 0, []byte("beebs")), NewRawReport(2, 0, nil)}, GoodTestValAddr, GoodTestAddr,
+	)))
+}
@@ -0,0 +1,606 @@
+package cli
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	telemetrytypes "github.com/GeoDB-Limited/odin-core/x/telemetry/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	exportFormatCSV    = "csv"
+	exportFormatJSON   = "json"
+	exportFormatNDJSON = "ndjson"
+
+	// exportPageSize is the number of items requested at once from the paginated series.
+	exportPageSize = 100
+)
+
+// exportParams is the parameters of an export shared by all the series.
+type exportParams struct {
+	startDate   *time.Time
+	endDate     *time.Time
+	granularity telemetrytypes.Granularity
+	desc        bool
+	denom       string
+	validator   string
+}
+
+// emitFunc writes a single item of a series along with its CSV record.
+type emitFunc func(item proto.Message, record []string) error
+
+// exportSeries is a telemetry series that can be exported.
+type exportSeries struct {
+	// header is the names of the CSV columns.
+	header []string
+	// query emits all the items of the series, going through every page of the paginated series.
+	query func(ctx context.Context, queryClient telemetrytypes.QueryClient, params exportParams, emit emitFunc) error
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatUint(n uint64) string {
+	return strconv.FormatUint(n, 10)
+}
+
+// fetchFunc fetches a page of a paginated series, returning the number of items of the page and the total number of
+// items. The call options are to be passed on to the query.
+type fetchFunc func(ctx context.Context, pageReq *query.PageRequest, opts ...grpc.CallOption) (int, uint64, error)
+
+// paginate calls fetch with consecutive pages until every item is fetched. Pages after the first one are queried at
+// the height of the first response, so blocks committed during the export do not shift the items between pages.
+func paginate(ctx context.Context, fetch fetchFunc) error {
+	var header metadata.MD
+	var offset uint64
+	for {
+		count, total, err := fetch(ctx, &query.PageRequest{Offset: offset, Limit: exportPageSize}, grpc.Header(&header))
+		if err != nil {
+			return err
+		}
+		if offset == 0 {
+			heights := header.Get(grpctypes.GRPCBlockHeightHeader)
+			if len(heights) == 0 {
+				return fmt.Errorf("no height in the response to the first page")
+			}
+			ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, heights[0])
+		}
+		offset += uint64(count)
+		if count == 0 || offset >= total {
+			return nil
+		}
+	}
+}
+
+// exportSeriesByName is all the series that can be exported, keyed by the name used on the command line.
+var exportSeriesByName = map[string]exportSeries{
+	"avg-block-size": {
+		header: []string{"start", "bytes"},
+		query: func(ctx context.Context, qc telemetrytypes.QueryClient, p exportParams, emit emitFunc) error {
+			res, err := qc.AvgBlockSize(ctx, &telemetrytypes.QueryAvgBlockSizeRequest{
+				StartDate: p.startDate, EndDate: p.endDate, Granularity: p.granularity,
+			})
+			if err != nil {
+				return err
+			}
+			for idx, item := range res.AvgBlockSize {
+				if err := emit(&res.AvgBlockSize[idx], []string{formatTime(item.Start), formatUint(item.Bytes)}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	"avg-block-time": {
+		header: []string{"start", "seconds"},
+		query: func(ctx context.Context, qc telemetrytypes.QueryClient, p exportParams, emit emitFunc) error {
+			res, err := qc.AvgBlockTime(ctx, &telemetrytypes.QueryAvgBlockTimeRequest{
+				StartDate: p.startDate, EndDate: p.endDate, Granularity: p.granularity,
+			})
+			if err != nil {
+				return err
+			}
+			for idx, item := range res.AvgBlockTime {
+				if err := emit(&res.AvgBlockTime[idx], []string{formatTime(item.Start), formatUint(item.Seconds)}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	"avg-tx-fee": {
+		header: []string{"start", "fee"},
+		query: func(ctx context.Context, qc telemetrytypes.QueryClient, p exportParams, emit emitFunc) error {
+			res, err := qc.AvgTxFee(ctx, &telemetrytypes.QueryAvgTxFeeRequest{
+				StartDate: p.startDate, EndDate: p.endDate, Granularity: p.granularity,
+			})
+			if err != nil {
+				return err
+			}
+			for idx, item := range res.AvgTxFee {
+				if err := emit(&res.AvgTxFee[idx], []string{formatTime(item.Start), item.Fee.String()}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	"tx-volume": {
+		header: []string{"start", "volume"},
+		query: func(ctx context.Context, qc telemetrytypes.QueryClient, p exportParams, emit emitFunc) error {
+			res, err := qc.TxVolume(ctx, &telemetrytypes.QueryTxVolumeRequest{
+				StartDate: p.startDate, EndDate: p.endDate, Granularity: p.granularity,
+			})
+			if err != nil {
+				return err
+			}
+			for idx, item := range res.TxVolume {
+				if err := emit(&res.TxVolume[idx], []string{formatTime(item.Start), formatUint(item.Volume)}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	"oracle-requests": {
+		header: []string{"start", "requests_count", "success_count", "failure_count", "expired_count", "avg_resolve_blocks"},
+		query: func(ctx context.Context, qc telemetrytypes.QueryClient, p exportParams, emit emitFunc) error {
+			res, err := qc.OracleRequests(ctx, &telemetrytypes.QueryOracleRequestsRequest{
+				StartDate: p.startDate, EndDate: p.endDate, Granularity: p.granularity,
+			})
+			if err != nil {
+				return err
+			}
+			for idx, item := range res.OracleRequests {
+				if err := emit(&res.OracleRequests[idx], []string{
+					formatTime(item.Start),
+					formatUint(item.RequestsCount),
+					formatUint(item.SuccessCount),
+					formatUint(item.FailureCount),
+					formatUint(item.ExpiredCount),
+					item.AvgResolveBlocks.String(),
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	"data-provider-rewards": {
+		header: []string{"start", "amount", "payouts_count"},
+		query: func(ctx context.Context, qc telemetrytypes.QueryClient, p exportParams, emit emitFunc) error {
+			res, err := qc.DataProviderRewards(ctx, &telemetrytypes.QueryDataProviderRewardsRequest{
+				StartDate: p.startDate, EndDate: p.endDate, Granularity: p.granularity,
+			})
+			if err != nil {
+				return err
+			}
+			for idx, item := range res.DataProviderRewards {
+				if err := emit(&res.DataProviderRewards[idx], []string{
+					formatTime(item.Start), item.Amount.String(), formatUint(item.PayoutsCount),
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	"active-accounts": {
+		header: []string{"start", "accounts_count"},
+		query: func(ctx context.Context, qc telemetrytypes.QueryClient, p exportParams, emit emitFunc) error {
+			res, err := qc.ActiveAccounts(ctx, &telemetrytypes.QueryActiveAccountsRequest{
+				StartDate: p.startDate, EndDate: p.endDate, Granularity: p.granularity,
+			})
+			if err != nil {
+				return err
+			}
+			for idx, item := range res.ActiveAccounts {
+				if err := emit(&res.ActiveAccounts[idx], []string{
+					formatTime(item.Start), formatUint(item.AccountsCount),
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	"account-growth": {
+		header: []string{"start", "new_accounts", "total_accounts"},
+		query: func(ctx context.Context, qc telemetrytypes.QueryClient, p exportParams, emit emitFunc) error {
+			res, err := qc.AccountGrowth(ctx, &telemetrytypes.QueryAccountGrowthRequest{
+				StartDate: p.startDate, EndDate: p.endDate, Granularity: p.granularity,
+			})
+			if err != nil {
+				return err
+			}
+			for idx, item := range res.AccountGrowth {
+				if err := emit(&res.AccountGrowth[idx], []string{
+					formatTime(item.Start), formatUint(item.NewAccounts), formatUint(item.TotalAccounts),
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	"validator-uptime": {
+		header: []string{"start", "signed_blocks", "missed_blocks", "uptime", "oracle_active"},
+		query: func(ctx context.Context, qc telemetrytypes.QueryClient, p exportParams, emit emitFunc) error {
+			if p.validator == "" {
+				return fmt.Errorf("--%s is required by the validator-uptime series", flagValidator)
+			}
+			res, err := qc.ValidatorUptime(ctx, &telemetrytypes.QueryValidatorUptimeRequest{
+				ValidatorAddress: p.validator, StartDate: p.startDate, EndDate: p.endDate, Granularity: p.granularity,
+			})
+			if err != nil {
+				return err
+			}
+			for idx, item := range res.Uptime {
+				if err := emit(&res.Uptime[idx], []string{
+					formatTime(item.Start),
+					formatUint(item.SignedBlocks),
+					formatUint(item.MissedBlocks),
+					item.Uptime.String(),
+					strconv.FormatBool(item.OracleActive),
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	"validator-blocks": {
+		header: []string{"validator_address", "blocks_count", "stake_percentage"},
+		query: func(ctx context.Context, qc telemetrytypes.QueryClient, p exportParams, emit emitFunc) error {
+			return paginate(ctx, func(ctx context.Context, pageReq *query.PageRequest, opts ...grpc.CallOption) (int, uint64, error) {
+				res, err := qc.TopValidators(ctx, &telemetrytypes.QueryTopValidatorsRequest{
+					StartDate: p.startDate, EndDate: p.endDate, Pagination: pageReq, Desc: p.desc,
+				}, opts...)
+				if err != nil {
+					return 0, 0, err
+				}
+				for idx, item := range res.TopValidators {
+					if err := emit(&res.TopValidators[idx], []string{
+						item.ValidatorAddress, formatUint(item.BlocksCount), item.StakePercentage.String(),
+					}); err != nil {
+						return 0, 0, err
+					}
+				}
+				return len(res.TopValidators), res.Pagination.GetTotal(), nil
+			})
+		},
+	},
+	"validators-uptime": {
+		header: []string{"validator_address", "signed_blocks", "missed_blocks", "uptime", "oracle_active"},
+		query: func(ctx context.Context, qc telemetrytypes.QueryClient, p exportParams, emit emitFunc) error {
+			return paginate(ctx, func(ctx context.Context, pageReq *query.PageRequest, opts ...grpc.CallOption) (int, uint64, error) {
+				res, err := qc.TopValidatorsByUptime(ctx, &telemetrytypes.QueryTopValidatorsByUptimeRequest{
+					StartDate: p.startDate, EndDate: p.endDate, Pagination: pageReq, Desc: p.desc,
+				}, opts...)
+				if err != nil {
+					return 0, 0, err
+				}
+				for idx, item := range res.Validators {
+					if err := emit(&res.Validators[idx], []string{
+						item.ValidatorAddress,
+						formatUint(item.SignedBlocks),
+						formatUint(item.MissedBlocks),
+						item.Uptime.String(),
+						strconv.FormatBool(item.OracleActive),
+					}); err != nil {
+						return 0, 0, err
+					}
+				}
+				return len(res.Validators), res.Pagination.GetTotal(), nil
+			})
+		},
+	},
+	"data-sources": {
+		header: []string{"data_source_id", "requests_count"},
+		query: func(ctx context.Context, qc telemetrytypes.QueryClient, p exportParams, emit emitFunc) error {
+			return paginate(ctx, func(ctx context.Context, pageReq *query.PageRequest, opts ...grpc.CallOption) (int, uint64, error) {
+				res, err := qc.TopDataSources(ctx, &telemetrytypes.QueryTopDataSourcesRequest{
+					StartDate: p.startDate, EndDate: p.endDate, Pagination: pageReq, Desc: p.desc,
+				}, opts...)
+				if err != nil {
+					return 0, 0, err
+				}
+				for idx, item := range res.DataSources {
+					if err := emit(&res.DataSources[idx], []string{
+						formatUint(item.DataSourceID), formatUint(item.RequestsCount),
+					}); err != nil {
+						return 0, 0, err
+					}
+				}
+				return len(res.DataSources), res.Pagination.GetTotal(), nil
+			})
+		},
+	},
+	"report-participation": {
+		header: []string{"validator_address", "requested_count", "reports_count", "participation"},
+		query: func(ctx context.Context, qc telemetrytypes.QueryClient, p exportParams, emit emitFunc) error {
+			return paginate(ctx, func(ctx context.Context, pageReq *query.PageRequest, opts ...grpc.CallOption) (int, uint64, error) {
+				res, err := qc.ReportParticipation(ctx, &telemetrytypes.QueryReportParticipationRequest{
+					StartDate: p.startDate, EndDate: p.endDate, Pagination: pageReq, Desc: p.desc,
+				}, opts...)
+				if err != nil {
+					return 0, 0, err
+				}
+				for idx, item := range res.Validators {
+					if err := emit(&res.Validators[idx], []string{
+						item.ValidatorAddress,
+						formatUint(item.RequestedCount),
+						formatUint(item.ReportsCount),
+						item.Participation.String(),
+					}); err != nil {
+						return 0, 0, err
+					}
+				}
+				return len(res.Validators), res.Pagination.GetTotal(), nil
+			})
+		},
+	},
+	"accounts": {
+		header: []string{"address", "txs_count"},
+		query: func(ctx context.Context, qc telemetrytypes.QueryClient, p exportParams, emit emitFunc) error {
+			return paginate(ctx, func(ctx context.Context, pageReq *query.PageRequest, opts ...grpc.CallOption) (int, uint64, error) {
+				res, err := qc.TopAccounts(ctx, &telemetrytypes.QueryTopAccountsRequest{
+					StartDate: p.startDate, EndDate: p.endDate, Pagination: pageReq, Desc: p.desc,
+				}, opts...)
+				if err != nil {
+					return 0, 0, err
+				}
+				for idx, item := range res.Accounts {
+					if err := emit(&res.Accounts[idx], []string{item.Address, formatUint(item.TxsCount)}); err != nil {
+						return 0, 0, err
+					}
+				}
+				return len(res.Accounts), res.Pagination.GetTotal(), nil
+			})
+		},
+	},
+	"balances": {
+		header: []string{"address", "coins"},
+		query: func(ctx context.Context, qc telemetrytypes.QueryClient, p exportParams, emit emitFunc) error {
+			if p.denom == "" {
+				return fmt.Errorf("--%s is required by the balances series", flagDenom)
+			}
+			return paginate(ctx, func(ctx context.Context, pageReq *query.PageRequest, opts ...grpc.CallOption) (int, uint64, error) {
+				res, err := qc.TopBalances(ctx, &telemetrytypes.QueryTopBalancesRequest{
+					Denom: p.denom, Pagination: pageReq, Desc: p.desc,
+				}, opts...)
+				if err != nil {
+					return 0, 0, err
+				}
+				for idx, item := range res.Balances {
+					if err := emit(&res.Balances[idx], []string{item.Address, item.Coins.String()}); err != nil {
+						return 0, 0, err
+					}
+				}
+				return len(res.Balances), res.Pagination.GetTotal(), nil
+			})
+		},
+	},
+}
+
+// exportSeriesNames returns the names of the series that can be exported, sorted.
+func exportSeriesNames() []string {
+	names := make([]string, 0, len(exportSeriesByName))
+	for name := range exportSeriesByName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// exportWriter writes the items of a series in an export format.
+type exportWriter interface {
+	Write(item proto.Message, record []string) error
+	// Close terminates the export, it does not close the underlying writer.
+	Close() error
+}
+
+func newExportWriter(format string, w io.Writer, cdc codec.JSONCodec, header []string) (exportWriter, error) {
+	switch format {
+	case exportFormatCSV:
+		csvWriter := csv.NewWriter(w)
+		if err := csvWriter.Write(header); err != nil {
+			return nil, err
+		}
+		return csvExportWriter{w: csvWriter}, nil
+	case exportFormatJSON:
+		return &jsonExportWriter{w: w, cdc: cdc}, nil
+	case exportFormatNDJSON:
+		return ndjsonExportWriter{w: w, cdc: cdc}, nil
+	default:
+		return nil, fmt.Errorf(
+			"unknown format %s, expected %s, %s or %s", format, exportFormatCSV, exportFormatJSON, exportFormatNDJSON,
+		)
+	}
+}
+
+// csvExportWriter writes a record per item after the header.
+type csvExportWriter struct {
+	w *csv.Writer
+}
+
+func (e csvExportWriter) Write(_ proto.Message, record []string) error {
+	return e.w.Write(record)
+}
+
+func (e csvExportWriter) Close() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// jsonExportWriter writes the items as a single JSON array.
+type jsonExportWriter struct {
+	w     io.Writer
+	cdc   codec.JSONCodec
+	count int
+}
+
+func (e *jsonExportWriter) Write(item proto.Message, _ []string) error {
+	bz, err := e.cdc.MarshalJSON(item)
+	if err != nil {
+		return err
+	}
+	separator := ",\n"
+	if e.count == 0 {
+		separator = "[\n"
+	}
+	e.count++
+	_, err = fmt.Fprintf(e.w, "%s%s", separator, bz)
+	return err
+}
+
+func (e *jsonExportWriter) Close() error {
+	if e.count == 0 {
+		_, err := fmt.Fprintln(e.w, "[]")
+		return err
+	}
+	_, err := fmt.Fprintln(e.w, "\n]")
+	return err
+}
+
+// ndjsonExportWriter writes an item as JSON per line.
+type ndjsonExportWriter struct {
+	w   io.Writer
+	cdc codec.JSONCodec
+}
+
+func (e ndjsonExportWriter) Write(item proto.Message, _ []string) error {
+	bz, err := e.cdc.MarshalJSON(item)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(e.w, "%s\n", bz)
+	return err
+}
+
+func (e ndjsonExportWriter) Close() error {
+	return nil
+}
+
+// GetQueryCmdExport implements the export command.
+func GetQueryCmdExport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [series]",
+		Short: "Export a telemetry series as CSV, JSON or NDJSON",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export a telemetry series as CSV, JSON or NDJSON to a file or the standard output. Every page of the paginated series is fetched at the height of the first one. Dates are either days or RFC3339 times.
+
+Series: %[3]s
+
+Example:
+  $ %[1]s query %[2]s export tx-volume --from=2021-12-01 --to=2021-12-31 --granularity=week
+  $ %[1]s query %[2]s export avg-tx-fee --from=2021-12-01 --format=json --file=fees.json
+  $ %[1]s query %[2]s export balances --denom=loki --desc --format=ndjson
+`,
+				version.AppName, telemetrytypes.ModuleName, strings.Join(exportSeriesNames(), ", "),
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			series, ok := exportSeriesByName[args[0]]
+			if !ok {
+				return fmt.Errorf("unknown series %s, expected one of %s", args[0], strings.Join(exportSeriesNames(), ", "))
+			}
+
+			flagSet := cmd.Flags()
+			from, _ := flagSet.GetString(flagFrom)
+			to, _ := flagSet.GetString(flagTo)
+			startDate, endDate, err := ParseDateInterval(from, to)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to parse date interval")
+			}
+			granularityName, _ := flagSet.GetString(flagGranularity)
+			granularity, err := telemetrytypes.ParseGranularity(granularityName)
+			if err != nil {
+				return err
+			}
+			desc, _ := flagSet.GetBool(flagDesc)
+			denom, _ := flagSet.GetString(flagDenom)
+			validator, _ := flagSet.GetString(flagValidator)
+			if validator != "" {
+				if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+					return err
+				}
+			}
+			format, _ := flagSet.GetString(flagFormat)
+			switch format {
+			case exportFormatCSV, exportFormatJSON, exportFormatNDJSON:
+			default:
+				return fmt.Errorf(
+					"unknown format %s, expected %s, %s or %s", format, exportFormatCSV, exportFormatJSON, exportFormatNDJSON,
+				)
+			}
+			fileName, _ := flagSet.GetString(flagFile)
+
+			out := cmd.OutOrStdout()
+			if fileName != "" {
+				file, err := os.Create(fileName)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				out = file
+			}
+			writer, err := newExportWriter(format, out, clientCtx.Codec, series.header)
+			if err != nil {
+				return err
+			}
+
+			queryClient := telemetrytypes.NewQueryClient(clientCtx)
+			if err := series.query(cmd.Context(), queryClient, exportParams{
+				startDate:   startDate,
+				endDate:     endDate,
+				granularity: granularity,
+				desc:        desc,
+				denom:       denom,
+				validator:   validator,
+			}, writer.Write); err != nil {
+				return sdkerrors.Wrapf(err, "failed to export %s", args[0])
+			}
+
+			return writer.Close()
+		},
+	}
+
+	cmd.Flags().String(flagFrom, "", "start date of the series")
+	cmd.Flags().String(flagTo, "", "end date of the series")
+	cmd.Flags().String(flagGranularity, "day", "size of the time buckets: hour, day, week or month")
+	cmd.Flags().String(flagFormat, exportFormatCSV, "export format: csv, json or ndjson")
+	cmd.Flags().String(flagFile, "", "file to export to, the standard output if empty")
+	cmd.Flags().Bool(flagDesc, false, "desc is used in calling the data with sort by desc")
+	cmd.Flags().String(flagDenom, "", "denomination of the balances series")
+	cmd.Flags().String(flagValidator, "", "validator operator address of the validator-uptime series")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+
+	telemetrytypes "github.com/GeoDB-Limited/odin-core/x/telemetry/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// fakeQueryClient serves the top accounts of a chain that commits a block before every query.
+type fakeQueryClient struct {
+	telemetrytypes.QueryClient
+	accounts []telemetrytypes.AccountTxs
+	height   int64
+	// heights is the height each query was served at.
+	heights []int64
+}
+
+func (c *fakeQueryClient) TopAccounts(
+	ctx context.Context,
+	req *telemetrytypes.QueryTopAccountsRequest,
+	opts ...grpc.CallOption,
+) (*telemetrytypes.QueryTopAccountsResponse, error) {
+	c.height++
+	height := c.height
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if heights := md.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+		var err error
+		if height, err = strconv.ParseInt(heights[0], 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	c.heights = append(c.heights, height)
+	for _, opt := range opts {
+		if header, ok := opt.(grpc.HeaderCallOption); ok {
+			*header.HeaderAddr = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+		}
+	}
+
+	total := uint64(len(c.accounts))
+	start, end := req.Pagination.GetOffset(), req.Pagination.GetOffset()+req.Pagination.GetLimit()
+	if start > total {
+		start = total
+	}
+	if end > total {
+		end = total
+	}
+	return &telemetrytypes.QueryTopAccountsResponse{
+		Accounts:   c.accounts[start:end],
+		Pagination: &query.PageResponse{Total: total},
+	}, nil
+}
+
+func newFakeQueryClient(accounts int) *fakeQueryClient {
+	qc := &fakeQueryClient{}
+	for idx := 0; idx < accounts; idx++ {
+		qc.accounts = append(qc.accounts, telemetrytypes.AccountTxs{
+			Address:  fmt.Sprintf("account%03d", idx),
+			TxsCount: uint64(idx),
+		})
+	}
+	return qc
+}
+
+// export exports the accounts series of the query client in the given format.
+func export(t *testing.T, qc telemetrytypes.QueryClient, format string) []byte {
+	series := exportSeriesByName["accounts"]
+	var out bytes.Buffer
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	writer, err := newExportWriter(format, &out, cdc, series.header)
+	require.NoError(t, err)
+	require.NoError(t, series.query(context.Background(), qc, exportParams{}, writer.Write))
+	require.NoError(t, writer.Close())
+	return out.Bytes()
+}
+
+func TestExportPagesAtFirstHeight(t *testing.T) {
+	qc := newFakeQueryClient(2*exportPageSize + 50)
+	records, err := csv.NewReader(bytes.NewReader(export(t, qc, exportFormatCSV))).ReadAll()
+	require.NoError(t, err)
+
+	// Every page is queried at the height of the first one although blocks are committed in between.
+	require.Equal(t, []int64{1, 1, 1}, qc.heights)
+	require.Len(t, records, 2*exportPageSize+51)
+	require.Equal(t, []string{"address", "txs_count"}, records[0])
+	require.Equal(t, []string{"account000", "0"}, records[1])
+	require.Equal(t, []string{"account100", "100"}, records[exportPageSize+1])
+	require.Equal(t, []string{"account249", "249"}, records[len(records)-1])
+}
+
+func TestExportJSON(t *testing.T) {
+	qc := newFakeQueryClient(exportPageSize + 1)
+	var accounts []struct {
+		Address  string `json:"address"`
+		TxsCount string `json:"txs_count"`
+	}
+	require.NoError(t, json.Unmarshal(export(t, qc, exportFormatJSON), &accounts))
+	require.Len(t, accounts, exportPageSize+1)
+	require.Equal(t, "account001", accounts[1].Address)
+	require.Equal(t, "1", accounts[1].TxsCount)
+	require.Equal(t, "account100", accounts[exportPageSize].Address)
+
+	require.JSONEq(t, "[]", string(export(t, newFakeQueryClient(0), exportFormatJSON)))
+}
+
+func TestExportRequiresFirstHeight(t *testing.T) {
+	err := paginate(context.Background(), func(context.Context, *query.PageRequest, ...grpc.CallOption) (int, uint64, error) {
+		return exportPageSize, 2 * exportPageSize, nil
+	})
+	require.Error(t, err)
+}
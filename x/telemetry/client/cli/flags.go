@@ -1,5 +1,14 @@
 package cli
 
 const (
-	flagDesc = "desc"
+	flagDesc        = "desc"
+	flagGranularity = "granularity"
+	flagThresholds  = "thresholds"
+	flagPercentiles = "percentiles"
+	flagFrom        = "from"
+	flagTo          = "to"
+	flagFormat      = "format"
+	flagFile        = "file"
+	flagDenom       = "denom"
+	flagValidator   = "validator"
 )
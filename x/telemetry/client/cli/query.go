@@ -15,7 +15,7 @@ import (
 )
 
 const (
-	DateFormat = "2006-01-02"
+	DateFormat = telemetrytypes.DateFormat
 )
 
 // GetQueryCmd returns the cli query commands for this module.
@@ -30,13 +30,24 @@ func GetQueryCmd() *cobra.Command {
 	coinswapCmd.AddCommand(
 		GetQueryCmdTopBalances(),
 		GetQueryCmdExtendedValidators(),
-		/*GetQueryCmdAvgBlockSize(),
+		GetQueryCmdAvgBlockSize(),
 		GetQueryCmdAvgBlockTime(),
 		GetQueryCmdAvgTxFee(),
 		GetQueryCmdTxVolume(),
-		GetQueryCmdValidatorBlocks(),*/
+		/*GetQueryCmdValidatorBlocks(),*/
 		GetQueryCmdTopValidators(),
 		GetQueryCmdValidatorByConsAddr(),
+		GetQueryCmdOracleRequests(),
+		GetQueryCmdTopDataSources(),
+		GetQueryCmdReportParticipation(),
+		GetQueryCmdDataProviderRewards(),
+		GetQueryCmdActiveAccounts(),
+		GetQueryCmdAccountGrowth(),
+		GetQueryCmdTopAccounts(),
+		GetQueryCmdSupplyDistribution(),
+		GetQueryCmdValidatorUptime(),
+		GetQueryCmdTopValidatorsByUptime(),
+		GetQueryCmdExport(),
 	)
 	return coinswapCmd
 }
@@ -136,9 +147,20 @@ Example:
 }
 
 // GetQueryCmdAvgBlockSize implements the query parameters command.
-/*func GetQueryCmdAvgBlockSize() *cobra.Command {
+func GetQueryCmdAvgBlockSize() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:  "avg-block-size [start-date] [end-date]",
+		Use:   "avg-block-size [start-date] [end-date]",
+		Short: "Query for the average block size per time bucket",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the average block size per time bucket. Dates are either days or RFC3339 times.
+
+Example:
+  $ %[1]s query %[2]s avg-block-size 2021-12-01 2021-12-31
+  $ %[1]s query %[2]s avg-block-size 2021-12-01T00:00:00Z 2021-12-01T12:00:00Z --granularity=hour
+`,
+				version.AppName, telemetrytypes.ModuleName,
+			),
+		),
 		Args: cobra.MaximumNArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -146,15 +168,21 @@ Example:
 				return err
 			}
 
-			startDate, endDate, err := ParseDateInterval(args[0], args[1])
+			startDate, endDate, err := parseDateArgs(args)
 			if err != nil {
 				return sdkerrors.Wrap(err, "failed to parse date interval")
 			}
+			granularityName, _ := cmd.Flags().GetString(flagGranularity)
+			granularity, err := telemetrytypes.ParseGranularity(granularityName)
+			if err != nil {
+				return err
+			}
 
 			queryClient := telemetrytypes.NewQueryClient(clientCtx)
 			res, err := queryClient.AvgBlockSize(cmd.Context(), &telemetrytypes.QueryAvgBlockSizeRequest{
-				StartDate: startDate,
-				EndDate:   endDate,
+				StartDate:   startDate,
+				EndDate:     endDate,
+				Granularity: granularity,
 			})
 			if err != nil {
 				return sdkerrors.Wrap(err, "failed to query average block size")
@@ -164,6 +192,7 @@ Example:
 		},
 	}
 
+	cmd.Flags().String(flagGranularity, "day", "size of the time buckets: hour, day, week or month")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -171,7 +200,18 @@ Example:
 // GetQueryCmdAvgBlockTime implements the query parameters command.
 func GetQueryCmdAvgBlockTime() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:  "avg-block-time [start-date] [end-date]",
+		Use:   "avg-block-time [start-date] [end-date]",
+		Short: "Query for the average block time per time bucket",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the average block time per time bucket. Dates are either days or RFC3339 times.
+
+Example:
+  $ %[1]s query %[2]s avg-block-time 2021-12-01 2021-12-31
+  $ %[1]s query %[2]s avg-block-time 2021-12-01T00:00:00Z 2021-12-01T12:00:00Z --granularity=hour
+`,
+				version.AppName, telemetrytypes.ModuleName,
+			),
+		),
 		Args: cobra.MaximumNArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -179,15 +219,21 @@ func GetQueryCmdAvgBlockTime() *cobra.Command {
 				return err
 			}
 
-			startDate, endDate, err := ParseDateInterval(args[0], args[1])
+			startDate, endDate, err := parseDateArgs(args)
 			if err != nil {
 				return sdkerrors.Wrap(err, "failed to parse date interval")
 			}
+			granularityName, _ := cmd.Flags().GetString(flagGranularity)
+			granularity, err := telemetrytypes.ParseGranularity(granularityName)
+			if err != nil {
+				return err
+			}
 
 			queryClient := telemetrytypes.NewQueryClient(clientCtx)
 			res, err := queryClient.AvgBlockTime(cmd.Context(), &telemetrytypes.QueryAvgBlockTimeRequest{
-				StartDate: startDate,
-				EndDate:   endDate,
+				StartDate:   startDate,
+				EndDate:     endDate,
+				Granularity: granularity,
 			})
 			if err != nil {
 				return sdkerrors.Wrap(err, "failed to query average block time")
@@ -197,6 +243,7 @@ func GetQueryCmdAvgBlockTime() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagGranularity, "day", "size of the time buckets: hour, day, week or month")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -204,7 +251,18 @@ func GetQueryCmdAvgBlockTime() *cobra.Command {
 // GetQueryCmdAvgTxFee implements the query parameters command.
 func GetQueryCmdAvgTxFee() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:  "avg-tx-fee [start-date] [end-date]",
+		Use:   "avg-tx-fee [start-date] [end-date]",
+		Short: "Query for the average transaction fee per time bucket",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the average transaction fee per time bucket. Dates are either days or RFC3339 times.
+
+Example:
+  $ %[1]s query %[2]s avg-tx-fee 2021-12-01 2021-12-31
+  $ %[1]s query %[2]s avg-tx-fee 2021-12-01T00:00:00Z 2021-12-01T12:00:00Z --granularity=hour
+`,
+				version.AppName, telemetrytypes.ModuleName,
+			),
+		),
 		Args: cobra.MaximumNArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -212,15 +270,21 @@ func GetQueryCmdAvgTxFee() *cobra.Command {
 				return err
 			}
 
-			startDate, endDate, err := ParseDateInterval(args[0], args[1])
+			startDate, endDate, err := parseDateArgs(args)
 			if err != nil {
 				return sdkerrors.Wrap(err, "failed to parse date interval")
 			}
+			granularityName, _ := cmd.Flags().GetString(flagGranularity)
+			granularity, err := telemetrytypes.ParseGranularity(granularityName)
+			if err != nil {
+				return err
+			}
 
 			queryClient := telemetrytypes.NewQueryClient(clientCtx)
 			res, err := queryClient.AvgTxFee(cmd.Context(), &telemetrytypes.QueryAvgTxFeeRequest{
-				StartDate: startDate,
-				EndDate:   endDate,
+				StartDate:   startDate,
+				EndDate:     endDate,
+				Granularity: granularity,
 			})
 			if err != nil {
 				return sdkerrors.Wrap(err, "failed to query average tx fee")
@@ -230,6 +294,7 @@ func GetQueryCmdAvgTxFee() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagGranularity, "day", "size of the time buckets: hour, day, week or month")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -237,7 +302,18 @@ func GetQueryCmdAvgTxFee() *cobra.Command {
 // GetQueryCmdTxVolume implements the query parameters command.
 func GetQueryCmdTxVolume() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:  "tx-volume [start-date] [end-date]",
+		Use:   "tx-volume [start-date] [end-date]",
+		Short: "Query for the transaction volume per time bucket",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the transaction volume per time bucket. Dates are either days or RFC3339 times.
+
+Example:
+  $ %[1]s query %[2]s tx-volume 2021-12-01 2021-12-31
+  $ %[1]s query %[2]s tx-volume 2021-12-01T00:00:00Z 2021-12-01T12:00:00Z --granularity=hour
+`,
+				version.AppName, telemetrytypes.ModuleName,
+			),
+		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -245,15 +321,21 @@ func GetQueryCmdTxVolume() *cobra.Command {
 				return err
 			}
 
-			startDate, endDate, err := ParseDateInterval(args[0], args[1])
+			startDate, endDate, err := parseDateArgs(args)
 			if err != nil {
 				return sdkerrors.Wrap(err, "failed to parse date interval")
 			}
+			granularityName, _ := cmd.Flags().GetString(flagGranularity)
+			granularity, err := telemetrytypes.ParseGranularity(granularityName)
+			if err != nil {
+				return err
+			}
 
 			queryClient := telemetrytypes.NewQueryClient(clientCtx)
 			res, err := queryClient.TxVolume(cmd.Context(), &telemetrytypes.QueryTxVolumeRequest{
-				StartDate: startDate,
-				EndDate:   endDate,
+				StartDate:   startDate,
+				EndDate:     endDate,
+				Granularity: granularity,
 			})
 			if err != nil {
 				return sdkerrors.Wrap(err, "failed to query tx volume")
@@ -263,9 +345,10 @@ func GetQueryCmdTxVolume() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagGranularity, "day", "size of the time buckets: hour, day, week or month")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
-}*/
+}
 
 // GetQueryCmdValidatorBlocks implements the query parameters command.
 func GetQueryCmdValidatorBlocks() *cobra.Command {
@@ -344,7 +427,7 @@ Example:
 				return sdkerrors.Wrap(err, "failed to get client context")
 			}
 
-			startDate, endDate, err := ParseDateInterval(args[0], args[1])
+			startDate, endDate, err := parseDateArgs(args)
 			if err != nil {
 				return sdkerrors.Wrap(err, "failed to parse date interval")
 			}
@@ -382,14 +465,14 @@ func ParseDateInterval(startDateArg, endDateArg string) (*time.Time, *time.Time,
 	var startDate, endDate *time.Time
 
 	if startDateArg != "" {
-		sd, err := time.Parse(DateFormat, startDateArg)
+		sd, err := telemetrytypes.ParseTime(startDateArg)
 		if err != nil {
 			return nil, nil, sdkerrors.Wrap(err, "failed to parse start date")
 		}
 		startDate = &sd
 	}
 	if endDateArg != "" {
-		ed, err := time.Parse(DateFormat, endDateArg)
+		ed, err := telemetrytypes.ParseTime(endDateArg)
 		if err != nil {
 			return nil, nil, sdkerrors.Wrap(err, "failed to parse end date")
 		}
@@ -399,6 +482,18 @@ func ParseDateInterval(startDateArg, endDateArg string) (*time.Time, *time.Time,
 	return startDate, endDate, nil
 }
 
+// parseDateArgs parses the optional start and end date arguments.
+func parseDateArgs(args []string) (*time.Time, *time.Time, error) {
+	var startDateArg, endDateArg string
+	if len(args) > 0 {
+		startDateArg = args[0]
+	}
+	if len(args) > 1 {
+		endDateArg = args[1]
+	}
+	return ParseDateInterval(startDateArg, endDateArg)
+}
+
 func GetQueryCmdValidatorByConsAddr() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "validator-by-consaddr [status]",
@@ -425,3 +520,546 @@ func GetQueryCmdValidatorByConsAddr() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetQueryCmdOracleRequests implements the query parameters command.
+func GetQueryCmdOracleRequests() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oracle-requests [start-date] [end-date]",
+		Short: "Query for the oracle requests per time bucket by oracle script and resolve status",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the oracle requests per time bucket by oracle script and resolve status. Dates are either days or RFC3339 times.
+
+Example:
+  $ %[1]s query %[2]s oracle-requests 2021-12-01 2021-12-31
+  $ %[1]s query %[2]s oracle-requests 2021-12-01T00:00:00Z 2021-12-01T12:00:00Z --granularity=hour
+`,
+				version.AppName, telemetrytypes.ModuleName,
+			),
+		),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startDate, endDate, err := parseDateArgs(args)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to parse date interval")
+			}
+			granularityName, _ := cmd.Flags().GetString(flagGranularity)
+			granularity, err := telemetrytypes.ParseGranularity(granularityName)
+			if err != nil {
+				return err
+			}
+
+			queryClient := telemetrytypes.NewQueryClient(clientCtx)
+			res, err := queryClient.OracleRequests(cmd.Context(), &telemetrytypes.QueryOracleRequestsRequest{
+				StartDate:   startDate,
+				EndDate:     endDate,
+				Granularity: granularity,
+			})
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to query oracle requests")
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagGranularity, "day", "size of the time buckets: hour, day, week or month")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetQueryCmdTopDataSources implements the query parameters command.
+func GetQueryCmdTopDataSources() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top-data-sources [start-date] [end-date]",
+		Short: "Query for top data sources by raw requests",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for top data sources by raw requests.
+
+Example:
+  $ %[1]s query %[2]s top-data-sources [start-date] [end-date]
+  $ %[1]s query %[2]s top-data-sources [start-date] [end-date] --limit=100 --offset=2 --desc=true
+`,
+				version.AppName, telemetrytypes.ModuleName,
+			),
+		),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to get client context")
+			}
+
+			startDate, endDate, err := parseDateArgs(args)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to parse date interval")
+			}
+
+			flagSet := cmd.Flags()
+			pageReq, err := client.ReadPageRequest(flagSet)
+			if err != nil {
+				return err
+			}
+			desc, _ := flagSet.GetBool(flagDesc)
+
+			queryClient := telemetrytypes.NewQueryClient(clientCtx)
+			res, err := queryClient.TopDataSources(cmd.Context(), &telemetrytypes.QueryTopDataSourcesRequest{
+				StartDate:  startDate,
+				EndDate:    endDate,
+				Pagination: pageReq,
+				Desc:       desc,
+			})
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to query top data sources")
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "top data sources")
+	cmd.Flags().Bool(flagDesc, false, "desc is used in calling the data with sort by desc")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryCmdReportParticipation implements the query parameters command.
+func GetQueryCmdReportParticipation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report-participation [start-date] [end-date]",
+		Short: "Query for the report participation of validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the report participation of validators.
+
+Example:
+  $ %[1]s query %[2]s report-participation [start-date] [end-date]
+  $ %[1]s query %[2]s report-participation [start-date] [end-date] --limit=100 --offset=2 --desc=true
+`,
+				version.AppName, telemetrytypes.ModuleName,
+			),
+		),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to get client context")
+			}
+
+			startDate, endDate, err := parseDateArgs(args)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to parse date interval")
+			}
+
+			flagSet := cmd.Flags()
+			pageReq, err := client.ReadPageRequest(flagSet)
+			if err != nil {
+				return err
+			}
+			desc, _ := flagSet.GetBool(flagDesc)
+
+			queryClient := telemetrytypes.NewQueryClient(clientCtx)
+			res, err := queryClient.ReportParticipation(cmd.Context(), &telemetrytypes.QueryReportParticipationRequest{
+				StartDate:  startDate,
+				EndDate:    endDate,
+				Pagination: pageReq,
+				Desc:       desc,
+			})
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to query report participation")
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "report participation")
+	cmd.Flags().Bool(flagDesc, false, "desc is used in calling the data with sort by desc")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryCmdDataProviderRewards implements the query parameters command.
+func GetQueryCmdDataProviderRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "data-provider-rewards [start-date] [end-date]",
+		Short: "Query for the rewards paid out to data providers per time bucket",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the rewards paid out to data providers per time bucket. Dates are either days or RFC3339 times.
+
+Example:
+  $ %[1]s query %[2]s data-provider-rewards 2021-12-01 2021-12-31
+  $ %[1]s query %[2]s data-provider-rewards 2021-12-01T00:00:00Z 2021-12-01T12:00:00Z --granularity=hour
+`,
+				version.AppName, telemetrytypes.ModuleName,
+			),
+		),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startDate, endDate, err := parseDateArgs(args)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to parse date interval")
+			}
+			granularityName, _ := cmd.Flags().GetString(flagGranularity)
+			granularity, err := telemetrytypes.ParseGranularity(granularityName)
+			if err != nil {
+				return err
+			}
+
+			queryClient := telemetrytypes.NewQueryClient(clientCtx)
+			res, err := queryClient.DataProviderRewards(cmd.Context(), &telemetrytypes.QueryDataProviderRewardsRequest{
+				StartDate:   startDate,
+				EndDate:     endDate,
+				Granularity: granularity,
+			})
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to query data provider rewards")
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagGranularity, "day", "size of the time buckets: hour, day, week or month")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetQueryCmdActiveAccounts implements the query parameters command.
+func GetQueryCmdActiveAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "active-accounts [start-date] [end-date]",
+		Short: "Query for the number of distinct transaction signers per time bucket",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the number of distinct transaction signers per time bucket. Dates are either days or RFC3339 times.
+
+Example:
+  $ %[1]s query %[2]s active-accounts 2021-12-01 2021-12-31
+  $ %[1]s query %[2]s active-accounts 2021-12-01T00:00:00Z 2021-12-01T12:00:00Z --granularity=hour
+`,
+				version.AppName, telemetrytypes.ModuleName,
+			),
+		),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startDate, endDate, err := parseDateArgs(args)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to parse date interval")
+			}
+			granularityName, _ := cmd.Flags().GetString(flagGranularity)
+			granularity, err := telemetrytypes.ParseGranularity(granularityName)
+			if err != nil {
+				return err
+			}
+
+			queryClient := telemetrytypes.NewQueryClient(clientCtx)
+			res, err := queryClient.ActiveAccounts(cmd.Context(), &telemetrytypes.QueryActiveAccountsRequest{
+				StartDate:   startDate,
+				EndDate:     endDate,
+				Granularity: granularity,
+			})
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to query active accounts")
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagGranularity, "day", "size of the time buckets: hour, day, week or month")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetQueryCmdAccountGrowth implements the query parameters command.
+func GetQueryCmdAccountGrowth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-growth [start-date] [end-date]",
+		Short: "Query for the new and total accounts per time bucket",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the new and total accounts per time bucket. Dates are either days or RFC3339 times.
+
+Example:
+  $ %[1]s query %[2]s account-growth 2021-12-01 2021-12-31
+  $ %[1]s query %[2]s account-growth 2021-12-01T00:00:00Z 2021-12-01T12:00:00Z --granularity=hour
+`,
+				version.AppName, telemetrytypes.ModuleName,
+			),
+		),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startDate, endDate, err := parseDateArgs(args)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to parse date interval")
+			}
+			granularityName, _ := cmd.Flags().GetString(flagGranularity)
+			granularity, err := telemetrytypes.ParseGranularity(granularityName)
+			if err != nil {
+				return err
+			}
+
+			queryClient := telemetrytypes.NewQueryClient(clientCtx)
+			res, err := queryClient.AccountGrowth(cmd.Context(), &telemetrytypes.QueryAccountGrowthRequest{
+				StartDate:   startDate,
+				EndDate:     endDate,
+				Granularity: granularity,
+			})
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to query account growth")
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagGranularity, "day", "size of the time buckets: hour, day, week or month")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetQueryCmdTopAccounts implements the query parameters command.
+func GetQueryCmdTopAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top-accounts [start-date] [end-date]",
+		Short: "Query for top accounts by signed transactions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for top accounts by signed transactions.
+
+Example:
+  $ %[1]s query %[2]s top-accounts [start-date] [end-date]
+  $ %[1]s query %[2]s top-accounts [start-date] [end-date] --limit=100 --offset=2 --desc=true
+`,
+				version.AppName, telemetrytypes.ModuleName,
+			),
+		),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to get client context")
+			}
+
+			startDate, endDate, err := parseDateArgs(args)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to parse date interval")
+			}
+
+			flagSet := cmd.Flags()
+			pageReq, err := client.ReadPageRequest(flagSet)
+			if err != nil {
+				return err
+			}
+			desc, _ := flagSet.GetBool(flagDesc)
+
+			queryClient := telemetrytypes.NewQueryClient(clientCtx)
+			res, err := queryClient.TopAccounts(cmd.Context(), &telemetrytypes.QueryTopAccountsRequest{
+				StartDate:  startDate,
+				EndDate:    endDate,
+				Pagination: pageReq,
+				Desc:       desc,
+			})
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to query top accounts")
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "top accounts")
+	cmd.Flags().Bool(flagDesc, false, "desc is used in calling the data with sort by desc")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryCmdSupplyDistribution implements the query parameters command.
+func GetQueryCmdSupplyDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-distribution [denom]",
+		Short: "Query for the supply distribution of a denomination",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the supply distribution of a denomination as of the last supply snapshot: the Gini
+coefficient of user balances, the holders of at least each threshold, the balance cut-offs of each percentile, the
+shares of module and user accounts and the treasury and community pools.
+
+Example:
+  $ %[1]s query %[2]s supply-distribution loki
+  $ %[1]s query %[2]s supply-distribution loki --thresholds=1000000,1000000000 --percentiles=50,90,99
+`,
+				version.AppName, telemetrytypes.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			thresholdArgs, _ := flagSet.GetStringSlice(flagThresholds)
+			thresholds := make([]sdk.Int, len(thresholdArgs))
+			for i, threshold := range thresholdArgs {
+				amount, ok := sdk.NewIntFromString(threshold)
+				if !ok {
+					return fmt.Errorf("invalid threshold: %s", threshold)
+				}
+				thresholds[i] = amount
+			}
+			percentiles, _ := flagSet.GetUintSlice(flagPercentiles)
+
+			queryClient := telemetrytypes.NewQueryClient(clientCtx)
+			request := &telemetrytypes.QuerySupplyDistributionRequest{
+				Denom:      args[0],
+				Thresholds: thresholds,
+			}
+			for _, percentile := range percentiles {
+				request.Percentiles = append(request.Percentiles, uint32(percentile))
+			}
+			res, err := queryClient.SupplyDistribution(cmd.Context(), request)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to query supply distribution")
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagThresholds, nil, "amounts to count the holders of at least")
+	cmd.Flags().UintSlice(flagPercentiles, nil, "percentiles to return the balance cut-offs of (default 50,90,99)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetQueryCmdValidatorUptime implements the query parameters command.
+func GetQueryCmdValidatorUptime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-uptime [validator-address] [start-date] [end-date]",
+		Short: "Query for the blocks signed and missed by a validator per time bucket",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the blocks signed and missed by a validator per time bucket along with its oracle status history. Dates are either days or RFC3339 times.
+
+Example:
+  $ %[1]s query %[2]s validator-uptime odinvaloper1... 2021-12-01 2021-12-31
+  $ %[1]s query %[2]s validator-uptime odinvaloper1... 2021-12-01T00:00:00Z 2021-12-01T12:00:00Z --granularity=hour
+`,
+				version.AppName, telemetrytypes.ModuleName,
+			),
+		),
+		Args: cobra.RangeArgs(1, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			startDate, endDate, err := parseDateArgs(args[1:])
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to parse date interval")
+			}
+			granularityName, _ := cmd.Flags().GetString(flagGranularity)
+			granularity, err := telemetrytypes.ParseGranularity(granularityName)
+			if err != nil {
+				return err
+			}
+
+			queryClient := telemetrytypes.NewQueryClient(clientCtx)
+			res, err := queryClient.ValidatorUptime(cmd.Context(), &telemetrytypes.QueryValidatorUptimeRequest{
+				ValidatorAddress: valAddr.String(),
+				StartDate:        startDate,
+				EndDate:          endDate,
+				Granularity:      granularity,
+			})
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to query validator uptime")
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagGranularity, "day", "size of the time buckets: hour, day, week or month")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetQueryCmdTopValidatorsByUptime implements the query parameters command.
+func GetQueryCmdTopValidatorsByUptime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top-validators-by-uptime [start-date] [end-date]",
+		Short: "Query for top validators by the share of blocks they signed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for top validators by the share of blocks they signed.
+
+Example:
+  $ %[1]s query %[2]s top-validators-by-uptime [start-date] [end-date]
+  $ %[1]s query %[2]s top-validators-by-uptime [start-date] [end-date] --limit=100 --offset=2 --desc=true
+`,
+				version.AppName, telemetrytypes.ModuleName,
+			),
+		),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to get client context")
+			}
+
+			startDate, endDate, err := parseDateArgs(args)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to parse date interval")
+			}
+
+			flagSet := cmd.Flags()
+			pageReq, err := client.ReadPageRequest(flagSet)
+			if err != nil {
+				return err
+			}
+			desc, _ := flagSet.GetBool(flagDesc)
+
+			queryClient := telemetrytypes.NewQueryClient(clientCtx)
+			res, err := queryClient.TopValidatorsByUptime(cmd.Context(), &telemetrytypes.QueryTopValidatorsByUptimeRequest{
+				StartDate:  startDate,
+				EndDate:    endDate,
+				Pagination: pageReq,
+				Desc:       desc,
+			})
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to query top validators by uptime")
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "top validators by uptime")
+	cmd.Flags().Bool(flagDesc, false, "desc is used in calling the data with sort by desc")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
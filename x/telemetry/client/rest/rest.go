@@ -5,9 +5,13 @@ import (
 	commonrest "github.com/GeoDB-Limited/odin-core/x/common/client/rest"
 	telemetrytypes "github.com/GeoDB-Limited/odin-core/x/telemetry/types"
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/gorilla/mux"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 )
 
 func RegisterRoutes(clientCtx client.Context, rtr *mux.Router) {
@@ -21,7 +25,7 @@ func RegisterRoutes(clientCtx client.Context, rtr *mux.Router) {
 		getExtendedValidatorsHandler(clientCtx),
 	).Methods("GET")
 
-	/*rtr.HandleFunc(
+	rtr.HandleFunc(
 		fmt.Sprintf("/%s/%s", telemetrytypes.ModuleName, telemetrytypes.QueryAvgBlockSize),
 		getAvgBlockSizeHandler(clientCtx),
 	).Methods("GET")
@@ -39,13 +43,65 @@ func RegisterRoutes(clientCtx client.Context, rtr *mux.Router) {
 	rtr.HandleFunc(
 		fmt.Sprintf("/%s/%s", telemetrytypes.ModuleName, telemetrytypes.QueryTxVolume),
 		getTxVolumeHandler(clientCtx),
-	).Methods("GET")*/
+	).Methods("GET")
 
 	rtr.HandleFunc(
 		fmt.Sprintf("/%s/%s", telemetrytypes.ModuleName, telemetrytypes.QueryTopValidators),
 		getTopValidatorsHandler(clientCtx),
 	).Methods("GET")
 
+	rtr.HandleFunc(
+		fmt.Sprintf("/%s/%s", telemetrytypes.ModuleName, telemetrytypes.QueryOracleRequests),
+		getOracleRequestsHandler(clientCtx),
+	).Methods("GET")
+
+	rtr.HandleFunc(
+		fmt.Sprintf("/%s/%s", telemetrytypes.ModuleName, telemetrytypes.QueryTopDataSources),
+		getTopDataSourcesHandler(clientCtx),
+	).Methods("GET")
+
+	rtr.HandleFunc(
+		fmt.Sprintf("/%s/%s", telemetrytypes.ModuleName, telemetrytypes.QueryReportParticipation),
+		getReportParticipationHandler(clientCtx),
+	).Methods("GET")
+
+	rtr.HandleFunc(
+		fmt.Sprintf("/%s/%s", telemetrytypes.ModuleName, telemetrytypes.QueryDataProviderRewards),
+		getDataProviderRewardsHandler(clientCtx),
+	).Methods("GET")
+
+	rtr.HandleFunc(
+		fmt.Sprintf("/%s/%s", telemetrytypes.ModuleName, telemetrytypes.QueryActiveAccounts),
+		getActiveAccountsHandler(clientCtx),
+	).Methods("GET")
+
+	rtr.HandleFunc(
+		fmt.Sprintf("/%s/%s", telemetrytypes.ModuleName, telemetrytypes.QueryAccountGrowth),
+		getAccountGrowthHandler(clientCtx),
+	).Methods("GET")
+
+	rtr.HandleFunc(
+		fmt.Sprintf("/%s/%s", telemetrytypes.ModuleName, telemetrytypes.QueryTopAccounts),
+		getTopAccountsHandler(clientCtx),
+	).Methods("GET")
+
+	rtr.HandleFunc(
+		fmt.Sprintf("/%s/%s/{%s}", telemetrytypes.ModuleName, telemetrytypes.QuerySupplyDistribution, telemetrytypes.DenomTag),
+		getSupplyDistributionHandler(clientCtx),
+	).Methods("GET")
+
+	rtr.HandleFunc(
+		fmt.Sprintf(
+			"/%s/%s/{%s}", telemetrytypes.ModuleName, telemetrytypes.QueryValidatorUptime, telemetrytypes.ValidatorAddressTag,
+		),
+		getValidatorUptimeHandler(clientCtx),
+	).Methods("GET")
+
+	rtr.HandleFunc(
+		fmt.Sprintf("/%s/%s", telemetrytypes.ModuleName, telemetrytypes.QueryTopValidatorsByUptime),
+		getTopValidatorsByUptimeHandler(clientCtx),
+	).Methods("GET")
+
 	/*rtr.HandleFunc(
 		fmt.Sprintf("/%s/%s", telemetrytypes.ModuleName, telemetrytypes.QueryValidatorBlocks),
 		getValidatorBlocksHandler(clientCtx),
@@ -124,11 +180,15 @@ func getAvgBlockSizeHandler(clientCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		var request telemetrytypes.QueryAvgBlockSizeRequest
-		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &request) {
+		startDate, endDate, granularity, ok := parseSeriesParams(w, r)
+		if !ok {
 			return
 		}
-		bin := clientCtx.LegacyAmino.MustMarshalJSON(request)
+		bin := clientCtx.LegacyAmino.MustMarshalJSON(telemetrytypes.QueryAvgBlockSizeRequest{
+			StartDate:   startDate,
+			EndDate:     endDate,
+			Granularity: granularity,
+		})
 
 		res, height, err := clientCtx.QueryWithData(
 			fmt.Sprintf("custom/%s/%s", telemetrytypes.QuerierRoute, telemetrytypes.QueryAvgBlockSize),
@@ -150,11 +210,15 @@ func getAvgBlockTimeHandler(clientCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		var request telemetrytypes.QueryAvgBlockTimeRequest
-		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &request) {
+		startDate, endDate, granularity, ok := parseSeriesParams(w, r)
+		if !ok {
 			return
 		}
-		bin := clientCtx.LegacyAmino.MustMarshalJSON(request)
+		bin := clientCtx.LegacyAmino.MustMarshalJSON(telemetrytypes.QueryAvgBlockTimeRequest{
+			StartDate:   startDate,
+			EndDate:     endDate,
+			Granularity: granularity,
+		})
 
 		res, height, err := clientCtx.QueryWithData(
 			fmt.Sprintf("custom/%s/%s", telemetrytypes.QuerierRoute, telemetrytypes.QueryAvgBlockTime),
@@ -176,11 +240,15 @@ func getAvgTxFeeHandler(clientCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		var request telemetrytypes.QueryAvgTxFeeRequest
-		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &request) {
+		startDate, endDate, granularity, ok := parseSeriesParams(w, r)
+		if !ok {
 			return
 		}
-		bin := clientCtx.LegacyAmino.MustMarshalJSON(request)
+		bin := clientCtx.LegacyAmino.MustMarshalJSON(telemetrytypes.QueryAvgTxFeeRequest{
+			StartDate:   startDate,
+			EndDate:     endDate,
+			Granularity: granularity,
+		})
 
 		res, height, err := clientCtx.QueryWithData(
 			fmt.Sprintf("custom/%s/%s", telemetrytypes.QuerierRoute, telemetrytypes.QueryAvgTxFee),
@@ -202,11 +270,15 @@ func getTxVolumeHandler(clientCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		var request telemetrytypes.QueryTxVolumeRequest
-		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &request) {
+		startDate, endDate, granularity, ok := parseSeriesParams(w, r)
+		if !ok {
 			return
 		}
-		bin := clientCtx.LegacyAmino.MustMarshalJSON(request)
+		bin := clientCtx.LegacyAmino.MustMarshalJSON(telemetrytypes.QueryTxVolumeRequest{
+			StartDate:   startDate,
+			EndDate:     endDate,
+			Granularity: granularity,
+		})
 
 		res, height, err := clientCtx.QueryWithData(
 			fmt.Sprintf("custom/%s/%s", telemetrytypes.QuerierRoute, telemetrytypes.QueryTxVolume),
@@ -221,6 +293,29 @@ func getTxVolumeHandler(clientCtx client.Context) http.HandlerFunc {
 	}
 }
 
+// parseSeriesParams reads the optional start date, end date and granularity of a telemetry series from the URL
+// query parameters.
+func parseSeriesParams(w http.ResponseWriter, r *http.Request) (*time.Time, *time.Time, telemetrytypes.Granularity, bool) {
+	query := r.URL.Query()
+	var dates [2]*time.Time
+	for i, tag := range []string{telemetrytypes.StartDateTag, telemetrytypes.EndDateTag} {
+		if value := query.Get(tag); value != "" {
+			date, err := telemetrytypes.ParseTime(value)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid %s: %s", tag, err))
+				return nil, nil, 0, false
+			}
+			dates[i] = &date
+		}
+	}
+	granularity, err := telemetrytypes.ParseGranularity(query.Get(telemetrytypes.GranularityTag))
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return nil, nil, 0, false
+	}
+	return dates[0], dates[1], granularity, true
+}
+
 func getTopValidatorsHandler(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
@@ -298,3 +393,307 @@ func getValidatorByConsAddressHandler(clientCtx client.Context) http.HandlerFunc
 		rest.PostProcessResponse(w, clientCtx, res)
 	}
 }
+
+func getOracleRequestsHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		startDate, endDate, granularity, ok := parseSeriesParams(w, r)
+		if !ok {
+			return
+		}
+		bin := clientCtx.LegacyAmino.MustMarshalJSON(telemetrytypes.QueryOracleRequestsRequest{
+			StartDate:   startDate,
+			EndDate:     endDate,
+			Granularity: granularity,
+		})
+
+		res, height, err := clientCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/%s", telemetrytypes.QuerierRoute, telemetrytypes.QueryOracleRequests),
+			bin,
+		)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+func getTopDataSourcesHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		var request telemetrytypes.QueryTopDataSourcesRequest
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &request) {
+			return
+		}
+		bin := clientCtx.LegacyAmino.MustMarshalJSON(request)
+
+		res, height, err := clientCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/%s", telemetrytypes.QuerierRoute, telemetrytypes.QueryTopDataSources),
+			bin,
+		)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+func getReportParticipationHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		var request telemetrytypes.QueryReportParticipationRequest
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &request) {
+			return
+		}
+		bin := clientCtx.LegacyAmino.MustMarshalJSON(request)
+
+		res, height, err := clientCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/%s", telemetrytypes.QuerierRoute, telemetrytypes.QueryReportParticipation),
+			bin,
+		)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+func getDataProviderRewardsHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		startDate, endDate, granularity, ok := parseSeriesParams(w, r)
+		if !ok {
+			return
+		}
+		bin := clientCtx.LegacyAmino.MustMarshalJSON(telemetrytypes.QueryDataProviderRewardsRequest{
+			StartDate:   startDate,
+			EndDate:     endDate,
+			Granularity: granularity,
+		})
+
+		res, height, err := clientCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/%s", telemetrytypes.QuerierRoute, telemetrytypes.QueryDataProviderRewards),
+			bin,
+		)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+func getActiveAccountsHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		startDate, endDate, granularity, ok := parseSeriesParams(w, r)
+		if !ok {
+			return
+		}
+		bin := clientCtx.LegacyAmino.MustMarshalJSON(telemetrytypes.QueryActiveAccountsRequest{
+			StartDate:   startDate,
+			EndDate:     endDate,
+			Granularity: granularity,
+		})
+
+		res, height, err := clientCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/%s", telemetrytypes.QuerierRoute, telemetrytypes.QueryActiveAccounts),
+			bin,
+		)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+func getAccountGrowthHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		startDate, endDate, granularity, ok := parseSeriesParams(w, r)
+		if !ok {
+			return
+		}
+		bin := clientCtx.LegacyAmino.MustMarshalJSON(telemetrytypes.QueryAccountGrowthRequest{
+			StartDate:   startDate,
+			EndDate:     endDate,
+			Granularity: granularity,
+		})
+
+		res, height, err := clientCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/%s", telemetrytypes.QuerierRoute, telemetrytypes.QueryAccountGrowth),
+			bin,
+		)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+func getTopAccountsHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		var request telemetrytypes.QueryTopAccountsRequest
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &request) {
+			return
+		}
+		bin := clientCtx.LegacyAmino.MustMarshalJSON(request)
+
+		res, height, err := clientCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/%s", telemetrytypes.QuerierRoute, telemetrytypes.QueryTopAccounts),
+			bin,
+		)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+func getSupplyDistributionHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		var request telemetrytypes.QuerySupplyDistributionRequest
+		query := r.URL.Query()
+		if value := query.Get(telemetrytypes.ThresholdsTag); value != "" {
+			for _, threshold := range strings.Split(value, ",") {
+				amount, ok := sdk.NewIntFromString(strings.TrimSpace(threshold))
+				if !ok {
+					rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid threshold: %s", threshold))
+					return
+				}
+				request.Thresholds = append(request.Thresholds, amount)
+			}
+		}
+		if value := query.Get(telemetrytypes.PercentilesTag); value != "" {
+			for _, percentile := range strings.Split(value, ",") {
+				p, err := strconv.ParseUint(strings.TrimSpace(percentile), 10, 32)
+				if err != nil {
+					rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid percentile: %s", percentile))
+					return
+				}
+				request.Percentiles = append(request.Percentiles, uint32(p))
+			}
+		}
+		bin := clientCtx.LegacyAmino.MustMarshalJSON(request)
+
+		vars := mux.Vars(r)
+
+		res, height, err := clientCtx.QueryWithData(fmt.Sprintf(
+			"custom/%s/%s/%s",
+			telemetrytypes.QuerierRoute,
+			telemetrytypes.QuerySupplyDistribution,
+			vars[telemetrytypes.DenomTag],
+		), bin)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+func getValidatorUptimeHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		startDate, endDate, granularity, ok := parseSeriesParams(w, r)
+		if !ok {
+			return
+		}
+		vars := mux.Vars(r)
+		bin := clientCtx.LegacyAmino.MustMarshalJSON(telemetrytypes.QueryValidatorUptimeRequest{
+			ValidatorAddress: vars[telemetrytypes.ValidatorAddressTag],
+			StartDate:        startDate,
+			EndDate:          endDate,
+			Granularity:      granularity,
+		})
+
+		res, height, err := clientCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/%s", telemetrytypes.QuerierRoute, telemetrytypes.QueryValidatorUptime),
+			bin,
+		)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+func getTopValidatorsByUptimeHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		var request telemetrytypes.QueryTopValidatorsByUptimeRequest
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &request) {
+			return
+		}
+		bin := clientCtx.LegacyAmino.MustMarshalJSON(request)
+
+		res, height, err := clientCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/%s", telemetrytypes.QuerierRoute, telemetrytypes.QueryTopValidatorsByUptime),
+			bin,
+		)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
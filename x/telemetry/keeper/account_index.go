@@ -0,0 +1,277 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"time"
+
+	telemetrytypes "github.com/GeoDB-Limited/odin-core/x/telemetry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// accountActivity is the accounts seen in a single block and the transactions they signed until it is committed.
+// An account is seen when it first receives coins or signs a transaction, which is when the chain creates it.
+type accountActivity struct {
+	seen map[string]struct{}
+	txs  map[string]uint64
+	// seeded is the accounts that existed before the block when the index is enabled on a running chain.
+	seeded map[string]struct{}
+}
+
+func newAccountActivity() accountActivity {
+	return accountActivity{
+		seen:   make(map[string]struct{}),
+		txs:    make(map[string]uint64),
+		seeded: make(map[string]struct{}),
+	}
+}
+
+// indexSigners adds the transaction to the signers of the block.
+func (i *Index) indexSigners(tx sdk.Tx) {
+	signers := make(map[string]struct{})
+	for _, msg := range tx.GetMsgs() {
+		for _, signer := range msg.GetSigners() {
+			signers[string(signer)] = struct{}{}
+		}
+	}
+	for signer := range signers {
+		i.block.accounts.seen[signer] = struct{}{}
+		i.block.accounts.txs[signer]++
+	}
+}
+
+// indexReceivers adds the accounts receiving coins in the events to the accounts seen in the block.
+func (i *Index) indexReceivers(events []abci.Event) {
+	if i.block == nil {
+		return
+	}
+	for _, event := range events {
+		if event.Type != banktypes.EventTypeCoinReceived {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) != banktypes.AttributeKeyReceiver {
+				continue
+			}
+			if addr, err := sdk.AccAddressFromBech32(string(attr.Value)); err == nil {
+				i.block.accounts.seen[string(addr)] = struct{}{}
+			}
+		}
+	}
+}
+
+// setAccountActivity records the accounts seen for the first time and adds the transactions of the block to its hour.
+// Seeded accounts are recorded as the baseline of the total accounts rather than as new accounts of the hour.
+func (i *Index) setAccountActivity(batch dbm.Batch, hour time.Time, activity accountActivity) error {
+	if len(activity.seeded) > 0 {
+		for addr := range activity.seeded {
+			if err := batch.Set(telemetrytypes.AccountKey(sdk.AccAddress(addr)), sdk.FormatTimeBytes(hour)); err != nil {
+				return err
+			}
+		}
+		seeded := sdk.Uint64ToBigEndian(uint64(len(activity.seeded)))
+		if err := batch.Set(telemetrytypes.SeededAccountsKey, seeded); err != nil {
+			return err
+		}
+	}
+	var newAccounts uint64
+	for addr := range activity.seen {
+		if _, ok := activity.seeded[addr]; ok {
+			continue
+		}
+		key := telemetrytypes.AccountKey(sdk.AccAddress(addr))
+		known, err := i.db.Has(key)
+		if err != nil {
+			return err
+		}
+		if known {
+			continue
+		}
+		if err := batch.Set(key, sdk.FormatTimeBytes(hour)); err != nil {
+			return err
+		}
+		newAccounts++
+	}
+	if newAccounts > 0 {
+		key := telemetrytypes.NewAccountsKey(hour)
+		bz, err := i.db.Get(key)
+		if err != nil {
+			return err
+		}
+		if bz != nil {
+			newAccounts += binary.BigEndian.Uint64(bz)
+		}
+		if err := batch.Set(key, sdk.Uint64ToBigEndian(newAccounts)); err != nil {
+			return err
+		}
+	}
+	for addr, txs := range activity.txs {
+		key := telemetrytypes.AccountTxsKey(hour, sdk.AccAddress(addr))
+		bz, err := i.db.Get(key)
+		if err != nil {
+			return err
+		}
+		if bz != nil {
+			txs += binary.BigEndian.Uint64(bz)
+		}
+		if err := batch.Set(key, sdk.Uint64ToBigEndian(txs)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// indexGenesisAccounts records the accounts of the genesis state as created at the genesis time.
+func (i *Index) indexGenesisAccounts(ctx sdk.Context, genesisTime time.Time) error {
+	activity := newAccountActivity()
+	i.accountKeeper.IterateAccounts(ctx, func(account authtypes.AccountI) bool {
+		activity.seen[string(account.GetAddress())] = struct{}{}
+		return false
+	})
+	batch := i.db.NewBatch()
+	defer batch.Close()
+	if err := i.setAccountActivity(batch, telemetrytypes.GRANULARITY_HOUR.Truncate(genesisTime), activity); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// seedAccounts adds the existing accounts to the accounts seeded in the block when the index has no accounts yet,
+// which is the case when the index is enabled on a running chain.
+func (i *Index) seedAccounts(ctx sdk.Context, activity accountActivity) error {
+	if i.accountsSeeded {
+		return nil
+	}
+	it, err := i.db.Iterator(telemetrytypes.AccountKeyPrefix, sdk.PrefixEndBytes(telemetrytypes.AccountKeyPrefix))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	if err := it.Error(); err != nil {
+		return err
+	}
+	if !it.Valid() {
+		i.accountKeeper.IterateAccounts(ctx, func(account authtypes.AccountI) bool {
+			activity.seeded[string(account.GetAddress())] = struct{}{}
+			return false
+		})
+	}
+	i.accountsSeeded = true
+	return nil
+}
+
+// GetActiveAccounts returns the number of distinct accounts that signed transactions in the time buckets of the
+// given granularity between the buckets containing startDate and endDate inclusive, sorted by time.
+func (i *Index) GetActiveAccounts(
+	startDate, endDate *time.Time,
+	granularity telemetrytypes.Granularity,
+) ([]telemetrytypes.ActiveAccounts, error) {
+	start, end, err := dateRange(telemetrytypes.AccountTxsKeyPrefix, startDate, endDate, granularity)
+	if err != nil {
+		return nil, err
+	}
+	it, err := i.db.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	dateLen := len(sdk.FormatTimeBytes(time.Time{}))
+	var active []telemetrytypes.ActiveAccounts
+	var accounts map[string]struct{}
+	for ; it.Valid(); it.Next() {
+		hour, err := keyHour(telemetrytypes.AccountTxsKeyPrefix, it.Key())
+		if err != nil {
+			return nil, err
+		}
+		// Hours come sorted, so an hour either falls in the last bucket or starts a new one.
+		start := granularity.Truncate(hour)
+		if len(active) == 0 || !active[len(active)-1].Start.Equal(start) {
+			active = append(active, telemetrytypes.ActiveAccounts{Start: start})
+			accounts = make(map[string]struct{})
+		}
+		addr := string(it.Key()[len(telemetrytypes.AccountTxsKeyPrefix)+dateLen:])
+		if _, ok := accounts[addr]; !ok {
+			accounts[addr] = struct{}{}
+			active[len(active)-1].AccountsCount++
+		}
+	}
+	return active, it.Error()
+}
+
+// GetAccountGrowth returns the number of accounts created in the time buckets of the given granularity between the
+// buckets containing startDate and endDate inclusive along with the total number of accounts at the end of each
+// bucket, sorted by time. The totals include the accounts seeded when the index was enabled on a running chain.
+func (i *Index) GetAccountGrowth(
+	startDate, endDate *time.Time,
+	granularity telemetrytypes.Granularity,
+) ([]telemetrytypes.AccountGrowth, error) {
+	_, end, err := dateRange(telemetrytypes.NewAccountsKeyPrefix, startDate, endDate, granularity)
+	if err != nil {
+		return nil, err
+	}
+	// The totals count the accounts created before the start date too.
+	it, err := i.db.Iterator(telemetrytypes.NewAccountsKeyPrefix, end)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var first time.Time
+	if startDate != nil {
+		first = granularity.Truncate(*startDate)
+	}
+	var total uint64
+	bz, err := i.db.Get(telemetrytypes.SeededAccountsKey)
+	if err != nil {
+		return nil, err
+	}
+	if bz != nil {
+		total = binary.BigEndian.Uint64(bz)
+	}
+	var growth []telemetrytypes.AccountGrowth
+	for ; it.Valid(); it.Next() {
+		hour, err := keyHour(telemetrytypes.NewAccountsKeyPrefix, it.Key())
+		if err != nil {
+			return nil, err
+		}
+		newAccounts := binary.BigEndian.Uint64(it.Value())
+		total += newAccounts
+		start := granularity.Truncate(hour)
+		if start.Before(first) {
+			continue
+		}
+		if len(growth) == 0 || !growth[len(growth)-1].Start.Equal(start) {
+			growth = append(growth, telemetrytypes.AccountGrowth{Start: start})
+		}
+		bucket := &growth[len(growth)-1]
+		bucket.NewAccounts += newAccounts
+		bucket.TotalAccounts = total
+	}
+	return growth, it.Error()
+}
+
+// GetAccountsTxs returns the number of transactions signed by each account between startDate and endDate inclusive,
+// keyed by address.
+func (i *Index) GetAccountsTxs(startDate, endDate *time.Time) (map[string]uint64, error) {
+	start, end, err := dateRange(telemetrytypes.AccountTxsKeyPrefix, startDate, endDate, telemetrytypes.GRANULARITY_DAY)
+	if err != nil {
+		return nil, err
+	}
+	it, err := i.db.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	dateLen := len(sdk.FormatTimeBytes(time.Time{}))
+	txs := make(map[string]uint64)
+	for ; it.Valid(); it.Next() {
+		addr := string(it.Key()[len(telemetrytypes.AccountTxsKeyPrefix)+dateLen:])
+		txs[addr] += binary.BigEndian.Uint64(it.Value())
+	}
+	return txs, it.Error()
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/tendermint/tendermint/rpc/core"
 	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
 	tendermint "github.com/tendermint/tendermint/types"
-	"time"
 )
 
 const (
@@ -17,59 +16,6 @@ const (
 	OrderByAsc     = "asc"
 )
 
-func (k Keeper) GetBlocksByDates(startDate, endDate *time.Time) (map[time.Time][]*tendermint.Block, error) {
-	if startDate != nil && endDate != nil {
-		if ok := startDate.Before(*endDate); !ok {
-			return nil, sdkerrors.Wrapf(telemetrytypes.ErrInvalidDateInterval, "invalid dates order")
-		}
-	}
-
-	blocksPerDay := make(map[time.Time][]*tendermint.Block, 0)
-	maxBlocksPerPage := MaxCountPerPage
-	page := 1
-	blocksParsed := 0
-
-	for {
-		blocks, err := core.BlockSearch(
-			&rpctypes.Context{},
-			AllBlocksQuery,
-			&page,
-			&maxBlocksPerPage,
-			OrderByAsc,
-		)
-		if err != nil {
-			return nil, sdkerrors.Wrap(err, "failed to find the blocks")
-		}
-
-		for _, r := range blocks.Blocks {
-			blockDate := telemetrytypes.TimeToUTCDate(r.Block.Header.Time)
-
-			if startDate != nil {
-				if !blockDate.Equal(*startDate) && !blockDate.After(*startDate) {
-					continue
-				}
-			}
-
-			if endDate != nil {
-				if !blockDate.Equal(*endDate) && !blockDate.Before(*endDate) {
-					continue
-				}
-			}
-
-			blocksPerDay[blockDate] = append(blocksPerDay[blockDate], r.Block)
-		}
-
-		blocksParsed += len(blocks.Blocks)
-		page++
-
-		if blocks.TotalCount == blocksParsed {
-			break
-		}
-	}
-
-	return blocksPerDay, nil
-}
-
 func (k Keeper) GetBlockValidators(blockHeight int64) ([]tendermint.Validator, error) {
 	var validators []tendermint.Validator
 	maxValidatorsPerPage := MaxCountPerPage
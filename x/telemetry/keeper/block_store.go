@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	telemetrytypes "github.com/GeoDB-Limited/odin-core/x/telemetry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/rpc/core"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	tendermint "github.com/tendermint/tendermint/types"
+)
+
+const (
+	// backfillChunkSize is the number of blocks backfilled into the index in a single batch.
+	backfillChunkSize = 1000
+	// backfillRetryInterval is the delay before backfilling again after a failure, e.g. while the node starts.
+	backfillRetryInterval = time.Minute
+)
+
+// BlockStore reads the blocks committed by the node. The telemetry index backfills the block statistics of the
+// heights it did not process from it, such as the blocks committed before the index was enabled.
+type BlockStore interface {
+	// Heights returns the lowest and the latest heights available in the store.
+	Heights() (int64, int64, error)
+	LoadBlock(height int64) (*tendermint.Block, error)
+	// LoadValidators returns the consensus addresses of the validator set of the given height, in the order of the
+	// signatures of its commit.
+	LoadValidators(height int64) ([]sdk.ConsAddress, error)
+}
+
+// NodeBlockStore reads the blocks through the RPC environment of the node the app runs in.
+type NodeBlockStore struct{}
+
+var _ BlockStore = NodeBlockStore{}
+
+// recoverNodeNotReady turns the panic of a call made before the node set up its RPC environment, such as while
+// blocks are replayed on start, into an error.
+func recoverNodeNotReady(err *error) {
+	if r := recover(); r != nil {
+		*err = fmt.Errorf("node RPC is not ready: %v", r)
+	}
+}
+
+// Heights implements BlockStore.
+func (NodeBlockStore) Heights() (base int64, height int64, err error) {
+	defer recoverNodeNotReady(&err)
+	status, err := core.Status(&rpctypes.Context{})
+	if err != nil {
+		return 0, 0, err
+	}
+	return status.SyncInfo.EarliestBlockHeight, status.SyncInfo.LatestBlockHeight, nil
+}
+
+// LoadBlock implements BlockStore.
+func (NodeBlockStore) LoadBlock(height int64) (block *tendermint.Block, err error) {
+	defer recoverNodeNotReady(&err)
+	res, err := core.Block(&rpctypes.Context{}, &height)
+	if err != nil {
+		return nil, err
+	}
+	return res.Block, nil
+}
+
+// LoadValidators implements BlockStore.
+func (NodeBlockStore) LoadValidators(height int64) (addrs []sdk.ConsAddress, err error) {
+	defer recoverNodeNotReady(&err)
+	perPage := 100
+	for page := 1; ; page++ {
+		res, err := core.Validators(&rpctypes.Context{}, &height, &page, &perPage)
+		if err != nil {
+			return nil, err
+		}
+		for _, val := range res.Validators {
+			addrs = append(addrs, sdk.ConsAddress(val.Address))
+		}
+		if len(res.Validators) == 0 || len(addrs) >= res.Total {
+			return addrs, nil
+		}
+	}
+}
+
+// heightRange is a range of heights missing from the index, inclusive, followed by an indexed run.
+type heightRange struct {
+	from, to int64
+	// preceded is set if the block before the range is indexed.
+	preceded bool
+}
+
+// storeHour is the statistics of the blocks of an hour read from the block store.
+type storeHour struct {
+	stats telemetrytypes.BlockStats
+	// uptime is the number of blocks signed and missed by each validator, keyed by consensus address.
+	uptime map[string]validatorUptime
+}
+
+// scanBlockStore reads the statistics of the blocks between from and to inclusive, along with the interval of the
+// block following them, which starts an indexed run. The interval of the first block is only read if firstInterval
+// is set, since it is otherwise read along with the blocks before it. It returns the time of the first block.
+func scanBlockStore(
+	store BlockStore,
+	txDecoder sdk.TxDecoder,
+	from, to int64,
+	firstInterval bool,
+) (map[time.Time]*storeHour, time.Time, error) {
+	hours := make(map[time.Time]*storeHour)
+	hourOf := func(blockTime time.Time) *storeHour {
+		hour := telemetrytypes.GRANULARITY_HOUR.Truncate(blockTime)
+		stats, ok := hours[hour]
+		if !ok {
+			stats = &storeHour{
+				stats:  telemetrytypes.BlockStats{Start: hour, TxsFee: sdk.NewCoins()},
+				uptime: make(map[string]validatorUptime),
+			}
+			hours[hour] = stats
+		}
+		return stats
+	}
+
+	var prevTime, fromTime time.Time
+	if firstInterval {
+		prev, err := store.LoadBlock(from - 1)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		prevTime = prev.Time
+	}
+	for height := from; height <= to+1; height++ {
+		block, err := store.LoadBlock(height)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		if height == from {
+			fromTime = block.Time
+		}
+		stats := hourOf(block.Time)
+		if !prevTime.IsZero() && block.Time.After(prevTime) {
+			stats.stats.BlocksInterval += block.Time.Sub(prevTime)
+		}
+		prevTime = block.Time
+		if height > to {
+			break
+		}
+		stats.stats.BlocksCount++
+		stats.stats.BlocksBytes += uint64(block.Header.ToProto().Size())
+		for _, txBytes := range block.Txs {
+			stats.stats.BlocksBytes += uint64(len(txBytes))
+			stats.stats.TxsCount++
+			tx, err := txDecoder(txBytes)
+			if err != nil {
+				continue
+			}
+			if feeTx, ok := tx.(sdk.FeeTx); ok {
+				stats.stats.TxsFee = stats.stats.TxsFee.Add(feeTx.GetFee()...)
+			}
+		}
+		if err := stats.addCommit(store, block); err != nil {
+			return nil, time.Time{}, err
+		}
+	}
+	return hours, fromTime, nil
+}
+
+// addCommit adds the validators that signed and missed the last commit of the block. Absent signatures carry no
+// address, so validators that missed it are read from the validator set of the previous height.
+func (h *storeHour) addCommit(store BlockStore, block *tendermint.Block) error {
+	if block.LastCommit == nil {
+		return nil
+	}
+	var validators []sdk.ConsAddress
+	for idx, sig := range block.LastCommit.Signatures {
+		if !sig.Absent() {
+			uptime := h.uptime[string(sig.ValidatorAddress)]
+			uptime.signed++
+			h.uptime[string(sig.ValidatorAddress)] = uptime
+			continue
+		}
+		if validators == nil {
+			var err error
+			validators, err = store.LoadValidators(block.Height - 1)
+			if err != nil {
+				return err
+			}
+		}
+		if idx >= len(validators) {
+			return fmt.Errorf("no validator of commit signature %d at height %d", idx, block.Height-1)
+		}
+		uptime := h.uptime[string(validators[idx])]
+		uptime.missed++
+		h.uptime[string(validators[idx])] = uptime
+	}
+	return nil
+}
@@ -52,13 +52,13 @@ func (k Keeper) AvgBlockSize(
 	request *telemetrytypes.QueryAvgBlockSizeRequest,
 ) (*telemetrytypes.QueryAvgBlockSizeResponse, error) {
 
-	blockSizePerDay, err := k.GetAvgBlockSizePerDay(request.GetStartDate(), request.GetEndDate())
+	avgBlockSize, err := k.GetAvgBlockSize(request.GetStartDate(), request.GetEndDate(), request.GetGranularity())
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed to get average block size per day")
+		return nil, sdkerrors.Wrap(err, "failed to get average block size")
 	}
 
 	return &telemetrytypes.QueryAvgBlockSizeResponse{
-		AvgBlockSizePerDay: blockSizePerDay,
+		AvgBlockSize: avgBlockSize,
 	}, nil
 }
 
@@ -67,13 +67,13 @@ func (k Keeper) AvgBlockTime(
 	request *telemetrytypes.QueryAvgBlockTimeRequest,
 ) (*telemetrytypes.QueryAvgBlockTimeResponse, error) {
 
-	blockTimePerDay, err := k.GetAvgBlockTimePerDay(request.GetStartDate(), request.GetEndDate())
+	avgBlockTime, err := k.GetAvgBlockTime(request.GetStartDate(), request.GetEndDate(), request.GetGranularity())
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed to get average block time per day")
+		return nil, sdkerrors.Wrap(err, "failed to get average block time")
 	}
 
 	return &telemetrytypes.QueryAvgBlockTimeResponse{
-		AvgBlockTimePerDay: blockTimePerDay,
+		AvgBlockTime: avgBlockTime,
 	}, nil
 }
 
@@ -82,13 +82,13 @@ func (k Keeper) AvgTxFee(
 	request *telemetrytypes.QueryAvgTxFeeRequest,
 ) (*telemetrytypes.QueryAvgTxFeeResponse, error) {
 
-	avgTxFee, err := k.GetAvgTxFeePerDay(request.GetStartDate(), request.GetEndDate())
+	avgTxFee, err := k.GetAvgTxFee(request.GetStartDate(), request.GetEndDate(), request.GetGranularity())
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed to get average tx fee per day")
+		return nil, sdkerrors.Wrap(err, "failed to get average tx fee")
 	}
 
 	return &telemetrytypes.QueryAvgTxFeeResponse{
-		AvgTxFeePerDay: avgTxFee,
+		AvgTxFee: avgTxFee,
 	}, nil
 }
 
@@ -97,13 +97,13 @@ func (k Keeper) TxVolume(
 	request *telemetrytypes.QueryTxVolumeRequest,
 ) (*telemetrytypes.QueryTxVolumeResponse, error) {
 
-	txVolume, err := k.GetTxVolumePerDay(request.GetStartDate(), request.GetEndDate())
+	txVolume, err := k.GetTxVolume(request.GetStartDate(), request.GetEndDate(), request.GetGranularity())
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to get tx volume")
 	}
 
 	return &telemetrytypes.QueryTxVolumeResponse{
-		TxVolumePerDay: txVolume,
+		TxVolume: txVolume,
 	}, nil
 }
 
@@ -183,3 +183,208 @@ func (k Keeper) TopValidators(
 		},
 	}, nil
 }
+
+func (k Keeper) OracleRequests(
+	_ context.Context,
+	request *telemetrytypes.QueryOracleRequestsRequest,
+) (*telemetrytypes.QueryOracleRequestsResponse, error) {
+
+	oracleRequests, err := k.GetOracleRequests(request.GetStartDate(), request.GetEndDate(), request.GetGranularity())
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get oracle requests")
+	}
+
+	return &telemetrytypes.QueryOracleRequestsResponse{
+		OracleRequests: oracleRequests,
+	}, nil
+}
+
+func (k Keeper) TopDataSources(
+	_ context.Context,
+	request *telemetrytypes.QueryTopDataSourcesRequest,
+) (*telemetrytypes.QueryTopDataSourcesResponse, error) {
+
+	dataSources, total, err := k.GetTopDataSources(
+		request.GetStartDate(),
+		request.GetEndDate(),
+		request.GetDesc(),
+		request.GetPagination(),
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get top data sources")
+	}
+
+	return &telemetrytypes.QueryTopDataSourcesResponse{
+		DataSources: dataSources,
+		Pagination: &query.PageResponse{
+			Total: total,
+		},
+	}, nil
+}
+
+func (k Keeper) ReportParticipation(
+	_ context.Context,
+	request *telemetrytypes.QueryReportParticipationRequest,
+) (*telemetrytypes.QueryReportParticipationResponse, error) {
+
+	validators, total, err := k.GetReportParticipation(
+		request.GetStartDate(),
+		request.GetEndDate(),
+		request.GetDesc(),
+		request.GetPagination(),
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get report participation")
+	}
+
+	return &telemetrytypes.QueryReportParticipationResponse{
+		Validators: validators,
+		Pagination: &query.PageResponse{
+			Total: total,
+		},
+	}, nil
+}
+
+func (k Keeper) DataProviderRewards(
+	_ context.Context,
+	request *telemetrytypes.QueryDataProviderRewardsRequest,
+) (*telemetrytypes.QueryDataProviderRewardsResponse, error) {
+
+	rewards, err := k.GetDataProviderRewards(request.GetStartDate(), request.GetEndDate(), request.GetGranularity())
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get data provider rewards")
+	}
+
+	return &telemetrytypes.QueryDataProviderRewardsResponse{
+		DataProviderRewards: rewards,
+	}, nil
+}
+
+func (k Keeper) ActiveAccounts(
+	_ context.Context,
+	request *telemetrytypes.QueryActiveAccountsRequest,
+) (*telemetrytypes.QueryActiveAccountsResponse, error) {
+
+	activeAccounts, err := k.GetActiveAccounts(request.GetStartDate(), request.GetEndDate(), request.GetGranularity())
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get active accounts")
+	}
+
+	return &telemetrytypes.QueryActiveAccountsResponse{
+		ActiveAccounts: activeAccounts,
+	}, nil
+}
+
+func (k Keeper) AccountGrowth(
+	_ context.Context,
+	request *telemetrytypes.QueryAccountGrowthRequest,
+) (*telemetrytypes.QueryAccountGrowthResponse, error) {
+
+	accountGrowth, err := k.GetAccountGrowth(request.GetStartDate(), request.GetEndDate(), request.GetGranularity())
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get account growth")
+	}
+
+	return &telemetrytypes.QueryAccountGrowthResponse{
+		AccountGrowth: accountGrowth,
+	}, nil
+}
+
+func (k Keeper) TopAccounts(
+	_ context.Context,
+	request *telemetrytypes.QueryTopAccountsRequest,
+) (*telemetrytypes.QueryTopAccountsResponse, error) {
+
+	accounts, total, err := k.GetTopAccounts(
+		request.GetStartDate(),
+		request.GetEndDate(),
+		request.GetDesc(),
+		request.GetPagination(),
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get top accounts")
+	}
+
+	return &telemetrytypes.QueryTopAccountsResponse{
+		Accounts: accounts,
+		Pagination: &query.PageResponse{
+			Total: total,
+		},
+	}, nil
+}
+
+func (k Keeper) SupplyDistribution(
+	_ context.Context,
+	request *telemetrytypes.QuerySupplyDistributionRequest,
+) (*telemetrytypes.QuerySupplyDistributionResponse, error) {
+
+	if request.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom cannot be empty")
+	}
+
+	distribution, err := k.GetSupplyDistribution(request.Denom, request.Thresholds, request.Percentiles)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get supply distribution")
+	}
+
+	return &telemetrytypes.QuerySupplyDistributionResponse{
+		SupplyDistribution: distribution,
+	}, nil
+}
+
+func (k Keeper) ValidatorUptime(
+	c context.Context,
+	request *telemetrytypes.QueryValidatorUptimeRequest,
+) (*telemetrytypes.QueryValidatorUptimeResponse, error) {
+
+	if request.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	address, err := sdk.ValAddressFromBech32(request.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	uptime, changes, err := k.GetValidatorUptime(
+		ctx,
+		address,
+		request.GetStartDate(),
+		request.GetEndDate(),
+		request.GetGranularity(),
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get validator uptime")
+	}
+
+	return &telemetrytypes.QueryValidatorUptimeResponse{
+		Uptime:              uptime,
+		OracleStatusChanges: changes,
+	}, nil
+}
+
+func (k Keeper) TopValidatorsByUptime(
+	c context.Context,
+	request *telemetrytypes.QueryTopValidatorsByUptimeRequest,
+) (*telemetrytypes.QueryTopValidatorsByUptimeResponse, error) {
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validators, total, err := k.GetTopValidatorsByUptime(
+		ctx,
+		request.GetStartDate(),
+		request.GetEndDate(),
+		request.GetDesc(),
+		request.GetPagination(),
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get top validators by uptime")
+	}
+
+	return &telemetrytypes.QueryTopValidatorsByUptimeResponse{
+		Validators: validators,
+		Pagination: &query.PageResponse{
+			Total: total,
+		},
+	}, nil
+}
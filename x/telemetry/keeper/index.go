@@ -0,0 +1,702 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
+	telemetrytypes "github.com/GeoDB-Limited/odin-core/x/telemetry/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Index keeps the block, validator, oracle and account statistics aggregated per hour on disk along with a periodic snapshot of
+// the supply. It is updated as an app hook while blocks are processed, so queries roll up the hourly buckets instead
+// of scanning the whole chain. Supply snapshots are taken in the background from the committed state, keeping the
+// scan of all balances off the block processing. The index records the runs of consecutive blocks it covers, and the
+// block statistics of the heights missing between them, such as the blocks committed before the index was enabled,
+// are backfilled from the block store in the background.
+type Index struct {
+	cdc           codec.BinaryCodec
+	db            dbm.DB
+	blocks        BlockStore
+	txDecoder     sdk.TxDecoder
+	oracleKeeper  telemetrytypes.OracleKeeper
+	accountKeeper telemetrytypes.AccountKeeper
+	bankKeeper    telemetrytypes.BankKeeper
+	mintKeeper    telemetrytypes.MintKeeper
+	distrKeeper   telemetrytypes.DistrKeeper
+	queryContext  QueryContextFunc
+	logger        log.Logger
+
+	// block is the block being processed, nil if the block is already indexed.
+	block *indexedBlock
+	// supplySnapshotted is set once a supply snapshot is known to be stored.
+	supplySnapshotted bool
+	// accountsSeeded is set once accounts are known to be recorded.
+	accountsSeeded bool
+	// snapshotHeight and snapshotTime are the block whose supply is to be snapshotted once committed, zero if none.
+	snapshotHeight int64
+	snapshotTime   time.Time
+	// snapshotDone is closed once the last supply snapshot job is done, nil if none was started.
+	snapshotDone chan struct{}
+	// committed is set once a block is indexed since the index was opened.
+	committed bool
+
+	// mtx serializes the writes of the indexed blocks and the backfilled ones.
+	mtx sync.Mutex
+	// quit is closed once the index is closed.
+	quit chan struct{}
+	// backfillWake wakes the backfill up to look for missing blocks.
+	backfillWake chan struct{}
+	// backfillDone is closed once the backfill is stopped, nil if it was not started.
+	backfillDone chan struct{}
+}
+
+// QueryContextFunc returns a context reading the state committed at the given height.
+type QueryContextFunc func(height int64) (sdk.Context, error)
+
+// indexedBlock is the statistics of a single block until it is committed.
+type indexedBlock struct {
+	height     int64
+	time       time.Time
+	bytes      uint64
+	txsCount   uint64
+	txsFee     sdk.Coins
+	validators []sdk.ConsAddress
+	missed     []sdk.ConsAddress
+	oracle     oracleActivity
+	accounts   accountActivity
+	// snapshotSupply is set if the supply is to be snapshotted at the end of the block.
+	snapshotSupply bool
+	// oracleStatuses is the oracle status of every validator before the block, only set for the first block indexed.
+	oracleStatuses map[string]oracletypes.ValidatorStatus
+}
+
+// NewIndex creates the telemetry index stored in db. The blocks missing from the index are backfilled from the block
+// store, which may be nil to leave them out. Supply snapshots read the committed state through queryContext.
+func NewIndex(
+	cdc codec.BinaryCodec,
+	db dbm.DB,
+	blocks BlockStore,
+	txDecoder sdk.TxDecoder,
+	oracleKeeper telemetrytypes.OracleKeeper,
+	accountKeeper telemetrytypes.AccountKeeper,
+	bankKeeper telemetrytypes.BankKeeper,
+	mintKeeper telemetrytypes.MintKeeper,
+	distrKeeper telemetrytypes.DistrKeeper,
+	queryContext QueryContextFunc,
+	logger log.Logger,
+) *Index {
+	i := &Index{
+		cdc:           cdc,
+		db:            db,
+		blocks:        blocks,
+		txDecoder:     txDecoder,
+		oracleKeeper:  oracleKeeper,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		mintKeeper:    mintKeeper,
+		distrKeeper:   distrKeeper,
+		queryContext:  queryContext,
+		logger:        logger,
+		quit:          make(chan struct{}),
+		backfillWake:  make(chan struct{}, 1),
+	}
+	if blocks != nil {
+		i.backfillDone = make(chan struct{})
+		go i.backfill()
+	}
+	return i
+}
+
+// lastBlock returns the height and time of the last indexed block, or zero values if nothing is indexed yet.
+func (i *Index) lastBlock() (int64, time.Time, error) {
+	bz, err := i.db.Get(telemetrytypes.IndexStateKey)
+	if err != nil || bz == nil {
+		return 0, time.Time{}, err
+	}
+	if len(bz) != 16 {
+		return 0, time.Time{}, fmt.Errorf("invalid telemetry index state")
+	}
+	height := int64(binary.BigEndian.Uint64(bz[:8]))
+	blockTime := time.Unix(0, int64(binary.BigEndian.Uint64(bz[8:]))).UTC()
+	return height, blockTime, nil
+}
+
+func indexState(height int64, blockTime time.Time) []byte {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz[:8], uint64(height))
+	binary.BigEndian.PutUint64(bz[8:], uint64(blockTime.UnixNano()))
+	return bz
+}
+
+// Close stops the backfill and closes the database of the index.
+func (i *Index) Close() error {
+	close(i.quit)
+	if i.backfillDone != nil {
+		<-i.backfillDone
+	}
+	if i.snapshotDone != nil {
+		<-i.snapshotDone
+	}
+	return i.db.Close()
+}
+
+// indexRun is a run of consecutive blocks processed by the index.
+type indexRun struct {
+	startHeight, endHeight int64
+	startTime, endTime     time.Time
+}
+
+func (r indexRun) value() []byte {
+	bz := make([]byte, 24)
+	binary.BigEndian.PutUint64(bz[:8], uint64(r.endHeight))
+	binary.BigEndian.PutUint64(bz[8:16], uint64(r.startTime.UnixNano()))
+	binary.BigEndian.PutUint64(bz[16:], uint64(r.endTime.UnixNano()))
+	return bz
+}
+
+func parseIndexRun(key, value []byte) (indexRun, error) {
+	if len(key) != len(telemetrytypes.IndexRunKeyPrefix)+8 || len(value) != 24 {
+		return indexRun{}, fmt.Errorf("invalid telemetry index run")
+	}
+	return indexRun{
+		startHeight: int64(binary.BigEndian.Uint64(key[len(telemetrytypes.IndexRunKeyPrefix):])),
+		endHeight:   int64(binary.BigEndian.Uint64(value[:8])),
+		startTime:   time.Unix(0, int64(binary.BigEndian.Uint64(value[8:16]))).UTC(),
+		endTime:     time.Unix(0, int64(binary.BigEndian.Uint64(value[16:]))).UTC(),
+	}, nil
+}
+
+// runs returns the runs of consecutive indexed blocks sorted by height.
+func (i *Index) runs() ([]indexRun, error) {
+	it, err := dbm.IteratePrefix(i.db, telemetrytypes.IndexRunKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	var runs []indexRun
+	for ; it.Valid(); it.Next() {
+		run, err := parseIndexRun(it.Key(), it.Value())
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+	return runs, it.Error()
+}
+
+// lastRun returns the run of the last indexed block, false if nothing is indexed yet.
+func (i *Index) lastRun() (indexRun, bool, error) {
+	it, err := i.db.ReverseIterator(telemetrytypes.IndexRunKeyPrefix, sdk.PrefixEndBytes(telemetrytypes.IndexRunKeyPrefix))
+	if err != nil {
+		return indexRun{}, false, err
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return indexRun{}, false, it.Error()
+	}
+	run, err := parseIndexRun(it.Key(), it.Value())
+	return run, err == nil, err
+}
+
+// runBefore returns the last run starting before the given height.
+func (i *Index) runBefore(height int64) (indexRun, error) {
+	it, err := i.db.ReverseIterator(telemetrytypes.IndexRunKeyPrefix, telemetrytypes.IndexRunKey(height))
+	if err != nil {
+		return indexRun{}, err
+	}
+	defer it.Close()
+	if !it.Valid() {
+		if err := it.Error(); err != nil {
+			return indexRun{}, err
+		}
+		return indexRun{}, fmt.Errorf("no telemetry index run before height %d", height)
+	}
+	return parseIndexRun(it.Key(), it.Value())
+}
+
+// missingRanges returns the height ranges of the block store missing from the index that are followed by indexed
+// blocks, sorted by height. The blocks after the last indexed one are left to the index.
+func (i *Index) missingRanges() ([]heightRange, error) {
+	runs, err := i.runs()
+	if err != nil || len(runs) == 0 {
+		return nil, err
+	}
+	base, _, err := i.blocks.Heights()
+	if err != nil {
+		return nil, err
+	}
+	if base < 1 {
+		base = 1
+	}
+	var ranges []heightRange
+	next := heightRange{from: base}
+	for _, run := range runs {
+		if run.startHeight > next.from {
+			next.to = run.startHeight - 1
+			ranges = append(ranges, next)
+		}
+		next = heightRange{from: run.endHeight + 1, preceded: true}
+		if next.from < base {
+			next = heightRange{from: base}
+		}
+	}
+	return ranges, nil
+}
+
+// backfill backfills the blocks missing from the index each time it is woken up, until the index is closed.
+func (i *Index) backfill() {
+	defer close(i.backfillDone)
+	for {
+		select {
+		case <-i.quit:
+			return
+		case <-i.backfillWake:
+		}
+		for {
+			done, err := i.backfillChunk()
+			if err != nil {
+				i.logger.Error("Failed to backfill the telemetry index", "err", err)
+				select {
+				case <-i.quit:
+					return
+				case <-time.After(backfillRetryInterval):
+				}
+				continue
+			}
+			if done {
+				break
+			}
+			select {
+			case <-i.quit:
+				return
+			default:
+			}
+		}
+	}
+}
+
+// wakeBackfill wakes the backfill up unless it is already due to look for missing blocks.
+func (i *Index) wakeBackfill() {
+	select {
+	case i.backfillWake <- struct{}{}:
+	default:
+	}
+}
+
+// backfillChunk backfills up to backfillChunkSize blocks at the end of the last range missing from the index, and
+// reports whether no range is left.
+func (i *Index) backfillChunk() (bool, error) {
+	ranges, err := i.missingRanges()
+	if err != nil {
+		return false, err
+	}
+	if len(ranges) == 0 {
+		return true, nil
+	}
+	r := ranges[len(ranges)-1]
+	from := r.from
+	if r.to-backfillChunkSize+1 > from {
+		from = r.to - backfillChunkSize + 1
+	}
+	// Only the first block of the range follows an indexed one, the interval of other blocks is read with the chunk
+	// before them.
+	joinPrevious := r.preceded && from == r.from
+	hours, fromTime, err := scanBlockStore(i.blocks, i.txDecoder, from, r.to, joinPrevious)
+	if err != nil {
+		return false, err
+	}
+	return false, i.writeBackfill(from, r.to, fromTime, joinPrevious, hours)
+}
+
+// writeBackfill adds the statistics of the blocks between from and to inclusive read from the block store to the
+// index, and joins them to the run following them, along with the run preceding them if joinPrevious is set.
+func (i *Index) writeBackfill(
+	from, to int64,
+	fromTime time.Time,
+	joinPrevious bool,
+	hours map[time.Time]*storeHour,
+) error {
+	i.mtx.Lock()
+	defer i.mtx.Unlock()
+
+	// The following run may have grown since the blocks were read.
+	nextKey := telemetrytypes.IndexRunKey(to + 1)
+	bz, err := i.db.Get(nextKey)
+	if err != nil {
+		return err
+	}
+	next, err := parseIndexRun(nextKey, bz)
+	if err != nil {
+		return err
+	}
+	run := indexRun{startHeight: from, startTime: fromTime}
+	if joinPrevious {
+		if run, err = i.runBefore(from); err != nil {
+			return err
+		}
+		if run.endHeight != from-1 {
+			return fmt.Errorf("telemetry index run %d does not end at height %d", run.startHeight, from-1)
+		}
+	}
+	run.endHeight, run.endTime = next.endHeight, next.endTime
+
+	batch := i.db.NewBatch()
+	defer batch.Close()
+	for hour, hourly := range hours {
+		stats := telemetrytypes.BlockStats{Start: hour}
+		if err := i.get(telemetrytypes.BlockStatsKey(hour), &stats); err != nil {
+			return err
+		}
+		addBlockStats(&stats, hourly.stats)
+		bz, err := i.cdc.Marshal(&stats)
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(telemetrytypes.BlockStatsKey(hour), bz); err != nil {
+			return err
+		}
+		for consAddr, uptime := range hourly.uptime {
+			if uptime.signed > 0 {
+				key := telemetrytypes.ValidatorBlocksKey(hour, sdk.ConsAddress(consAddr))
+				if err := i.add(batch, key, uptime.signed); err != nil {
+					return err
+				}
+			}
+			key := telemetrytypes.ValidatorUptimeKey(sdk.ConsAddress(consAddr), hour)
+			if err := i.addUptime(batch, key, uptime); err != nil {
+				return err
+			}
+		}
+	}
+	if err := batch.Delete(nextKey); err != nil {
+		return err
+	}
+	if err := batch.Set(telemetrytypes.IndexRunKey(run.startHeight), run.value()); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// get unmarshals the value stored at key into ptr, leaving ptr untouched if there is no such value.
+func (i *Index) get(key []byte, ptr codec.ProtoMarshaler) error {
+	bz, err := i.db.Get(key)
+	if err != nil || bz == nil {
+		return err
+	}
+	return i.cdc.Unmarshal(bz, ptr)
+}
+
+// add adds count to the counter stored at key.
+func (i *Index) add(batch dbm.Batch, key []byte, count uint64) error {
+	bz, err := i.db.Get(key)
+	if err != nil {
+		return err
+	}
+	if bz != nil {
+		count += binary.BigEndian.Uint64(bz)
+	}
+	return batch.Set(key, sdk.Uint64ToBigEndian(count))
+}
+
+// GetBlockStats returns the statistics of the time buckets of the given granularity between the buckets containing
+// startDate and endDate inclusive, sorted by time. Either date may be nil to leave the interval open.
+func (i *Index) GetBlockStats(
+	startDate, endDate *time.Time,
+	granularity telemetrytypes.Granularity,
+) ([]telemetrytypes.BlockStats, error) {
+	start, end, err := dateRange(telemetrytypes.BlockStatsKeyPrefix, startDate, endDate, granularity)
+	if err != nil {
+		return nil, err
+	}
+	it, err := i.db.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var hours []telemetrytypes.BlockStats
+	for ; it.Valid(); it.Next() {
+		var hourly telemetrytypes.BlockStats
+		if err := i.cdc.Unmarshal(it.Value(), &hourly); err != nil {
+			return nil, err
+		}
+		hours = append(hours, hourly)
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	return rollUpBlockStats(hours, granularity), nil
+}
+
+// rollUpBlockStats sums the hourly block statistics into time buckets of the given granularity sorted by time.
+func rollUpBlockStats(hours []telemetrytypes.BlockStats, granularity telemetrytypes.Granularity) []telemetrytypes.BlockStats {
+	sort.SliceStable(hours, func(i, j int) bool { return hours[i].Start.Before(hours[j].Start) })
+	var stats []telemetrytypes.BlockStats
+	for _, hourly := range hours {
+		// Hours come sorted, so an hour either falls in the last bucket or starts a new one.
+		start := granularity.Truncate(hourly.Start)
+		if len(stats) == 0 || !stats[len(stats)-1].Start.Equal(start) {
+			stats = append(stats, telemetrytypes.BlockStats{Start: start, TxsFee: sdk.NewCoins()})
+		}
+		addBlockStats(&stats[len(stats)-1], hourly)
+	}
+	return stats
+}
+
+// addBlockStats adds the statistics of an hour to the statistics of the time bucket containing it.
+func addBlockStats(bucket *telemetrytypes.BlockStats, hourly telemetrytypes.BlockStats) {
+	bucket.BlocksCount += hourly.BlocksCount
+	bucket.BlocksBytes += hourly.BlocksBytes
+	bucket.BlocksInterval += hourly.BlocksInterval
+	bucket.TxsCount += hourly.TxsCount
+	bucket.TxsFee = bucket.TxsFee.Add(hourly.TxsFee...)
+}
+
+// GetValidatorsBlocks returns the number of blocks signed by each validator between startDate and endDate
+// inclusive, keyed by consensus address.
+func (i *Index) GetValidatorsBlocks(startDate, endDate *time.Time) (map[string]uint64, error) {
+	start, end, err := dateRange(telemetrytypes.ValidatorBlocksKeyPrefix, startDate, endDate, telemetrytypes.GRANULARITY_DAY)
+	if err != nil {
+		return nil, err
+	}
+	it, err := i.db.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	dateLen := len(sdk.FormatTimeBytes(time.Time{}))
+	blocks := make(map[string]uint64)
+	for ; it.Valid(); it.Next() {
+		consAddr := sdk.ConsAddress(it.Key()[len(telemetrytypes.ValidatorBlocksKeyPrefix)+dateLen:])
+		blocks[string(consAddr)] += binary.BigEndian.Uint64(it.Value())
+	}
+	return blocks, it.Error()
+}
+
+// keyHour returns the hour of a key made of the prefix followed by the start of the hour.
+func keyHour(prefix, key []byte) (time.Time, error) {
+	dateLen := len(sdk.FormatTimeBytes(time.Time{}))
+	return sdk.ParseTimeBytes(key[len(prefix) : len(prefix)+dateLen])
+}
+
+// dateRange returns the iterator bounds of the keys with the given prefix between the time buckets containing
+// startDate and endDate inclusive.
+func dateRange(
+	prefix []byte,
+	startDate, endDate *time.Time,
+	granularity telemetrytypes.Granularity,
+) ([]byte, []byte, error) {
+	if err := granularity.Validate(); err != nil {
+		return nil, nil, err
+	}
+	if startDate != nil && endDate != nil {
+		if ok := startDate.Before(*endDate); !ok {
+			return nil, nil, sdkerrors.Wrapf(telemetrytypes.ErrInvalidDateInterval, "invalid dates order")
+		}
+	}
+	start := prefix
+	if startDate != nil {
+		start = append(prefix, sdk.FormatTimeBytes(granularity.Truncate(*startDate))...)
+	}
+	end := sdk.PrefixEndBytes(prefix)
+	if endDate != nil {
+		end = append(prefix, sdk.FormatTimeBytes(granularity.Next(granularity.Truncate(*endDate)))...)
+	}
+	return start, end, nil
+}
+
+// AfterInitChain records the genesis accounts (app.Hook interface).
+func (i *Index) AfterInitChain(ctx sdk.Context, req abci.RequestInitChain, res abci.ResponseInitChain) {
+	if err := i.indexGenesisAccounts(ctx, req.Time.UTC()); err != nil {
+		panic(fmt.Errorf("failed to index genesis accounts: %w", err))
+	}
+}
+
+// AfterBeginBlock starts the supply snapshot scheduled at the previous block, now committed, and starts collecting the
+// statistics of the block unless it is already indexed (app.Hook interface).
+func (i *Index) AfterBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) {
+	i.block = nil
+	i.startSupplySnapshot()
+	lastHeight, _, err := i.lastBlock()
+	if err != nil {
+		panic(err)
+	}
+	// Blocks replayed after a restart are already indexed.
+	if req.Header.Height <= lastHeight {
+		return
+	}
+	block := &indexedBlock{
+		height:   req.Header.Height,
+		time:     req.Header.Time.UTC(),
+		bytes:    uint64(req.Header.Size()),
+		txsFee:   sdk.NewCoins(),
+		oracle:   newOracleActivity(),
+		accounts: newAccountActivity(),
+	}
+	if err := i.seedAccounts(ctx, block.accounts); err != nil {
+		panic(fmt.Errorf("failed to seed accounts: %w", err))
+	}
+	// Status changes are only seen as they happen, so the first block records the status of every validator.
+	if lastHeight == 0 {
+		block.oracleStatuses = make(map[string]oracletypes.ValidatorStatus)
+		i.oracleKeeper.IterateValidatorStatuses(ctx, func(valAddr sdk.ValAddress, status oracletypes.ValidatorStatus) bool {
+			block.oracleStatuses[string(valAddr)] = status
+			return false
+		})
+	}
+	for _, vote := range req.LastCommitInfo.Votes {
+		if vote.SignedLastBlock {
+			block.validators = append(block.validators, vote.Validator.Address)
+		} else {
+			block.missed = append(block.missed, vote.Validator.Address)
+		}
+	}
+	i.block = block
+	i.indexReceivers(res.Events)
+}
+
+// AfterDeliverTx adds the transaction and its events to the statistics of the block (app.Hook interface).
+func (i *Index) AfterDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) {
+	if i.block == nil {
+		return
+	}
+	i.block.bytes += uint64(len(req.Tx))
+	i.block.txsCount++
+	i.indexOracleEvents(ctx, res.Events)
+	i.indexReceivers(res.Events)
+	tx, err := i.txDecoder(req.Tx)
+	if err != nil {
+		return
+	}
+	i.indexSigners(tx)
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		i.block.txsFee = i.block.txsFee.Add(feeTx.GetFee()...)
+	}
+}
+
+// AfterEndBlock adds the events of the end block to the statistics of the block and schedules a supply snapshot when
+// due (app.Hook interface).
+func (i *Index) AfterEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) {
+	if i.block == nil {
+		return
+	}
+	i.indexOracleEvents(ctx, res.Events)
+	i.indexReceivers(res.Events)
+	due, err := i.supplySnapshotDue(i.block.height)
+	if err != nil {
+		panic(fmt.Errorf("failed to read supply snapshot: %w", err))
+	}
+	i.block.snapshotSupply = due
+}
+
+// ApplyQuery catch the custom query that matches specific paths (app.Hook interface).
+func (i *Index) ApplyQuery(req abci.RequestQuery) (res abci.ResponseQuery, stop bool) {
+	return abci.ResponseQuery{}, false
+}
+
+// BeforeCommit adds the statistics of the block to its hour, and wakes the backfill up if blocks may be missing from
+// the index before it (app.Hook interface).
+func (i *Index) BeforeCommit() {
+	if i.block == nil {
+		return
+	}
+	started, err := i.commit(*i.block)
+	if err != nil {
+		panic(fmt.Errorf("failed to index block %d: %w", i.block.height, err))
+	}
+	if started || !i.committed {
+		i.committed = true
+		i.wakeBackfill()
+	}
+	if i.block.snapshotSupply {
+		i.snapshotHeight, i.snapshotTime = i.block.height, i.block.time
+	}
+	i.block = nil
+}
+
+// commit writes the statistics of the block along with the index state in a single batch, and reports whether the
+// block started a new run. The batch is not synced to disk, blocks lost on a crash are backfilled instead.
+func (i *Index) commit(block indexedBlock) (bool, error) {
+	i.mtx.Lock()
+	defer i.mtx.Unlock()
+
+	lastHeight, lastTime, err := i.lastBlock()
+	if err != nil {
+		return false, err
+	}
+	run, found, err := i.lastRun()
+	if err != nil {
+		return false, err
+	}
+	// A block following a gap starts a new run, and its interval is backfilled from the block store.
+	consecutive := found && lastHeight == block.height-1 && run.endHeight == lastHeight
+	if !consecutive {
+		run = indexRun{startHeight: block.height, startTime: block.time}
+	}
+	run.endHeight, run.endTime = block.height, block.time
+	hour := telemetrytypes.GRANULARITY_HOUR.Truncate(block.time)
+	stats := telemetrytypes.BlockStats{Start: hour}
+	if err := i.get(telemetrytypes.BlockStatsKey(hour), &stats); err != nil {
+		return false, err
+	}
+	stats.BlocksCount++
+	stats.BlocksBytes += block.bytes
+	if consecutive && block.time.After(lastTime) {
+		stats.BlocksInterval += block.time.Sub(lastTime)
+	}
+	stats.TxsCount += block.txsCount
+	stats.TxsFee = stats.TxsFee.Add(block.txsFee...)
+
+	batch := i.db.NewBatch()
+	defer batch.Close()
+	bz, err := i.cdc.Marshal(&stats)
+	if err != nil {
+		return false, err
+	}
+	if err := batch.Set(telemetrytypes.BlockStatsKey(hour), bz); err != nil {
+		return false, err
+	}
+	for _, consAddr := range block.validators {
+		if err := i.add(batch, telemetrytypes.ValidatorBlocksKey(hour, consAddr), 1); err != nil {
+			return false, err
+		}
+		if err := i.addUptime(batch, telemetrytypes.ValidatorUptimeKey(consAddr, hour), validatorUptime{signed: 1}); err != nil {
+			return false, err
+		}
+	}
+	for _, consAddr := range block.missed {
+		if err := i.addUptime(batch, telemetrytypes.ValidatorUptimeKey(consAddr, hour), validatorUptime{missed: 1}); err != nil {
+			return false, err
+		}
+	}
+	if err := i.setOracleStatuses(batch, block.oracleStatuses); err != nil {
+		return false, err
+	}
+	if err := i.setOracleActivity(batch, hour, block.oracle); err != nil {
+		return false, err
+	}
+	if err := i.setOracleStatusChanges(batch, block.time, block.oracle.statuses); err != nil {
+		return false, err
+	}
+	if err := i.setAccountActivity(batch, hour, block.accounts); err != nil {
+		return false, err
+	}
+	if err := batch.Set(telemetrytypes.IndexRunKey(run.startHeight), run.value()); err != nil {
+		return false, err
+	}
+	if err := batch.Set(telemetrytypes.IndexStateKey, indexState(block.height, block.time)); err != nil {
+		return false, err
+	}
+	return !consecutive, batch.Write()
+}
@@ -0,0 +1,842 @@
+package keeper_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	odinapp "github.com/GeoDB-Limited/odin-core/app"
+	"github.com/GeoDB-Limited/odin-core/app/params"
+	minttypes "github.com/GeoDB-Limited/odin-core/x/mint/types"
+	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
+	telemetrykeeper "github.com/GeoDB-Limited/odin-core/x/telemetry/keeper"
+	telemetrytypes "github.com/GeoDB-Limited/odin-core/x/telemetry/types"
+)
+
+var (
+	alice = sdk.ConsAddress("alice_______________")
+	bob   = sdk.ConsAddress("bob_________________")
+)
+
+// fakeKeepers is the chain state read by the index.
+type fakeKeepers struct {
+	requests      map[oracletypes.RequestID]oracletypes.Request
+	accounts      []authtypes.AccountI
+	balances      []banktypes.Balance
+	treasuryPool  sdk.Coins
+	communityPool sdk.DecCoins
+	statuses      map[string]oracletypes.ValidatorStatus
+}
+
+func (k *fakeKeepers) MustGetRequest(_ sdk.Context, id oracletypes.RequestID) oracletypes.Request {
+	return k.requests[id]
+}
+
+func (k *fakeKeepers) GetValidatorStatus(_ sdk.Context, val sdk.ValAddress) oracletypes.ValidatorStatus {
+	return k.statuses[string(val)]
+}
+
+func (k *fakeKeepers) IterateValidatorStatuses(
+	_ sdk.Context,
+	cb func(val sdk.ValAddress, status oracletypes.ValidatorStatus) bool,
+) {
+	for val, status := range k.statuses {
+		if cb(sdk.ValAddress(val), status) {
+			return
+		}
+	}
+}
+
+func (k *fakeKeepers) IterateAccounts(_ sdk.Context, cb func(account authtypes.AccountI) bool) {
+	for _, account := range k.accounts {
+		if cb(account) {
+			return
+		}
+	}
+}
+
+func (k *fakeKeepers) GetAccount(_ sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
+	for _, account := range k.accounts {
+		if account.GetAddress().Equals(addr) {
+			return account
+		}
+	}
+	return nil
+}
+
+func (k *fakeKeepers) IterateTotalSupply(_ sdk.Context, cb func(sdk.Coin) bool) {
+	supply := sdk.NewCoins()
+	for _, balance := range k.balances {
+		supply = supply.Add(balance.Coins...)
+	}
+	for _, coin := range supply {
+		if cb(coin) {
+			return
+		}
+	}
+}
+
+func (k *fakeKeepers) IterateAllBalances(_ sdk.Context, cb func(sdk.AccAddress, sdk.Coin) bool) {
+	for _, balance := range k.balances {
+		for _, coin := range balance.Coins {
+			if cb(balance.GetAddress(), coin) {
+				return
+			}
+		}
+	}
+}
+
+func (k *fakeKeepers) GetMintPool(_ sdk.Context) minttypes.MintPool {
+	return minttypes.MintPool{TreasuryPool: k.treasuryPool}
+}
+
+func (k *fakeKeepers) GetFeePoolCommunityCoins(_ sdk.Context) sdk.DecCoins {
+	return k.communityPool
+}
+
+func (k *fakeKeepers) GetValidatorHistoricalRewards(
+	_ sdk.Context, _ sdk.ValAddress, _ uint64,
+) distrtypes.ValidatorHistoricalRewards {
+	return distrtypes.ValidatorHistoricalRewards{}
+}
+
+// fakeBlockStore is the block store of the node.
+type fakeBlockStore struct {
+	mtx        sync.Mutex
+	blocks     map[int64]*tmtypes.Block
+	validators map[int64][]sdk.ConsAddress
+}
+
+func (s *fakeBlockStore) Heights() (int64, int64, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	base, height := int64(1), int64(0)
+	for h := range s.blocks {
+		if height == 0 || h < base {
+			base = h
+		}
+		if h > height {
+			height = h
+		}
+	}
+	return base, height, nil
+}
+
+func (s *fakeBlockStore) LoadBlock(height int64) (*tmtypes.Block, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	block, ok := s.blocks[height]
+	if !ok {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+	return block, nil
+}
+
+func (s *fakeBlockStore) LoadValidators(height int64) ([]sdk.ConsAddress, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	validators, ok := s.validators[height]
+	if !ok {
+		return nil, fmt.Errorf("validators %d not found", height)
+	}
+	return validators, nil
+}
+
+type testChain struct {
+	index   *telemetrykeeper.Index
+	encCfg  params.EncodingConfig
+	tx      []byte
+	keepers *fakeKeepers
+	blocks  *fakeBlockStore
+}
+
+func newTestChain(t *testing.T, db dbm.DB, accounts ...authtypes.AccountI) testChain {
+	encCfg := odinapp.MakeEncodingConfig()
+	builder := encCfg.TxConfig.NewTxBuilder()
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("loki", 10)))
+	tx, err := encCfg.TxConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	keepers := &fakeKeepers{
+		requests: make(map[oracletypes.RequestID]oracletypes.Request),
+		accounts: accounts,
+	}
+	blocks := &fakeBlockStore{
+		blocks:     make(map[int64]*tmtypes.Block),
+		validators: make(map[int64][]sdk.ConsAddress),
+	}
+	return testChain{
+		index: telemetrykeeper.NewIndex(
+			encCfg.Marshaler, db, blocks, encCfg.TxConfig.TxDecoder(), keepers, keepers, keepers, keepers, keepers,
+			func(int64) (sdk.Context, error) { return sdk.Context{}, nil }, log.NewNopLogger(),
+		),
+		encCfg:  encCfg,
+		tx:      tx,
+		keepers: keepers,
+		blocks:  blocks,
+	}
+}
+
+// storeBlock commits a block with the given number of transactions signed by the given validators, and missed by an
+// absent one, to the block store without indexing it.
+func (c testChain) storeBlock(height int64, blockTime time.Time, txs int, signers ...sdk.ConsAddress) {
+	block := &tmtypes.Block{
+		Header:     tmtypes.Header{Height: height, Time: blockTime},
+		LastCommit: &tmtypes.Commit{},
+	}
+	for i := 0; i < txs; i++ {
+		block.Txs = append(block.Txs, c.tx)
+	}
+	for _, signer := range signers {
+		block.LastCommit.Signatures = append(block.LastCommit.Signatures, tmtypes.CommitSig{
+			BlockIDFlag: tmtypes.BlockIDFlagCommit, ValidatorAddress: tmbytes.HexBytes(signer),
+		})
+	}
+	block.LastCommit.Signatures = append(block.LastCommit.Signatures, tmtypes.NewCommitSigAbsent())
+	c.blocks.mtx.Lock()
+	defer c.blocks.mtx.Unlock()
+	c.blocks.blocks[height] = block
+	c.blocks.validators[height-1] = append(append([]sdk.ConsAddress{}, signers...), sdk.ConsAddress("absent"))
+}
+
+// block commits and processes a block with the given number of transactions signed by the given validators.
+func (c testChain) block(height int64, blockTime time.Time, txs int, signers ...sdk.ConsAddress) {
+	c.storeBlock(height, blockTime, txs, signers...)
+	var votes []abci.VoteInfo
+	for _, signer := range signers {
+		votes = append(votes, abci.VoteInfo{Validator: abci.Validator{Address: signer}, SignedLastBlock: true})
+	}
+	votes = append(votes, abci.VoteInfo{Validator: abci.Validator{Address: sdk.ConsAddress("absent")}})
+	c.index.AfterBeginBlock(sdk.Context{}, abci.RequestBeginBlock{
+		Header:         tmproto.Header{Height: height, Time: blockTime},
+		LastCommitInfo: abci.LastCommitInfo{Votes: votes},
+	}, abci.ResponseBeginBlock{})
+	for i := 0; i < txs; i++ {
+		c.index.AfterDeliverTx(sdk.Context{}, abci.RequestDeliverTx{Tx: c.tx}, abci.ResponseDeliverTx{})
+	}
+	c.index.AfterEndBlock(sdk.Context{}, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{})
+	c.index.BeforeCommit()
+}
+
+// oracleBlock processes a block with a single transaction emitting txEvents and an end block emitting
+// endBlockEvents.
+func (c testChain) oracleBlock(height int64, blockTime time.Time, txEvents, endBlockEvents []abci.Event) {
+	ctx := sdk.Context{}.WithBlockHeight(height)
+	c.index.AfterBeginBlock(ctx, abci.RequestBeginBlock{
+		Header: tmproto.Header{Height: height, Time: blockTime},
+	}, abci.ResponseBeginBlock{})
+	c.index.AfterDeliverTx(ctx, abci.RequestDeliverTx{Tx: c.tx}, abci.ResponseDeliverTx{Events: txEvents})
+	c.index.AfterEndBlock(ctx, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{Events: endBlockEvents})
+	c.index.BeforeCommit()
+}
+
+// send returns a transaction sending coins from the sender to the recipient.
+func (c testChain) send(t *testing.T, from, to sdk.AccAddress) []byte {
+	builder := c.encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("loki", 1)))))
+	tx, err := c.encCfg.TxConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	return tx
+}
+
+// txBlock processes a block with the given transactions, the first one emitting the given events.
+func (c testChain) txBlock(height int64, blockTime time.Time, txs [][]byte, events ...abci.Event) {
+	c.index.AfterBeginBlock(sdk.Context{}, abci.RequestBeginBlock{
+		Header: tmproto.Header{Height: height, Time: blockTime},
+	}, abci.ResponseBeginBlock{})
+	for idx, tx := range txs {
+		res := abci.ResponseDeliverTx{}
+		if idx == 0 {
+			res.Events = events
+		}
+		c.index.AfterDeliverTx(sdk.Context{}, abci.RequestDeliverTx{Tx: tx}, res)
+	}
+	c.index.AfterEndBlock(sdk.Context{}, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{})
+	c.index.BeforeCommit()
+}
+
+func received(addr sdk.AccAddress) abci.Event {
+	return abci.Event(sdk.NewEvent(
+		banktypes.EventTypeCoinReceived,
+		sdk.NewAttribute(banktypes.AttributeKeyReceiver, addr.String()),
+	))
+}
+
+// request records the request and returns its event along with the raw request events of the data sources.
+func (c testChain) request(
+	id oracletypes.RequestID,
+	oid oracletypes.OracleScriptID,
+	height int64,
+	dataSources []oracletypes.DataSourceID,
+	validators ...sdk.ValAddress,
+) []abci.Event {
+	c.keepers.requests[id] = oracletypes.Request{OracleScriptID: oid, RequestHeight: height}
+	event := sdk.NewEvent(
+		oracletypes.EventTypeRequest,
+		sdk.NewAttribute(oracletypes.AttributeKeyID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(oracletypes.AttributeKeyOracleScriptID, fmt.Sprintf("%d", oid)),
+	)
+	for _, val := range validators {
+		event = event.AppendAttributes(sdk.NewAttribute(oracletypes.AttributeKeyValidator, val.String()))
+	}
+	events := []abci.Event{abci.Event(event)}
+	for _, did := range dataSources {
+		events = append(events, abci.Event(sdk.NewEvent(
+			oracletypes.EventTypeRawRequest,
+			sdk.NewAttribute(oracletypes.AttributeKeyDataSourceID, fmt.Sprintf("%d", did)),
+		)))
+	}
+	return events
+}
+
+func report(id oracletypes.RequestID, val sdk.ValAddress) abci.Event {
+	return abci.Event(sdk.NewEvent(
+		oracletypes.EventTypeReport,
+		sdk.NewAttribute(oracletypes.AttributeKeyID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(oracletypes.AttributeKeyValidator, val.String()),
+	))
+}
+
+func resolve(id oracletypes.RequestID, status oracletypes.ResolveStatus) abci.Event {
+	return abci.Event(sdk.NewEvent(
+		oracletypes.EventTypeResolve,
+		sdk.NewAttribute(oracletypes.AttributeKeyID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(oracletypes.AttributeKeyResolveStatus, fmt.Sprintf("%d", status)),
+	))
+}
+
+func reward(id oracletypes.RequestID, amount sdk.Coins) abci.Event {
+	return abci.Event(sdk.NewEvent(
+		oracletypes.EventTypeDataProviderReward,
+		sdk.NewAttribute(oracletypes.AttributeKeyID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(oracletypes.AttributeKeyAmount, amount.String()),
+	))
+}
+
+func statusChange(eventType string, val sdk.ValAddress) abci.Event {
+	return abci.Event(sdk.NewEvent(eventType, sdk.NewAttribute(oracletypes.AttributeKeyValidator, val.String())))
+}
+
+func date(day int) time.Time {
+	return time.Date(2021, time.December, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestIndexBlockStats(t *testing.T) {
+	c := newTestChain(t, dbm.NewMemDB())
+	c.block(1, date(2).Add(23*time.Hour+59*time.Minute), 0, alice)
+	c.block(2, date(3).Add(time.Minute), 2, alice, bob)
+	c.block(3, date(3).Add(3*time.Minute), 1, bob)
+
+	stats, err := c.index.GetBlockStats(nil, nil, telemetrytypes.GRANULARITY_DAY)
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	require.Equal(t, date(2), stats[0].Start)
+	require.Equal(t, uint64(1), stats[0].BlocksCount)
+	require.Zero(t, stats[0].BlocksInterval)
+
+	require.Equal(t, date(3), stats[1].Start)
+	require.Equal(t, uint64(2), stats[1].BlocksCount)
+	require.Equal(t, 4*time.Minute, stats[1].BlocksInterval)
+	require.Equal(t, uint64(3), stats[1].TxsCount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("loki", 30)), stats[1].TxsFee)
+	require.Greater(t, stats[1].BlocksBytes, uint64(3*len(c.tx)))
+
+	start, end := date(3), date(4)
+	stats, err = c.index.GetBlockStats(&start, &end, telemetrytypes.GRANULARITY_DAY)
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	require.Equal(t, date(3), stats[0].Start)
+
+	_, err = c.index.GetBlockStats(&end, &start, telemetrytypes.GRANULARITY_DAY)
+	require.Error(t, err)
+
+	blocks, err := c.index.GetValidatorsBlocks(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{string(alice): 2, string(bob): 2}, blocks)
+	blocks, err = c.index.GetValidatorsBlocks(&start, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{string(alice): 1, string(bob): 2}, blocks)
+}
+
+func TestIndexSkipsIndexedBlocks(t *testing.T) {
+	db := dbm.NewMemDB()
+	c := newTestChain(t, db)
+	c.block(1, date(1), 1, alice)
+	c.block(2, date(1).Add(time.Minute), 1, alice)
+
+	// Blocks replayed after a restart are not counted twice.
+	c = newTestChain(t, db)
+	c.block(2, date(1).Add(time.Minute), 1, alice)
+	c.block(3, date(1).Add(2*time.Minute), 1, alice)
+
+	stats, err := c.index.GetBlockStats(nil, nil, telemetrytypes.GRANULARITY_DAY)
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	require.Equal(t, uint64(3), stats[0].BlocksCount)
+	require.Equal(t, uint64(3), stats[0].TxsCount)
+	require.Equal(t, 2*time.Minute, stats[0].BlocksInterval)
+	blocks, err := c.index.GetValidatorsBlocks(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{string(alice): 3}, blocks)
+}
+
+// blocksCount returns the number of blocks in the index.
+func (c testChain) blocksCount(t *testing.T) uint64 {
+	stats, err := c.index.GetBlockStats(nil, nil, telemetrytypes.GRANULARITY_MONTH)
+	require.NoError(t, err)
+	var count uint64
+	for _, month := range stats {
+		count += month.BlocksCount
+	}
+	return count
+}
+
+func TestIndexBackfillsMissingBlocks(t *testing.T) {
+	c := newTestChain(t, dbm.NewMemDB())
+	defer c.index.Close()
+	// Blocks committed before the index was enabled.
+	c.storeBlock(1, date(1), 1, alice)
+	c.storeBlock(2, date(1).Add(time.Minute), 1, alice, bob)
+	c.block(3, date(1).Add(2*time.Minute), 1, alice)
+	c.block(4, date(1).Add(3*time.Minute), 1, bob)
+	// A block committed while the index was disabled.
+	c.storeBlock(5, date(1).Add(4*time.Minute), 1, alice)
+	c.block(6, date(1).Add(5*time.Minute), 1, alice)
+	// A block lost by the index on a crash.
+	c.storeBlock(7, date(2).Add(time.Minute), 2, bob)
+	c.block(8, date(2).Add(2*time.Minute), 1, bob)
+
+	require.Eventually(t, func() bool { return c.blocksCount(t) == 8 }, 5*time.Second, 10*time.Millisecond)
+	stats, err := c.index.GetBlockStats(nil, nil, telemetrytypes.GRANULARITY_DAY)
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	require.Equal(t, uint64(6), stats[0].BlocksCount)
+	require.Equal(t, 5*time.Minute, stats[0].BlocksInterval)
+	require.Equal(t, uint64(6), stats[0].TxsCount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("loki", 60)), stats[0].TxsFee)
+	require.Equal(t, uint64(2), stats[1].BlocksCount)
+	require.Equal(t, 24*time.Hour-3*time.Minute, stats[1].BlocksInterval)
+	require.Equal(t, uint64(3), stats[1].TxsCount)
+
+	blocks, err := c.index.GetValidatorsBlocks(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{string(alice): 5, string(bob): 4}, blocks)
+	end := date(1)
+	blocks, err = c.index.GetValidatorsBlocks(nil, &end)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{string(alice): 5, string(bob): 2}, blocks)
+
+	// Validators missing a block are read from the validator set of the committed height.
+	uptime, err := c.index.GetValidatorUptime(sdk.ConsAddress("absent"), nil, nil, telemetrytypes.GRANULARITY_DAY)
+	require.NoError(t, err)
+	require.Len(t, uptime, 2)
+	require.Equal(t, uint64(6), uptime[0].MissedBlocks)
+	require.Equal(t, uint64(2), uptime[1].MissedBlocks)
+	uptimes, err := c.index.GetValidatorsUptime(nil, &end)
+	require.NoError(t, err)
+	require.Equal(t, telemetrytypes.ValidatorUptime{MissedBlocks: 6, Uptime: sdk.ZeroDec()}, uptimes["absent"])
+	require.Equal(t, telemetrytypes.ValidatorUptime{SignedBlocks: 5, Uptime: sdk.OneDec()}, uptimes[string(alice)])
+
+	// Backfilled blocks are joined to the runs around them, so they are not backfilled twice.
+	c.block(9, date(2).Add(3*time.Minute), 0, bob)
+	require.Equal(t, uint64(9), c.blocksCount(t))
+}
+
+func TestIndexBackfillsInChunks(t *testing.T) {
+	c := newTestChain(t, dbm.NewMemDB())
+	defer c.index.Close()
+	const stored = 2500
+	for height := int64(1); height <= stored; height++ {
+		c.storeBlock(height, date(1).Add(time.Duration(height)*time.Second), 0, alice)
+	}
+	c.block(stored+1, date(1).Add((stored+1)*time.Second), 0, alice)
+
+	require.Eventually(t, func() bool { return c.blocksCount(t) == stored+1 }, 10*time.Second, 10*time.Millisecond)
+	stats, err := c.index.GetBlockStats(nil, nil, telemetrytypes.GRANULARITY_DAY)
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	require.Equal(t, stored*time.Second, stats[0].BlocksInterval)
+	blocks, err := c.index.GetValidatorsBlocks(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{string(alice): stored + 1}, blocks)
+}
+
+func TestKeeperWithoutIndex(t *testing.T) {
+	c := newTestChain(t, dbm.NewMemDB())
+	k := telemetrykeeper.NewKeeper(nil, c.encCfg.TxConfig, nil, stakingkeeper.Keeper{}, distrkeeper.Keeper{}, c.keepers, nil)
+	_, err := k.GetTxVolume(nil, nil, telemetrytypes.GRANULARITY_DAY)
+	require.ErrorIs(t, err, telemetrytypes.ErrIndexDisabled)
+	_, err = k.GetActiveAccounts(nil, nil, telemetrytypes.GRANULARITY_DAY)
+	require.ErrorIs(t, err, telemetrytypes.ErrIndexDisabled)
+}
+
+func TestIndexGranularity(t *testing.T) {
+	c := newTestChain(t, dbm.NewMemDB())
+	// December 6, 2021 is a Monday.
+	c.block(1, date(5).Add(23*time.Hour), 1)
+	c.block(2, date(6).Add(time.Hour), 1)
+	c.block(3, date(6).Add(time.Hour+30*time.Minute), 1)
+	c.block(4, date(6).Add(2*time.Hour), 1)
+	c.block(5, date(31).Add(23*time.Hour), 1)
+	c.block(6, date(32), 1)
+
+	starts := func(stats []telemetrytypes.BlockStats) []time.Time {
+		var times []time.Time
+		for _, s := range stats {
+			times = append(times, s.Start)
+		}
+		return times
+	}
+
+	stats, err := c.index.GetBlockStats(nil, nil, telemetrytypes.GRANULARITY_HOUR)
+	require.NoError(t, err)
+	require.Equal(t, []time.Time{
+		date(5).Add(23 * time.Hour), date(6).Add(time.Hour), date(6).Add(2 * time.Hour),
+		date(31).Add(23 * time.Hour), date(32),
+	}, starts(stats))
+	require.Equal(t, uint64(2), stats[1].BlocksCount)
+
+	start, end := date(6).Add(90*time.Minute), date(6).Add(2*time.Hour)
+	stats, err = c.index.GetBlockStats(&start, &end, telemetrytypes.GRANULARITY_HOUR)
+	require.NoError(t, err)
+	require.Equal(t, []time.Time{date(6).Add(time.Hour), date(6).Add(2 * time.Hour)}, starts(stats))
+
+	stats, err = c.index.GetBlockStats(nil, nil, telemetrytypes.GRANULARITY_WEEK)
+	require.NoError(t, err)
+	require.Equal(t, []time.Time{date(-1), date(6), date(27)}, starts(stats))
+	require.Equal(t, uint64(3), stats[1].TxsCount)
+	require.Equal(t, uint64(2), stats[2].BlocksCount)
+
+	stats, err = c.index.GetBlockStats(nil, nil, telemetrytypes.GRANULARITY_MONTH)
+	require.NoError(t, err)
+	require.Equal(t, []time.Time{date(1), date(32)}, starts(stats))
+	require.Equal(t, uint64(5), stats[0].BlocksCount)
+	// The intervals of all the buckets add up to the time between the first and last blocks.
+	require.Equal(t, date(32).Sub(date(5).Add(23*time.Hour)), stats[0].BlocksInterval+stats[1].BlocksInterval)
+
+	_, err = c.index.GetBlockStats(nil, nil, telemetrytypes.Granularity(42))
+	require.Error(t, err)
+}
+
+func TestIndexOracleActivity(t *testing.T) {
+	c := newTestChain(t, dbm.NewMemDB())
+	aliceVal, bobVal := sdk.ValAddress(alice), sdk.ValAddress(bob)
+	loki := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("loki", amount)) }
+
+	c.oracleBlock(1, date(1), append(
+		c.request(1, 1, 1, []oracletypes.DataSourceID{1, 2}, aliceVal, bobVal),
+		c.request(2, 2, 1, []oracletypes.DataSourceID{2}, aliceVal)...,
+	), nil)
+	c.oracleBlock(2, date(1).Add(time.Minute), []abci.Event{report(1, aliceVal), report(1, bobVal)},
+		[]abci.Event{resolve(1, oracletypes.RESOLVE_STATUS_SUCCESS), reward(1, loki(5))})
+	c.oracleBlock(3, date(2), c.request(3, 1, 3, []oracletypes.DataSourceID{2}, bobVal),
+		[]abci.Event{resolve(2, oracletypes.RESOLVE_STATUS_EXPIRED)})
+	c.oracleBlock(4, date(2).Add(time.Minute), []abci.Event{report(3, bobVal)},
+		[]abci.Event{resolve(3, oracletypes.RESOLVE_STATUS_FAILURE), reward(3, loki(2)), reward(3, loki(1))})
+
+	requests, err := c.index.GetOracleRequests(nil, nil, telemetrytypes.GRANULARITY_DAY)
+	require.NoError(t, err)
+	require.Len(t, requests, 2)
+	require.Equal(t, date(1), requests[0].Start)
+	require.Equal(t, uint64(2), requests[0].RequestsCount)
+	require.Equal(t, uint64(1), requests[0].SuccessCount)
+	require.Equal(t, sdk.NewDec(1), requests[0].AvgResolveBlocks)
+	require.Equal(t, []telemetrytypes.OracleScriptStats{
+		{OracleScriptID: 1, RequestsCount: 1, SuccessCount: 1, ResolveBlocks: 1},
+		{OracleScriptID: 2, RequestsCount: 1},
+	}, requests[0].OracleScripts)
+	require.Equal(t, []telemetrytypes.OracleScriptStats{
+		{OracleScriptID: 1, RequestsCount: 1, FailureCount: 1, ResolveBlocks: 1},
+		{OracleScriptID: 2, ExpiredCount: 1},
+	}, requests[1].OracleScripts)
+
+	requests, err = c.index.GetOracleRequests(nil, nil, telemetrytypes.GRANULARITY_MONTH)
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	require.Equal(t, uint64(3), requests[0].RequestsCount)
+	require.Equal(t, uint64(1), requests[0].ExpiredCount)
+	require.Equal(t, sdk.NewDec(1), requests[0].AvgResolveBlocks)
+	require.Equal(t, uint64(2), requests[0].OracleScripts[0].RequestsCount)
+
+	dataSources, err := c.index.GetDataSourcesRequests(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[uint64]uint64{1: 1, 2: 3}, dataSources)
+	start := date(2)
+	dataSources, err = c.index.GetDataSourcesRequests(&start, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[uint64]uint64{2: 1}, dataSources)
+
+	rewards, err := c.index.GetDataProviderRewards(nil, nil, telemetrytypes.GRANULARITY_DAY)
+	require.NoError(t, err)
+	require.Equal(t, []telemetrytypes.DataProviderRewards{
+		{Start: date(1), Amount: loki(5), PayoutsCount: 1},
+		{Start: date(2), Amount: loki(3), PayoutsCount: 2},
+	}, rewards)
+}
+
+func TestReportParticipation(t *testing.T) {
+	c := newTestChain(t, dbm.NewMemDB())
+	aliceVal, bobVal := sdk.ValAddress(alice), sdk.ValAddress(bob)
+	c.oracleBlock(1, date(1), append(
+		c.request(1, 1, 1, nil, aliceVal, bobVal),
+		c.request(2, 1, 1, nil, aliceVal, bobVal)...,
+	), nil)
+	c.oracleBlock(2, date(1).Add(time.Minute), []abci.Event{report(1, aliceVal), report(2, aliceVal), report(1, bobVal)}, nil)
+
+	reports, err := c.index.GetValidatorsReports(nil, nil)
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+
+	k := telemetrykeeper.NewKeeper(nil, nil, nil, stakingkeeper.Keeper{}, distrkeeper.Keeper{}, c.keepers, c.index)
+	participation, total, err := k.GetReportParticipation(nil, nil, true, &query.PageRequest{Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), total)
+	require.Equal(t, []telemetrytypes.ReportParticipation{
+		{ValidatorAddress: aliceVal.String(), RequestedCount: 2, ReportsCount: 2, Participation: sdk.OneDec()},
+		{ValidatorAddress: bobVal.String(), RequestedCount: 2, ReportsCount: 1, Participation: sdk.NewDecWithPrec(5, 1)},
+	}, participation)
+}
+
+func TestIndexAccounts(t *testing.T) {
+	aliceAcc, bobAcc, carolAcc := sdk.AccAddress(alice), sdk.AccAddress(bob), sdk.AccAddress("carol_______________")
+	c := newTestChain(t, dbm.NewMemDB(), authtypes.NewBaseAccountWithAddress(aliceAcc))
+	c.index.AfterInitChain(sdk.Context{}, abci.RequestInitChain{Time: date(1)}, abci.ResponseInitChain{})
+
+	c.txBlock(1, date(1).Add(time.Hour), [][]byte{c.send(t, aliceAcc, bobAcc)}, received(bobAcc))
+	c.txBlock(2, date(2), [][]byte{c.send(t, aliceAcc, carolAcc), c.send(t, bobAcc, aliceAcc)}, received(carolAcc))
+	c.txBlock(3, date(3), [][]byte{c.send(t, aliceAcc, carolAcc)}, received(carolAcc))
+
+	active, err := c.index.GetActiveAccounts(nil, nil, telemetrytypes.GRANULARITY_DAY)
+	require.NoError(t, err)
+	require.Equal(t, []telemetrytypes.ActiveAccounts{
+		{Start: date(1), AccountsCount: 1},
+		{Start: date(2), AccountsCount: 2},
+		{Start: date(3), AccountsCount: 1},
+	}, active)
+	active, err = c.index.GetActiveAccounts(nil, nil, telemetrytypes.GRANULARITY_MONTH)
+	require.NoError(t, err)
+	require.Equal(t, []telemetrytypes.ActiveAccounts{{Start: date(1), AccountsCount: 2}}, active)
+
+	growth, err := c.index.GetAccountGrowth(nil, nil, telemetrytypes.GRANULARITY_DAY)
+	require.NoError(t, err)
+	require.Equal(t, []telemetrytypes.AccountGrowth{
+		{Start: date(1), NewAccounts: 2, TotalAccounts: 2},
+		{Start: date(2), NewAccounts: 1, TotalAccounts: 3},
+	}, growth)
+	// The totals include the accounts created before the start date.
+	start := date(2)
+	growth, err = c.index.GetAccountGrowth(&start, nil, telemetrytypes.GRANULARITY_HOUR)
+	require.NoError(t, err)
+	require.Equal(t, []telemetrytypes.AccountGrowth{{Start: date(2), NewAccounts: 1, TotalAccounts: 3}}, growth)
+
+	txs, err := c.index.GetAccountsTxs(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{string(aliceAcc): 3, string(bobAcc): 1}, txs)
+
+	k := telemetrykeeper.NewKeeper(nil, nil, nil, stakingkeeper.Keeper{}, distrkeeper.Keeper{}, c.keepers, c.index)
+	top, total, err := k.GetTopAccounts(&start, nil, true, &query.PageRequest{Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), total)
+	require.Equal(t, []telemetrytypes.AccountTxs{{Address: aliceAcc.String(), TxsCount: 2}}, top)
+}
+
+func TestIndexSeedsAccounts(t *testing.T) {
+	aliceAcc, bobAcc, carolAcc := sdk.AccAddress(alice), sdk.AccAddress(bob), sdk.AccAddress("carol_______________")
+	// The index is enabled on a running chain, so it never saw the genesis.
+	db := dbm.NewMemDB()
+	c := newTestChain(t, db, authtypes.NewBaseAccountWithAddress(aliceAcc), authtypes.NewBaseAccountWithAddress(bobAcc))
+
+	c.txBlock(10, date(2), [][]byte{c.send(t, aliceAcc, carolAcc)}, received(carolAcc))
+	c.keepers.accounts = append(c.keepers.accounts, authtypes.NewBaseAccountWithAddress(carolAcc))
+	c.txBlock(11, date(3), [][]byte{c.send(t, bobAcc, aliceAcc)}, received(aliceAcc))
+
+	// The seeded accounts count toward the total accounts but were not created in the block.
+	growth, err := c.index.GetAccountGrowth(nil, nil, telemetrytypes.GRANULARITY_DAY)
+	require.NoError(t, err)
+	require.Equal(t, []telemetrytypes.AccountGrowth{{Start: date(2), NewAccounts: 1, TotalAccounts: 3}}, growth)
+	active, err := c.index.GetActiveAccounts(nil, nil, telemetrytypes.GRANULARITY_DAY)
+	require.NoError(t, err)
+	require.Equal(t, []telemetrytypes.ActiveAccounts{
+		{Start: date(2), AccountsCount: 1},
+		{Start: date(3), AccountsCount: 1},
+	}, active)
+
+	// Accounts are seeded only once, the baseline is kept once the index is reopened.
+	daveAcc := sdk.AccAddress("dave________________")
+	c = newTestChain(t, db, c.keepers.accounts...)
+	c.txBlock(12, date(4), [][]byte{c.send(t, carolAcc, daveAcc)}, received(daveAcc))
+	start := date(3)
+	growth, err = c.index.GetAccountGrowth(&start, nil, telemetrytypes.GRANULARITY_DAY)
+	require.NoError(t, err)
+	require.Equal(t, []telemetrytypes.AccountGrowth{{Start: date(4), NewAccounts: 1, TotalAccounts: 4}}, growth)
+}
+
+func TestSupplyDistribution(t *testing.T) {
+	loki := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("loki", amount)) }
+	module := authtypes.NewEmptyModuleAccount("mint")
+	users := []sdk.AccAddress{
+		sdk.AccAddress("user1_______________"), sdk.AccAddress("user2_______________"),
+		sdk.AccAddress("user3_______________"), sdk.AccAddress("user4_______________"),
+	}
+	c := newTestChain(t, dbm.NewMemDB(), module)
+	c.keepers.balances = []banktypes.Balance{
+		{Address: module.GetAddress().String(), Coins: loki(100)},
+		{Address: users[0].String(), Coins: loki(10)},
+		{Address: users[1].String(), Coins: loki(20)},
+		{Address: users[2].String(), Coins: loki(30)},
+		{Address: users[3].String(), Coins: loki(40)},
+	}
+	c.keepers.treasuryPool = loki(60)
+	c.keepers.communityPool = sdk.NewDecCoinsFromCoins(loki(30)...)
+
+	_, err := c.index.GetSupplyDistribution("loki", nil, nil)
+	require.ErrorIs(t, err, telemetrytypes.ErrSnapshotNotFound)
+
+	// The first block schedules a snapshot since there is none, taken in the background once the block is committed.
+	c.block(1, date(1), 0)
+	_, err = c.index.GetSupplyDistribution("loki", nil, nil)
+	require.ErrorIs(t, err, telemetrytypes.ErrSnapshotNotFound)
+	c.block(2, date(1).Add(time.Minute), 0)
+	waitSnapshot := func(height int64) {
+		require.Eventually(t, func() bool {
+			distribution, err := c.index.GetSupplyDistribution("loki", nil, nil)
+			return err == nil && distribution.Height == height
+		}, time.Second, time.Millisecond)
+	}
+	waitSnapshot(1)
+	distribution, err := c.index.GetSupplyDistribution("loki", []sdk.Int{sdk.NewInt(20), sdk.NewInt(50)}, nil)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(200), distribution.TotalSupply)
+	require.Equal(t, sdk.NewInt(110), distribution.Circulating)
+	require.Equal(t, sdk.NewInt(60), distribution.TreasuryPool)
+	require.Equal(t, sdk.NewInt(30), distribution.CommunityPool)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), distribution.ModuleAccountsShare)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), distribution.UsersShare)
+	require.Equal(t, uint64(4), distribution.HoldersCount)
+	// 2 * (10 + 40 + 90 + 160) / (4 * 100) - 5 / 4
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), distribution.Gini)
+	require.Equal(t, []telemetrytypes.HoldersThreshold{
+		{Amount: sdk.NewInt(20), HoldersCount: 3},
+		{Amount: sdk.NewInt(50), HoldersCount: 0},
+	}, distribution.Thresholds)
+	require.Equal(t, []telemetrytypes.PercentileCutoff{
+		{Percentile: 50, Amount: sdk.NewInt(20)},
+		{Percentile: 90, Amount: sdk.NewInt(40)},
+		{Percentile: 99, Amount: sdk.NewInt(40)},
+	}, distribution.Percentiles)
+
+	_, err = c.index.GetSupplyDistribution("loki", nil, []uint32{0})
+	require.ErrorIs(t, err, telemetrytypes.ErrInvalidPercentile)
+
+	// Snapshots are only taken periodically afterwards.
+	c.keepers.balances = c.keepers.balances[:1]
+	c.block(3, date(1).Add(2*time.Minute), 0)
+	c.block(4, date(1).Add(3*time.Minute), 0)
+	distribution, err = c.index.GetSupplyDistribution("loki", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), distribution.Height)
+	require.Equal(t, uint64(4), distribution.HoldersCount)
+	c.block(telemetrykeeper.SupplySnapshotInterval, date(1).Add(time.Hour), 0)
+	c.block(telemetrykeeper.SupplySnapshotInterval+1, date(1).Add(time.Hour+time.Minute), 0)
+	waitSnapshot(telemetrykeeper.SupplySnapshotInterval)
+	distribution, err = c.index.GetSupplyDistribution("loki", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), distribution.HoldersCount)
+	require.Equal(t, sdk.OneDec(), distribution.ModuleAccountsShare)
+	require.Equal(t, sdk.ZeroDec(), distribution.Gini)
+}
+
+func TestValidatorUptime(t *testing.T) {
+	c := newTestChain(t, dbm.NewMemDB())
+	absent := sdk.ConsAddress("absent")
+	c.block(1, date(1), 0, alice)
+	c.block(2, date(1).Add(time.Minute), 0, alice, bob)
+	c.block(3, date(2), 0, alice)
+
+	uptime, err := c.index.GetValidatorUptime(alice, nil, nil, telemetrytypes.GRANULARITY_DAY)
+	require.NoError(t, err)
+	require.Len(t, uptime, 2)
+	require.Equal(t, date(1), uptime[0].Start)
+	require.Equal(t, uint64(2), uptime[0].SignedBlocks)
+	require.Zero(t, uptime[0].MissedBlocks)
+	require.Equal(t, sdk.OneDec(), uptime[0].Uptime)
+
+	uptime, err = c.index.GetValidatorUptime(absent, nil, nil, telemetrytypes.GRANULARITY_DAY)
+	require.NoError(t, err)
+	require.Len(t, uptime, 2)
+	require.Equal(t, uint64(2), uptime[0].MissedBlocks)
+	require.Equal(t, sdk.ZeroDec(), uptime[0].Uptime)
+
+	start := date(2)
+	uptimes, err := c.index.GetValidatorsUptime(&start, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]telemetrytypes.ValidatorUptime{
+		string(alice):  {SignedBlocks: 1, Uptime: sdk.OneDec()},
+		string(absent): {MissedBlocks: 1, Uptime: sdk.ZeroDec()},
+	}, uptimes)
+	uptimes, err = c.index.GetValidatorsUptime(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]telemetrytypes.ValidatorUptime{
+		string(alice):  {SignedBlocks: 3, Uptime: sdk.OneDec()},
+		string(bob):    {SignedBlocks: 1, Uptime: sdk.OneDec()},
+		string(absent): {MissedBlocks: 3, Uptime: sdk.ZeroDec()},
+	}, uptimes)
+	_, err = c.index.GetValidatorsUptime(&start, &start)
+	require.Error(t, err)
+
+	val := sdk.ValAddress(alice)
+	c.oracleBlock(4, date(3), []abci.Event{statusChange(oracletypes.EventTypeActivate, val)}, nil)
+	c.oracleBlock(5, date(4), nil, []abci.Event{statusChange(oracletypes.EventTypeDeactivate, val)})
+	changes, err := c.index.GetOracleStatusChanges(val)
+	require.NoError(t, err)
+	require.Equal(t, []telemetrytypes.OracleStatusChange{
+		{Time: date(3), IsActive: true},
+		{Time: date(4), IsActive: false},
+	}, changes)
+	changes, err = c.index.GetOracleStatusChanges(sdk.ValAddress(bob))
+	require.NoError(t, err)
+	require.Empty(t, changes)
+}
+
+func TestIndexRecordsOracleStatuses(t *testing.T) {
+	aliceVal, bobVal, carolVal := sdk.ValAddress(alice), sdk.ValAddress(bob), sdk.ValAddress("carol_______________")
+	c := newTestChain(t, dbm.NewMemDB())
+	c.keepers.statuses = map[string]oracletypes.ValidatorStatus{
+		string(aliceVal): oracletypes.NewValidatorStatus(true, date(1)),
+		string(bobVal):   oracletypes.NewValidatorStatus(false, date(1).Add(time.Hour)),
+		string(carolVal): oracletypes.NewValidatorStatus(false, time.Time{}),
+	}
+
+	// The first block indexed records the status of every validator as of its last change.
+	c.oracleBlock(10, date(2), nil, []abci.Event{statusChange(oracletypes.EventTypeDeactivate, aliceVal)})
+	c.keepers.statuses[string(bobVal)] = oracletypes.NewValidatorStatus(true, date(3))
+	c.oracleBlock(11, date(3), nil, nil)
+
+	changes, err := c.index.GetOracleStatusChanges(aliceVal)
+	require.NoError(t, err)
+	require.Equal(t, []telemetrytypes.OracleStatusChange{
+		{Time: date(1), IsActive: true},
+		{Time: date(2), IsActive: false},
+	}, changes)
+	changes, err = c.index.GetOracleStatusChanges(bobVal)
+	require.NoError(t, err)
+	require.Equal(t, []telemetrytypes.OracleStatusChange{{Time: date(1).Add(time.Hour), IsActive: false}}, changes)
+	changes, err = c.index.GetOracleStatusChanges(carolVal)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+}
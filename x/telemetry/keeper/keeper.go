@@ -11,7 +11,6 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"sort"
 	"time"
 )
@@ -22,6 +21,8 @@ type Keeper struct {
 	bankKeeper     bankkeeper.ViewKeeper
 	distrKeeper    telemetrytypes.DistrKeeper
 	stakingQuerier stakingkeeper.Querier
+	oracleKeeper   telemetrytypes.OracleKeeper
+	index          *Index
 }
 
 func NewKeeper(
@@ -30,6 +31,8 @@ func NewKeeper(
 	bk bankkeeper.ViewKeeper,
 	sk stakingkeeper.Keeper,
 	dk distrkeeper.Keeper,
+	ok telemetrytypes.OracleKeeper,
+	index *Index,
 ) Keeper {
 	return Keeper{
 		cdc:         cdc,
@@ -38,10 +41,25 @@ func NewKeeper(
 		stakingQuerier: stakingkeeper.Querier{
 			Keeper: sk,
 		},
-		txCfg: txCfg,
+		oracleKeeper: ok,
+		txCfg:        txCfg,
+		index:        index,
 	}
 }
 
+// pageBounds returns the bounds of the page requested by pagination in a list of the given length, false if the page
+// starts past the end of the list.
+func pageBounds(pagination *query.PageRequest, length uint64) (uint64, uint64, bool) {
+	if pagination.GetOffset() >= length {
+		return 0, 0, false
+	}
+	end := length
+	if pagination.GetOffset()+pagination.GetLimit() < length {
+		end = pagination.GetOffset() + pagination.GetLimit()
+	}
+	return pagination.GetOffset(), end, true
+}
+
 func (k Keeper) GetPaginatedBalances(
 	ctx sdk.Context,
 	denom string,
@@ -58,16 +76,12 @@ func (k Keeper) GetPaginatedBalances(
 		return balances[i].GetCoins().AmountOf(denom).LT(balances[j].GetCoins().AmountOf(denom))
 	})
 
-	if pagination.GetOffset() >= uint64(len(balances)) {
+	start, end, ok := pageBounds(pagination, uint64(len(balances)))
+	if !ok {
 		return []banktypes.Balance{}, 0
 	}
 
-	maxLimit := pagination.GetLimit()
-	if pagination.GetOffset()+pagination.GetLimit() >= uint64(len(balances)) {
-		maxLimit = uint64(len(balances)) - pagination.GetOffset()
-	}
-
-	return balances[pagination.GetOffset() : pagination.GetOffset()+maxLimit], uint64(len(balances))
+	return balances[start:end], uint64(len(balances))
 }
 
 func (k Keeper) GetBalances(ctx sdk.Context, addrs ...sdk.AccAddress) []banktypes.Balance {
@@ -81,113 +95,111 @@ func (k Keeper) GetBalances(ctx sdk.Context, addrs ...sdk.AccAddress) []banktype
 	return balances
 }
 
-func (k Keeper) GetAvgBlockSizePerDay(startDate, endDate *time.Time) ([]telemetrytypes.AverageBlockSizePerDay, error) {
-	blocksByDates, err := k.GetBlocksByDates(startDate, endDate)
+// getBlockStats returns the block statistics from the index.
+func (k Keeper) getBlockStats(
+	startDate, endDate *time.Time,
+	granularity telemetrytypes.Granularity,
+) ([]telemetrytypes.BlockStats, error) {
+	if k.index == nil {
+		return nil, telemetrytypes.ErrIndexDisabled
+	}
+	return k.index.GetBlockStats(startDate, endDate, granularity)
+}
+
+// getValidatorsBlocks returns the number of blocks signed by each validator from the index.
+func (k Keeper) getValidatorsBlocks(startDate, endDate *time.Time) (map[string]uint64, error) {
+	if k.index == nil {
+		return nil, telemetrytypes.ErrIndexDisabled
+	}
+	return k.index.GetValidatorsBlocks(startDate, endDate)
+}
+
+func (k Keeper) GetAvgBlockSize(
+	startDate, endDate *time.Time,
+	granularity telemetrytypes.Granularity,
+) ([]telemetrytypes.AverageBlockSize, error) {
+	blockStats, err := k.getBlockStats(startDate, endDate, granularity)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed to get the blocks by date")
+		return nil, sdkerrors.Wrap(err, "failed to get the block stats")
 	}
 
-	avgBlockSizePerDay := make([]telemetrytypes.AverageBlockSizePerDay, 0, len(blocksByDates))
+	avgBlockSize := make([]telemetrytypes.AverageBlockSize, 0, len(blockStats))
 
-	for key, value := range blocksByDates {
-		totalSize := 0
-		for _, block := range value {
-			totalSize += block.Size()
-		}
-		averageSize := totalSize / len(value)
-		avgBlockSizePerDay = append(avgBlockSizePerDay, telemetrytypes.AverageBlockSizePerDay{
-			Date:  key,
-			Bytes: uint64(averageSize),
+	for _, stats := range blockStats {
+		avgBlockSize = append(avgBlockSize, telemetrytypes.AverageBlockSize{
+			Start: stats.Start,
+			Bytes: stats.BlocksBytes / stats.BlocksCount,
 		})
 	}
 
-	return avgBlockSizePerDay, nil
+	return avgBlockSize, nil
 }
 
-func (k Keeper) GetAvgBlockTimePerDay(startDate, endDate *time.Time) ([]telemetrytypes.AverageBlockTimePerDay, error) {
-	blocksByDates, err := k.GetBlocksByDates(startDate, endDate)
+func (k Keeper) GetAvgBlockTime(
+	startDate, endDate *time.Time,
+	granularity telemetrytypes.Granularity,
+) ([]telemetrytypes.AverageBlockTime, error) {
+	blockStats, err := k.getBlockStats(startDate, endDate, granularity)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed to get the blocks by date")
+		return nil, sdkerrors.Wrap(err, "failed to get the block stats")
 	}
 
-	avgBlockTimePerDay := make([]telemetrytypes.AverageBlockTimePerDay, 0, len(blocksByDates))
-
-	for key, value := range blocksByDates {
-		blocksCount := int64(len(value))
-		commonSeconds := value[blocksCount-1].Header.Time.Unix() - value[0].Header.Time.Unix()
-		averageTime := commonSeconds / blocksCount
+	avgBlockTime := make([]telemetrytypes.AverageBlockTime, 0, len(blockStats))
 
-		avgBlockTimePerDay = append(avgBlockTimePerDay, telemetrytypes.AverageBlockTimePerDay{
-			Date:    key,
-			Seconds: uint64(averageTime),
+	for _, stats := range blockStats {
+		averageTime := stats.BlocksInterval / time.Duration(stats.BlocksCount)
+		avgBlockTime = append(avgBlockTime, telemetrytypes.AverageBlockTime{
+			Start:   stats.Start,
+			Seconds: uint64(averageTime / time.Second),
 		})
 	}
 
-	return avgBlockTimePerDay, nil
+	return avgBlockTime, nil
 }
 
-func (k Keeper) GetAvgTxFeePerDay(startDate, endDate *time.Time) ([]telemetrytypes.AverageTxFeePerDay, error) {
-	blocksByDates, err := k.GetBlocksByDates(startDate, endDate)
+func (k Keeper) GetAvgTxFee(
+	startDate, endDate *time.Time,
+	granularity telemetrytypes.Granularity,
+) ([]telemetrytypes.AverageTxFee, error) {
+	blockStats, err := k.getBlockStats(startDate, endDate, granularity)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed to get the blocks by date")
-	}
-
-	avgTxFeePerDay := make([]telemetrytypes.AverageTxFeePerDay, 0, len(blocksByDates))
-
-	for key, value := range blocksByDates {
-		totalFee := sdk.NewCoins()
-		totalTxCount := 0
-		for _, block := range value {
-			for _, tx := range block.Data.Txs {
-				decodedTx, err := k.txCfg.TxDecoder()(tx)
-				if err != nil {
-					return nil, sdkerrors.Wrap(err, "failed to decode block transaction")
-				}
-
-				feeTx, ok := decodedTx.(sdk.FeeTx)
-				if !ok {
-					return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "failed to retrieve tx fee")
-				}
-
-				txFee := feeTx.GetFee()
-				totalFee = totalFee.Add(txFee...)
-			}
-			totalTxCount += len(block.Data.Txs)
-		}
+		return nil, sdkerrors.Wrap(err, "failed to get the block stats")
+	}
+
+	avgTxFee := make([]telemetrytypes.AverageTxFee, 0, len(blockStats))
 
+	for _, stats := range blockStats {
 		avgFee := sdk.NewCoins()
-		if totalTxCount != 0 {
-			avgFee, _ = sdk.NewDecCoinsFromCoins(totalFee...).QuoDec(sdk.NewDec(int64(totalTxCount))).TruncateDecimal()
+		if stats.TxsCount != 0 {
+			avgFee, _ = sdk.NewDecCoinsFromCoins(stats.TxsFee...).QuoDec(sdk.NewDec(int64(stats.TxsCount))).TruncateDecimal()
 		}
-		avgTxFeePerDay = append(avgTxFeePerDay, telemetrytypes.AverageTxFeePerDay{
-			Date: key,
-			Fee:  avgFee,
+		avgTxFee = append(avgTxFee, telemetrytypes.AverageTxFee{
+			Start: stats.Start,
+			Fee:   avgFee,
 		})
 	}
 
-	return avgTxFeePerDay, nil
+	return avgTxFee, nil
 }
 
-func (k Keeper) GetTxVolumePerDay(startDate, endDate *time.Time) ([]telemetrytypes.TxVolumePerDay, error) {
-	blocksByDates, err := k.GetBlocksByDates(startDate, endDate)
+func (k Keeper) GetTxVolume(
+	startDate, endDate *time.Time,
+	granularity telemetrytypes.Granularity,
+) ([]telemetrytypes.TxVolume, error) {
+	blockStats, err := k.getBlockStats(startDate, endDate, granularity)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed to get the blocks by date")
+		return nil, sdkerrors.Wrap(err, "failed to get the block stats")
 	}
 
-	dailyTxsVolumes := make([]telemetrytypes.TxVolumePerDay, 0, len(blocksByDates))
+	txsVolumes := make([]telemetrytypes.TxVolume, 0, len(blockStats))
 
-	for key, value := range blocksByDates {
-		txsVolume := 0
-		for _, block := range value {
-			txsVolume += len(block.Data.Txs)
-		}
-
-		dailyTxsVolumes = append(dailyTxsVolumes, telemetrytypes.TxVolumePerDay{
-			Date:   key,
-			Volume: uint64(txsVolume),
+	for _, stats := range blockStats {
+		txsVolumes = append(txsVolumes, telemetrytypes.TxVolume{
+			Start:  stats.Start,
+			Volume: stats.TxsCount,
 		})
 	}
-	return dailyTxsVolumes, nil
+	return txsVolumes, nil
 }
 
 func (k Keeper) GetTopValidatorsByBlocks(
@@ -197,45 +209,22 @@ func (k Keeper) GetTopValidatorsByBlocks(
 	pagination *query.PageRequest,
 ) ([]telemetrytypes.ValidatorBlockStats, uint64, error) {
 
-	blocksByDates, err := k.GetBlocksByDates(startDate, endDate)
+	blocksCount, err := k.getValidatorsBlocks(startDate, endDate)
 	if err != nil {
-		return nil, 0, sdkerrors.Wrap(err, "failed to get the blocks by date")
-	}
-
-	blocksCount := make(map[string]uint64, pagination.Limit)
-	for _, blocks := range blocksByDates {
-		for _, block := range blocks {
-			validators, err := k.GetBlockValidators(block.Header.Height)
-			if err != nil {
-				return nil, 0, sdkerrors.Wrap(err, "failed to get block validators")
-			}
-
-			for _, val := range validators {
-				blocksCount[val.Address.String()]++
-			}
-		}
+		return nil, 0, sdkerrors.Wrap(err, "failed to get the validators blocks")
 	}
 
 	validatorsBlocks := make([]telemetrytypes.ValidatorBlockStats, 0, len(blocksCount))
 	totalBondedTokens := k.stakingQuerier.TotalBondedTokens(ctx)
 
-	for addr, blocks := range blocksCount {
-		valAddr, err := sdk.ValAddressFromHex(addr)
-		if err != nil {
-			return nil, 0, sdkerrors.Wrap(err, "failed to retrieve validator address from hex")
-		}
-
-		validatorRequest := &stakingtypes.QueryValidatorRequest{
-			ValidatorAddr: valAddr.String(),
-		}
-
-		validatorResponse, err := k.stakingQuerier.Validator(sdk.WrapSDKContext(ctx), validatorRequest)
-		if err != nil {
-			return nil, 0, sdkerrors.Wrap(err, "failed to get the validator")
+	for consAddr, blocks := range blocksCount {
+		validator, found := k.stakingQuerier.GetValidatorByConsAddr(ctx, sdk.ConsAddress(consAddr))
+		if !found {
+			continue
 		}
 
 		stakePercentage := sdk.NewDecFromIntWithPrec(
-			validatorResponse.Validator.BondedTokens(),
+			validator.BondedTokens(),
 			2,
 		).QuoRoundUp(
 			sdk.NewDecFromIntWithPrec(
@@ -251,7 +240,7 @@ func (k Keeper) GetTopValidatorsByBlocks(
 		validatorsBlocks = append(
 			validatorsBlocks,
 			telemetrytypes.ValidatorBlockStats{
-				ValidatorAddress: valAddr.String(),
+				ValidatorAddress: validator.OperatorAddress,
 				BlocksCount:      blocks,
 				StakePercentage:  stakePercentage,
 			},
@@ -259,24 +248,23 @@ func (k Keeper) GetTopValidatorsByBlocks(
 	}
 
 	sort.Slice(validatorsBlocks, func(i, j int) bool {
+		if validatorsBlocks[i].BlocksCount == validatorsBlocks[j].BlocksCount {
+			return validatorsBlocks[i].ValidatorAddress < validatorsBlocks[j].ValidatorAddress
+		}
 		if desc {
-			return validatorsBlocks[j].BlocksCount < validatorsBlocks[j].BlocksCount
+			return validatorsBlocks[j].BlocksCount < validatorsBlocks[i].BlocksCount
 		}
 		return validatorsBlocks[i].BlocksCount < validatorsBlocks[j].BlocksCount
 	})
 
 	validatorsBlocksLength := uint64(len(validatorsBlocks))
 
-	if pagination.GetOffset() >= validatorsBlocksLength {
+	start, end, ok := pageBounds(pagination, validatorsBlocksLength)
+	if !ok {
 		return []telemetrytypes.ValidatorBlockStats{}, 0, nil
 	}
 
-	maxLimit := pagination.GetLimit()
-	if pagination.GetOffset()+pagination.GetLimit() >= validatorsBlocksLength {
-		maxLimit = validatorsBlocksLength - pagination.GetOffset()
-	}
-
-	return validatorsBlocks[pagination.GetOffset() : pagination.GetOffset()+maxLimit], validatorsBlocksLength, nil
+	return validatorsBlocks[start:end], validatorsBlocksLength, nil
 }
 
 func (k Keeper) GetValidatorBlocks(
@@ -300,14 +288,329 @@ func (k Keeper) GetValidatorBlocks(
 
 	blocksCount := uint64(len(validatorBlocks))
 
-	if pagination.GetOffset() >= blocksCount {
+	start, end, ok := pageBounds(pagination, blocksCount)
+	if !ok {
 		return []telemetrytypes.ValidatorBlock{}, 0, nil
 	}
 
-	maxLimit := pagination.GetLimit()
-	if pagination.GetOffset()+pagination.GetLimit() >= blocksCount {
-		maxLimit = blocksCount - pagination.GetOffset()
+	return validatorBlocks[start:end], blocksCount, nil
+}
+
+func (k Keeper) GetOracleRequests(
+	startDate, endDate *time.Time,
+	granularity telemetrytypes.Granularity,
+) ([]telemetrytypes.OracleRequests, error) {
+	if k.index == nil {
+		return nil, telemetrytypes.ErrIndexDisabled
+	}
+	oracleRequests, err := k.index.GetOracleRequests(startDate, endDate, granularity)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get the oracle requests")
+	}
+	return oracleRequests, nil
+}
+
+func (k Keeper) GetTopDataSources(
+	startDate, endDate *time.Time,
+	desc bool,
+	pagination *query.PageRequest,
+) ([]telemetrytypes.DataSourceStats, uint64, error) {
+
+	if k.index == nil {
+		return nil, 0, telemetrytypes.ErrIndexDisabled
+	}
+	requestsCount, err := k.index.GetDataSourcesRequests(startDate, endDate)
+	if err != nil {
+		return nil, 0, sdkerrors.Wrap(err, "failed to get the data sources requests")
+	}
+
+	dataSources := make([]telemetrytypes.DataSourceStats, 0, len(requestsCount))
+	for id, requests := range requestsCount {
+		dataSources = append(dataSources, telemetrytypes.DataSourceStats{
+			DataSourceID:  id,
+			RequestsCount: requests,
+		})
+	}
+
+	sort.Slice(dataSources, func(i, j int) bool {
+		if dataSources[i].RequestsCount == dataSources[j].RequestsCount {
+			return dataSources[i].DataSourceID < dataSources[j].DataSourceID
+		}
+		if desc {
+			return dataSources[j].RequestsCount < dataSources[i].RequestsCount
+		}
+		return dataSources[i].RequestsCount < dataSources[j].RequestsCount
+	})
+
+	dataSourcesLength := uint64(len(dataSources))
+
+	start, end, ok := pageBounds(pagination, dataSourcesLength)
+	if !ok {
+		return []telemetrytypes.DataSourceStats{}, 0, nil
+	}
+
+	return dataSources[start:end], dataSourcesLength, nil
+}
+
+func (k Keeper) GetReportParticipation(
+	startDate, endDate *time.Time,
+	desc bool,
+	pagination *query.PageRequest,
+) ([]telemetrytypes.ReportParticipation, uint64, error) {
+
+	if k.index == nil {
+		return nil, 0, telemetrytypes.ErrIndexDisabled
+	}
+	reportsCount, err := k.index.GetValidatorsReports(startDate, endDate)
+	if err != nil {
+		return nil, 0, sdkerrors.Wrap(err, "failed to get the validators reports")
+	}
+
+	participation := make([]telemetrytypes.ReportParticipation, 0, len(reportsCount))
+	for valAddr, reports := range reportsCount {
+		ratio := sdk.ZeroDec()
+		if reports.requested != 0 {
+			ratio = sdk.NewDec(int64(reports.reported)).QuoInt64(int64(reports.requested))
+		}
+		participation = append(participation, telemetrytypes.ReportParticipation{
+			ValidatorAddress: sdk.ValAddress(valAddr).String(),
+			RequestedCount:   reports.requested,
+			ReportsCount:     reports.reported,
+			Participation:    ratio,
+		})
+	}
+
+	sort.Slice(participation, func(i, j int) bool {
+		if participation[i].Participation.Equal(participation[j].Participation) {
+			return participation[i].ValidatorAddress < participation[j].ValidatorAddress
+		}
+		if desc {
+			return participation[j].Participation.LT(participation[i].Participation)
+		}
+		return participation[i].Participation.LT(participation[j].Participation)
+	})
+
+	participationLength := uint64(len(participation))
+
+	start, end, ok := pageBounds(pagination, participationLength)
+	if !ok {
+		return []telemetrytypes.ReportParticipation{}, 0, nil
+	}
+
+	return participation[start:end], participationLength, nil
+}
+
+func (k Keeper) GetDataProviderRewards(
+	startDate, endDate *time.Time,
+	granularity telemetrytypes.Granularity,
+) ([]telemetrytypes.DataProviderRewards, error) {
+	if k.index == nil {
+		return nil, telemetrytypes.ErrIndexDisabled
+	}
+	rewards, err := k.index.GetDataProviderRewards(startDate, endDate, granularity)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get the data provider rewards")
+	}
+	return rewards, nil
+}
+
+func (k Keeper) GetActiveAccounts(
+	startDate, endDate *time.Time,
+	granularity telemetrytypes.Granularity,
+) ([]telemetrytypes.ActiveAccounts, error) {
+	if k.index == nil {
+		return nil, telemetrytypes.ErrIndexDisabled
+	}
+	activeAccounts, err := k.index.GetActiveAccounts(startDate, endDate, granularity)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get the active accounts")
+	}
+	return activeAccounts, nil
+}
+
+func (k Keeper) GetAccountGrowth(
+	startDate, endDate *time.Time,
+	granularity telemetrytypes.Granularity,
+) ([]telemetrytypes.AccountGrowth, error) {
+	if k.index == nil {
+		return nil, telemetrytypes.ErrIndexDisabled
+	}
+	accountGrowth, err := k.index.GetAccountGrowth(startDate, endDate, granularity)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get the account growth")
+	}
+	return accountGrowth, nil
+}
+
+func (k Keeper) GetTopAccounts(
+	startDate, endDate *time.Time,
+	desc bool,
+	pagination *query.PageRequest,
+) ([]telemetrytypes.AccountTxs, uint64, error) {
+
+	if k.index == nil {
+		return nil, 0, telemetrytypes.ErrIndexDisabled
+	}
+	txsCount, err := k.index.GetAccountsTxs(startDate, endDate)
+	if err != nil {
+		return nil, 0, sdkerrors.Wrap(err, "failed to get the accounts txs")
+	}
+
+	accounts := make([]telemetrytypes.AccountTxs, 0, len(txsCount))
+	for addr, txs := range txsCount {
+		accounts = append(accounts, telemetrytypes.AccountTxs{
+			Address:  sdk.AccAddress(addr).String(),
+			TxsCount: txs,
+		})
+	}
+
+	sort.Slice(accounts, func(i, j int) bool {
+		if accounts[i].TxsCount == accounts[j].TxsCount {
+			return accounts[i].Address < accounts[j].Address
+		}
+		if desc {
+			return accounts[j].TxsCount < accounts[i].TxsCount
+		}
+		return accounts[i].TxsCount < accounts[j].TxsCount
+	})
+
+	accountsLength := uint64(len(accounts))
+
+	start, end, ok := pageBounds(pagination, accountsLength)
+	if !ok {
+		return []telemetrytypes.AccountTxs{}, 0, nil
+	}
+
+	return accounts[start:end], accountsLength, nil
+}
+
+func (k Keeper) GetSupplyDistribution(
+	denom string,
+	thresholds []sdk.Int,
+	percentiles []uint32,
+) (telemetrytypes.SupplyDistribution, error) {
+	if k.index == nil {
+		return telemetrytypes.SupplyDistribution{}, telemetrytypes.ErrIndexDisabled
+	}
+	distribution, err := k.index.GetSupplyDistribution(denom, thresholds, percentiles)
+	if err != nil {
+		return telemetrytypes.SupplyDistribution{}, sdkerrors.Wrap(err, "failed to get the supply distribution")
+	}
+	return distribution, nil
+}
+
+func (k Keeper) GetValidatorUptime(
+	ctx sdk.Context,
+	valAddr sdk.ValAddress,
+	startDate, endDate *time.Time,
+	granularity telemetrytypes.Granularity,
+) ([]telemetrytypes.ValidatorUptime, []telemetrytypes.OracleStatusChange, error) {
+	validator, found := k.stakingQuerier.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "validator %s", valAddr)
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "failed to get the validator consensus address")
+	}
+
+	if k.index == nil {
+		return nil, nil, telemetrytypes.ErrIndexDisabled
+	}
+	uptime, err := k.index.GetValidatorUptime(consAddr, startDate, endDate, granularity)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "failed to get the validator uptime")
+	}
+	changes, err := k.index.GetOracleStatusChanges(valAddr)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "failed to get the oracle status changes")
+	}
+
+	current := k.oracleKeeper.GetValidatorStatus(ctx, valAddr).IsActive
+	for idx := range uptime {
+		uptime[idx].OracleActive = oracleStatusAt(changes, granularity.Next(uptime[idx].Start), current)
+	}
+
+	var first, last time.Time
+	if startDate != nil {
+		first = granularity.Truncate(*startDate)
+	}
+	if endDate != nil {
+		last = granularity.Next(granularity.Truncate(*endDate))
+	}
+	changesInRange := make([]telemetrytypes.OracleStatusChange, 0, len(changes))
+	for _, change := range changes {
+		if change.Time.Before(first) || (!last.IsZero() && !change.Time.Before(last)) {
+			continue
+		}
+		changesInRange = append(changesInRange, change)
+	}
+
+	return uptime, changesInRange, nil
+}
+
+// oracleStatusAt returns whether the validator was active in the oracle right before the given time, knowing its
+// status changes sorted by time and its current status.
+func oracleStatusAt(changes []telemetrytypes.OracleStatusChange, at time.Time, current bool) bool {
+	// The changes recorded after the time tell the status before them, which is the opposite of the first of them.
+	after := sort.Search(len(changes), func(idx int) bool {
+		return !changes[idx].Time.Before(at)
+	})
+	if after > 0 {
+		return changes[after-1].IsActive
+	}
+	if after < len(changes) {
+		return !changes[after].IsActive
+	}
+	return current
+}
+
+func (k Keeper) GetTopValidatorsByUptime(
+	ctx sdk.Context,
+	startDate, endDate *time.Time,
+	desc bool,
+	pagination *query.PageRequest,
+) ([]telemetrytypes.ValidatorUptimeStats, uint64, error) {
+
+	if k.index == nil {
+		return nil, 0, telemetrytypes.ErrIndexDisabled
+	}
+	uptimes, err := k.index.GetValidatorsUptime(startDate, endDate)
+	if err != nil {
+		return nil, 0, sdkerrors.Wrap(err, "failed to get the validators uptime")
+	}
+
+	validators := make([]telemetrytypes.ValidatorUptimeStats, 0, len(uptimes))
+	for consAddr, uptime := range uptimes {
+		validator, found := k.stakingQuerier.GetValidatorByConsAddr(ctx, sdk.ConsAddress(consAddr))
+		if !found {
+			continue
+		}
+		validators = append(validators, telemetrytypes.ValidatorUptimeStats{
+			ValidatorAddress: validator.OperatorAddress,
+			SignedBlocks:     uptime.SignedBlocks,
+			MissedBlocks:     uptime.MissedBlocks,
+			Uptime:           uptime.Uptime,
+			OracleActive:     k.oracleKeeper.GetValidatorStatus(ctx, validator.GetOperator()).IsActive,
+		})
+	}
+
+	sort.Slice(validators, func(i, j int) bool {
+		if validators[i].Uptime.Equal(validators[j].Uptime) {
+			return validators[i].ValidatorAddress < validators[j].ValidatorAddress
+		}
+		if desc {
+			return validators[j].Uptime.LT(validators[i].Uptime)
+		}
+		return validators[i].Uptime.LT(validators[j].Uptime)
+	})
+
+	validatorsLength := uint64(len(validators))
+
+	start, end, ok := pageBounds(pagination, validatorsLength)
+	if !ok {
+		return []telemetrytypes.ValidatorUptimeStats{}, 0, nil
 	}
 
-	return validatorBlocks[pagination.GetOffset() : pagination.GetOffset()+maxLimit], blocksCount, nil
+	return validators[start:end], validatorsLength, nil
 }
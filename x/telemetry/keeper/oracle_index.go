@@ -0,0 +1,386 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"sort"
+	"strconv"
+	"time"
+
+	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
+	telemetrytypes "github.com/GeoDB-Limited/odin-core/x/telemetry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// oracleActivity is the oracle statistics of a single block until it is committed.
+type oracleActivity struct {
+	oracleScripts map[uint64]*telemetrytypes.OracleScriptStats
+	dataSources   map[uint64]uint64
+	reports       map[string]*validatorReports
+	rewards       telemetrytypes.DataProviderRewards
+	// statuses is whether each validator activated or deactivated in the block is active at its end.
+	statuses map[string]bool
+}
+
+// validatorReports is the number of requests a validator was asked for and the number of reports it sent.
+type validatorReports struct {
+	requested uint64
+	reported  uint64
+}
+
+func newOracleActivity() oracleActivity {
+	return oracleActivity{
+		oracleScripts: make(map[uint64]*telemetrytypes.OracleScriptStats),
+		dataSources:   make(map[uint64]uint64),
+		reports:       make(map[string]*validatorReports),
+		rewards:       telemetrytypes.DataProviderRewards{Amount: sdk.NewCoins()},
+		statuses:      make(map[string]bool),
+	}
+}
+
+func (a oracleActivity) oracleScript(id uint64) *telemetrytypes.OracleScriptStats {
+	stats, ok := a.oracleScripts[id]
+	if !ok {
+		stats = &telemetrytypes.OracleScriptStats{OracleScriptID: id}
+		a.oracleScripts[id] = stats
+	}
+	return stats
+}
+
+func (a oracleActivity) validator(valAddr sdk.ValAddress) *validatorReports {
+	reports, ok := a.reports[string(valAddr)]
+	if !ok {
+		reports = &validatorReports{}
+		a.reports[string(valAddr)] = reports
+	}
+	return reports
+}
+
+// indexOracleEvents adds the requests, reports, resolves, data provider rewards and validator status changes found in
+// the events to the statistics of the block.
+func (i *Index) indexOracleEvents(ctx sdk.Context, events []abci.Event) {
+	if i.block == nil {
+		return
+	}
+	activity := &i.block.oracle
+	for _, event := range events {
+		attrs := make(map[string][]string)
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = append(attrs[string(attr.Key)], string(attr.Value))
+		}
+		switch event.Type {
+		case oracletypes.EventTypeRequest:
+			oid, ok := parseUint(attrs[oracletypes.AttributeKeyOracleScriptID])
+			if !ok {
+				continue
+			}
+			activity.oracleScript(oid).RequestsCount++
+			for _, val := range attrs[oracletypes.AttributeKeyValidator] {
+				if valAddr, err := sdk.ValAddressFromBech32(val); err == nil {
+					activity.validator(valAddr).requested++
+				}
+			}
+		case oracletypes.EventTypeRawRequest:
+			if did, ok := parseUint(attrs[oracletypes.AttributeKeyDataSourceID]); ok {
+				activity.dataSources[did]++
+			}
+		case oracletypes.EventTypeReport:
+			for _, val := range attrs[oracletypes.AttributeKeyValidator] {
+				if valAddr, err := sdk.ValAddressFromBech32(val); err == nil {
+					activity.validator(valAddr).reported++
+				}
+			}
+		case oracletypes.EventTypeResolve:
+			rid, ok := parseUint(attrs[oracletypes.AttributeKeyID])
+			if !ok {
+				continue
+			}
+			status, ok := parseUint(attrs[oracletypes.AttributeKeyResolveStatus])
+			if !ok {
+				continue
+			}
+			req := i.oracleKeeper.MustGetRequest(ctx, oracletypes.RequestID(rid))
+			stats := activity.oracleScript(uint64(req.OracleScriptID))
+			switch oracletypes.ResolveStatus(status) {
+			case oracletypes.RESOLVE_STATUS_SUCCESS:
+				stats.SuccessCount++
+				stats.ResolveBlocks += uint64(ctx.BlockHeight() - req.RequestHeight)
+			case oracletypes.RESOLVE_STATUS_FAILURE:
+				stats.FailureCount++
+				stats.ResolveBlocks += uint64(ctx.BlockHeight() - req.RequestHeight)
+			case oracletypes.RESOLVE_STATUS_EXPIRED:
+				stats.ExpiredCount++
+			}
+		case oracletypes.EventTypeDataProviderReward:
+			if len(attrs[oracletypes.AttributeKeyAmount]) == 0 {
+				continue
+			}
+			amount, err := sdk.ParseCoinsNormalized(attrs[oracletypes.AttributeKeyAmount][0])
+			if err != nil {
+				continue
+			}
+			activity.rewards.Amount = activity.rewards.Amount.Add(amount...)
+			activity.rewards.PayoutsCount++
+		case oracletypes.EventTypeActivate, oracletypes.EventTypeDeactivate:
+			for _, val := range attrs[oracletypes.AttributeKeyValidator] {
+				if valAddr, err := sdk.ValAddressFromBech32(val); err == nil {
+					activity.statuses[string(valAddr)] = event.Type == oracletypes.EventTypeActivate
+				}
+			}
+		}
+	}
+}
+
+func parseUint(values []string) (uint64, bool) {
+	if len(values) == 0 {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(values[0], 10, 64)
+	return n, err == nil
+}
+
+// setOracleActivity adds the oracle statistics of the block to its hour.
+func (i *Index) setOracleActivity(batch dbm.Batch, hour time.Time, activity oracleActivity) error {
+	for id, stats := range activity.oracleScripts {
+		key := telemetrytypes.OracleScriptStatsKey(hour, id)
+		hourly := telemetrytypes.OracleScriptStats{OracleScriptID: id}
+		if err := i.get(key, &hourly); err != nil {
+			return err
+		}
+		hourly.RequestsCount += stats.RequestsCount
+		hourly.SuccessCount += stats.SuccessCount
+		hourly.FailureCount += stats.FailureCount
+		hourly.ExpiredCount += stats.ExpiredCount
+		hourly.ResolveBlocks += stats.ResolveBlocks
+		bz, err := i.cdc.Marshal(&hourly)
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(key, bz); err != nil {
+			return err
+		}
+	}
+	for id, count := range activity.dataSources {
+		key := telemetrytypes.DataSourceRequestsKey(hour, id)
+		bz, err := i.db.Get(key)
+		if err != nil {
+			return err
+		}
+		if bz != nil {
+			count += binary.BigEndian.Uint64(bz)
+		}
+		if err := batch.Set(key, sdk.Uint64ToBigEndian(count)); err != nil {
+			return err
+		}
+	}
+	for valAddr, reports := range activity.reports {
+		key := telemetrytypes.ValidatorReportsKey(hour, sdk.ValAddress(valAddr))
+		bz, err := i.db.Get(key)
+		if err != nil {
+			return err
+		}
+		hourly := decodeValidatorReports(bz)
+		hourly.requested += reports.requested
+		hourly.reported += reports.reported
+		if err := batch.Set(key, hourly.encode()); err != nil {
+			return err
+		}
+	}
+	if activity.rewards.PayoutsCount > 0 {
+		key := telemetrytypes.DataProviderRewardsKey(hour)
+		hourly := telemetrytypes.DataProviderRewards{Start: hour}
+		if err := i.get(key, &hourly); err != nil {
+			return err
+		}
+		hourly.Amount = hourly.Amount.Add(activity.rewards.Amount...)
+		hourly.PayoutsCount += activity.rewards.PayoutsCount
+		bz, err := i.cdc.Marshal(&hourly)
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(key, bz); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r validatorReports) encode() []byte {
+	return append(sdk.Uint64ToBigEndian(r.requested), sdk.Uint64ToBigEndian(r.reported)...)
+}
+
+func decodeValidatorReports(bz []byte) validatorReports {
+	if len(bz) != 16 {
+		return validatorReports{}
+	}
+	return validatorReports{
+		requested: binary.BigEndian.Uint64(bz[:8]),
+		reported:  binary.BigEndian.Uint64(bz[8:]),
+	}
+}
+
+// GetOracleRequests returns the oracle requests of the time buckets of the given granularity between the buckets
+// containing startDate and endDate inclusive, sorted by time. The oracle scripts of each bucket are sorted by ID.
+func (i *Index) GetOracleRequests(
+	startDate, endDate *time.Time,
+	granularity telemetrytypes.Granularity,
+) ([]telemetrytypes.OracleRequests, error) {
+	start, end, err := dateRange(telemetrytypes.OracleScriptStatsKeyPrefix, startDate, endDate, granularity)
+	if err != nil {
+		return nil, err
+	}
+	it, err := i.db.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var requests []telemetrytypes.OracleRequests
+	var scripts map[uint64]*telemetrytypes.OracleScriptStats
+	for ; it.Valid(); it.Next() {
+		var hourly telemetrytypes.OracleScriptStats
+		if err := i.cdc.Unmarshal(it.Value(), &hourly); err != nil {
+			return nil, err
+		}
+		hour, err := keyHour(telemetrytypes.OracleScriptStatsKeyPrefix, it.Key())
+		if err != nil {
+			return nil, err
+		}
+		// Hours come sorted, so an hour either falls in the last bucket or starts a new one.
+		start := granularity.Truncate(hour)
+		if len(requests) == 0 || !requests[len(requests)-1].Start.Equal(start) {
+			if len(requests) > 0 {
+				requests[len(requests)-1].OracleScripts = sortedOracleScripts(scripts)
+			}
+			requests = append(requests, telemetrytypes.OracleRequests{Start: start})
+			scripts = make(map[uint64]*telemetrytypes.OracleScriptStats)
+		}
+		bucket := &requests[len(requests)-1]
+		bucket.RequestsCount += hourly.RequestsCount
+		bucket.SuccessCount += hourly.SuccessCount
+		bucket.FailureCount += hourly.FailureCount
+		bucket.ExpiredCount += hourly.ExpiredCount
+		stats, ok := scripts[hourly.OracleScriptID]
+		if !ok {
+			stats = &telemetrytypes.OracleScriptStats{OracleScriptID: hourly.OracleScriptID}
+			scripts[hourly.OracleScriptID] = stats
+		}
+		stats.RequestsCount += hourly.RequestsCount
+		stats.SuccessCount += hourly.SuccessCount
+		stats.FailureCount += hourly.FailureCount
+		stats.ExpiredCount += hourly.ExpiredCount
+		stats.ResolveBlocks += hourly.ResolveBlocks
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	if len(requests) > 0 {
+		requests[len(requests)-1].OracleScripts = sortedOracleScripts(scripts)
+	}
+
+	for idx := range requests {
+		bucket := &requests[idx]
+		var resolveBlocks uint64
+		for _, stats := range bucket.OracleScripts {
+			resolveBlocks += stats.ResolveBlocks
+		}
+		bucket.AvgResolveBlocks = sdk.ZeroDec()
+		if resolved := bucket.SuccessCount + bucket.FailureCount; resolved > 0 {
+			bucket.AvgResolveBlocks = sdk.NewDec(int64(resolveBlocks)).QuoInt64(int64(resolved))
+		}
+	}
+	return requests, nil
+}
+
+func sortedOracleScripts(scripts map[uint64]*telemetrytypes.OracleScriptStats) []telemetrytypes.OracleScriptStats {
+	sorted := make([]telemetrytypes.OracleScriptStats, 0, len(scripts))
+	for _, stats := range scripts {
+		sorted = append(sorted, *stats)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].OracleScriptID < sorted[j].OracleScriptID
+	})
+	return sorted
+}
+
+// GetDataSourcesRequests returns the number of raw requests of each data source between startDate and endDate
+// inclusive, keyed by data source ID.
+func (i *Index) GetDataSourcesRequests(startDate, endDate *time.Time) (map[uint64]uint64, error) {
+	start, end, err := dateRange(telemetrytypes.DataSourceRequestsKeyPrefix, startDate, endDate, telemetrytypes.GRANULARITY_DAY)
+	if err != nil {
+		return nil, err
+	}
+	it, err := i.db.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	dateLen := len(sdk.FormatTimeBytes(time.Time{}))
+	requests := make(map[uint64]uint64)
+	for ; it.Valid(); it.Next() {
+		id := sdk.BigEndianToUint64(it.Key()[len(telemetrytypes.DataSourceRequestsKeyPrefix)+dateLen:])
+		requests[id] += binary.BigEndian.Uint64(it.Value())
+	}
+	return requests, it.Error()
+}
+
+// GetValidatorsReports returns the number of requests each validator was asked for and the number of reports it
+// sent between startDate and endDate inclusive, keyed by operator address.
+func (i *Index) GetValidatorsReports(startDate, endDate *time.Time) (map[string]validatorReports, error) {
+	start, end, err := dateRange(telemetrytypes.ValidatorReportsKeyPrefix, startDate, endDate, telemetrytypes.GRANULARITY_DAY)
+	if err != nil {
+		return nil, err
+	}
+	it, err := i.db.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	dateLen := len(sdk.FormatTimeBytes(time.Time{}))
+	reports := make(map[string]validatorReports)
+	for ; it.Valid(); it.Next() {
+		valAddr := string(it.Key()[len(telemetrytypes.ValidatorReportsKeyPrefix)+dateLen:])
+		hourly := decodeValidatorReports(it.Value())
+		total := reports[valAddr]
+		total.requested += hourly.requested
+		total.reported += hourly.reported
+		reports[valAddr] = total
+	}
+	return reports, it.Error()
+}
+
+// GetDataProviderRewards returns the rewards paid out to data providers in the time buckets of the given
+// granularity between the buckets containing startDate and endDate inclusive, sorted by time.
+func (i *Index) GetDataProviderRewards(
+	startDate, endDate *time.Time,
+	granularity telemetrytypes.Granularity,
+) ([]telemetrytypes.DataProviderRewards, error) {
+	start, end, err := dateRange(telemetrytypes.DataProviderRewardsKeyPrefix, startDate, endDate, granularity)
+	if err != nil {
+		return nil, err
+	}
+	it, err := i.db.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var rewards []telemetrytypes.DataProviderRewards
+	for ; it.Valid(); it.Next() {
+		var hourly telemetrytypes.DataProviderRewards
+		if err := i.cdc.Unmarshal(it.Value(), &hourly); err != nil {
+			return nil, err
+		}
+		start := granularity.Truncate(hourly.Start)
+		if len(rewards) == 0 || !rewards[len(rewards)-1].Start.Equal(start) {
+			rewards = append(rewards, telemetrytypes.DataProviderRewards{Start: start, Amount: sdk.NewCoins()})
+		}
+		bucket := &rewards[len(rewards)-1]
+		bucket.Amount = bucket.Amount.Add(hourly.Amount...)
+		bucket.PayoutsCount += hourly.PayoutsCount
+	}
+	return rewards, it.Error()
+}
@@ -19,20 +19,40 @@ func NewQuerier(keeper Keeper, cdc *codec.LegacyAmino) sdk.Querier {
 			return queryTopBalances(ctx, path[1:], keeper, cdc, req)
 		case telemetrytypes.QueryExtendedValidators:
 			return queryExtendedValidators(ctx, path[1:], keeper, cdc, req)
-		/*case telemetrytypes.QueryAvgBlockSize:
+		case telemetrytypes.QueryAvgBlockSize:
 			return queryAvgBlockSize(ctx, path[1:], keeper, cdc, req)
 		case telemetrytypes.QueryAvgBlockTime:
 			return queryAvgBlockTime(ctx, path[1:], keeper, cdc, req)
 		case telemetrytypes.QueryAvgTxFee:
 			return queryAvgTxFee(ctx, path[1:], keeper, cdc, req)
 		case telemetrytypes.QueryTxVolume:
-			return queryTxVolume(ctx, path[1:], keeper, cdc, req)*/
+			return queryTxVolume(ctx, path[1:], keeper, cdc, req)
 		case telemetrytypes.QueryValidatorBlocks:
 			return queryValidatorBlocks(ctx, path[1:], keeper, cdc, req)
 		case telemetrytypes.QueryTopValidators:
 			return queryTopValidators(ctx, path[1:], keeper, cdc, req)
 		case telemetrytypes.QueryValidatorByConsAddress:
 			return queryValidatorByConsAddr(ctx, path[1:], keeper, cdc)
+		case telemetrytypes.QueryOracleRequests:
+			return queryOracleRequests(ctx, path[1:], keeper, cdc, req)
+		case telemetrytypes.QueryTopDataSources:
+			return queryTopDataSources(ctx, path[1:], keeper, cdc, req)
+		case telemetrytypes.QueryReportParticipation:
+			return queryReportParticipation(ctx, path[1:], keeper, cdc, req)
+		case telemetrytypes.QueryDataProviderRewards:
+			return queryDataProviderRewards(ctx, path[1:], keeper, cdc, req)
+		case telemetrytypes.QueryActiveAccounts:
+			return queryActiveAccounts(ctx, path[1:], keeper, cdc, req)
+		case telemetrytypes.QueryAccountGrowth:
+			return queryAccountGrowth(ctx, path[1:], keeper, cdc, req)
+		case telemetrytypes.QueryTopAccounts:
+			return queryTopAccounts(ctx, path[1:], keeper, cdc, req)
+		case telemetrytypes.QuerySupplyDistribution:
+			return querySupplyDistribution(ctx, path[1:], keeper, cdc, req)
+		case telemetrytypes.QueryValidatorUptime:
+			return queryValidatorUptime(ctx, path[1:], keeper, cdc, req)
+		case telemetrytypes.QueryTopValidatorsByUptime:
+			return queryTopValidatorsByUptime(ctx, path[1:], keeper, cdc, req)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown telemetry query endpoint")
 		}
@@ -110,12 +130,12 @@ func queryAvgBlockSize(
 	if err := cdc.UnmarshalJSON(req.Data, &request); err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
-	blockSizePerDay, err := k.GetAvgBlockSizePerDay(request.GetStartDate(), request.GetEndDate())
+	avgBlockSize, err := k.GetAvgBlockSize(request.GetStartDate(), request.GetEndDate(), request.GetGranularity())
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed to get average block size per day")
+		return nil, sdkerrors.Wrap(err, "failed to get average block size")
 	}
 	return commontypes.QueryOK(cdc, telemetrytypes.QueryAvgBlockSizeResponse{
-		AvgBlockSizePerDay: blockSizePerDay,
+		AvgBlockSize: avgBlockSize,
 	})
 }
 
@@ -130,13 +150,13 @@ func queryAvgBlockTime(
 	if err := cdc.UnmarshalJSON(req.Data, &request); err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
-	blockTimePerDay, err := k.GetAvgBlockTimePerDay(request.GetStartDate(), request.GetEndDate())
+	avgBlockTime, err := k.GetAvgBlockTime(request.GetStartDate(), request.GetEndDate(), request.GetGranularity())
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed to get average block time per day")
+		return nil, sdkerrors.Wrap(err, "failed to get average block time")
 	}
 
 	return commontypes.QueryOK(cdc, telemetrytypes.QueryAvgBlockTimeResponse{
-		AvgBlockTimePerDay: blockTimePerDay,
+		AvgBlockTime: avgBlockTime,
 	})
 }
 
@@ -145,12 +165,12 @@ func queryAvgTxFee(_ sdk.Context, _ []string, k Keeper, cdc *codec.LegacyAmino,
 	if err := cdc.UnmarshalJSON(req.Data, &request); err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
-	avgTxFee, err := k.GetAvgTxFeePerDay(request.GetStartDate(), request.GetEndDate())
+	avgTxFee, err := k.GetAvgTxFee(request.GetStartDate(), request.GetEndDate(), request.GetGranularity())
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed to get average tx fee per day")
+		return nil, sdkerrors.Wrap(err, "failed to get average tx fee")
 	}
 	return commontypes.QueryOK(cdc, telemetrytypes.QueryAvgTxFeeResponse{
-		AvgTxFeePerDay: avgTxFee,
+		AvgTxFee: avgTxFee,
 	})
 }
 
@@ -159,13 +179,13 @@ func queryTxVolume(_ sdk.Context, _ []string, k Keeper, cdc *codec.LegacyAmino,
 	if err := cdc.UnmarshalJSON(req.Data, &request); err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
-	txVolume, err := k.GetTxVolumePerDay(request.GetStartDate(), request.GetEndDate())
+	txVolume, err := k.GetTxVolume(request.GetStartDate(), request.GetEndDate(), request.GetGranularity())
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to get tx volume")
 	}
 
 	return commontypes.QueryOK(cdc, telemetrytypes.QueryTxVolumeResponse{
-		TxVolumePerDay: txVolume,
+		TxVolume: txVolume,
 	})
 }
 
@@ -248,3 +268,228 @@ func queryValidatorByConsAddr(
 
 	return commontypes.QueryOK(cdc, validator.Validator)
 }
+
+func queryOracleRequests(
+	_ sdk.Context, _ []string, k Keeper, cdc *codec.LegacyAmino, req abci.RequestQuery,
+) ([]byte, error) {
+	var request telemetrytypes.QueryOracleRequestsRequest
+	if err := cdc.UnmarshalJSON(req.Data, &request); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	oracleRequests, err := k.GetOracleRequests(request.GetStartDate(), request.GetEndDate(), request.GetGranularity())
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get oracle requests")
+	}
+
+	return commontypes.QueryOK(cdc, telemetrytypes.QueryOracleRequestsResponse{
+		OracleRequests: oracleRequests,
+	})
+}
+
+func queryTopDataSources(
+	_ sdk.Context, _ []string, k Keeper, cdc *codec.LegacyAmino, req abci.RequestQuery,
+) ([]byte, error) {
+	var request telemetrytypes.QueryTopDataSourcesRequest
+	if err := cdc.UnmarshalJSON(req.Data, &request); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	dataSources, total, err := k.GetTopDataSources(
+		request.GetStartDate(),
+		request.GetEndDate(),
+		request.GetDesc(),
+		request.GetPagination(),
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get top data sources")
+	}
+
+	return commontypes.QueryOK(cdc, telemetrytypes.QueryTopDataSourcesResponse{
+		DataSources: dataSources,
+		Pagination: &query.PageResponse{
+			Total: total,
+		},
+	})
+}
+
+func queryReportParticipation(
+	_ sdk.Context, _ []string, k Keeper, cdc *codec.LegacyAmino, req abci.RequestQuery,
+) ([]byte, error) {
+	var request telemetrytypes.QueryReportParticipationRequest
+	if err := cdc.UnmarshalJSON(req.Data, &request); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	validators, total, err := k.GetReportParticipation(
+		request.GetStartDate(),
+		request.GetEndDate(),
+		request.GetDesc(),
+		request.GetPagination(),
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get report participation")
+	}
+
+	return commontypes.QueryOK(cdc, telemetrytypes.QueryReportParticipationResponse{
+		Validators: validators,
+		Pagination: &query.PageResponse{
+			Total: total,
+		},
+	})
+}
+
+func queryDataProviderRewards(
+	_ sdk.Context, _ []string, k Keeper, cdc *codec.LegacyAmino, req abci.RequestQuery,
+) ([]byte, error) {
+	var request telemetrytypes.QueryDataProviderRewardsRequest
+	if err := cdc.UnmarshalJSON(req.Data, &request); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	rewards, err := k.GetDataProviderRewards(request.GetStartDate(), request.GetEndDate(), request.GetGranularity())
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get data provider rewards")
+	}
+
+	return commontypes.QueryOK(cdc, telemetrytypes.QueryDataProviderRewardsResponse{
+		DataProviderRewards: rewards,
+	})
+}
+
+func queryActiveAccounts(
+	_ sdk.Context, _ []string, k Keeper, cdc *codec.LegacyAmino, req abci.RequestQuery,
+) ([]byte, error) {
+	var request telemetrytypes.QueryActiveAccountsRequest
+	if err := cdc.UnmarshalJSON(req.Data, &request); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	activeAccounts, err := k.GetActiveAccounts(request.GetStartDate(), request.GetEndDate(), request.GetGranularity())
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get active accounts")
+	}
+
+	return commontypes.QueryOK(cdc, telemetrytypes.QueryActiveAccountsResponse{
+		ActiveAccounts: activeAccounts,
+	})
+}
+
+func queryAccountGrowth(
+	_ sdk.Context, _ []string, k Keeper, cdc *codec.LegacyAmino, req abci.RequestQuery,
+) ([]byte, error) {
+	var request telemetrytypes.QueryAccountGrowthRequest
+	if err := cdc.UnmarshalJSON(req.Data, &request); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	accountGrowth, err := k.GetAccountGrowth(request.GetStartDate(), request.GetEndDate(), request.GetGranularity())
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get account growth")
+	}
+
+	return commontypes.QueryOK(cdc, telemetrytypes.QueryAccountGrowthResponse{
+		AccountGrowth: accountGrowth,
+	})
+}
+
+func queryTopAccounts(
+	_ sdk.Context, _ []string, k Keeper, cdc *codec.LegacyAmino, req abci.RequestQuery,
+) ([]byte, error) {
+	var request telemetrytypes.QueryTopAccountsRequest
+	if err := cdc.UnmarshalJSON(req.Data, &request); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	accounts, total, err := k.GetTopAccounts(
+		request.GetStartDate(),
+		request.GetEndDate(),
+		request.GetDesc(),
+		request.GetPagination(),
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get top accounts")
+	}
+
+	return commontypes.QueryOK(cdc, telemetrytypes.QueryTopAccountsResponse{
+		Accounts: accounts,
+		Pagination: &query.PageResponse{
+			Total: total,
+		},
+	})
+}
+
+func querySupplyDistribution(
+	_ sdk.Context, path []string, k Keeper, cdc *codec.LegacyAmino, req abci.RequestQuery,
+) ([]byte, error) {
+	if len(path) != 1 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "denom not specified")
+	}
+	var request telemetrytypes.QuerySupplyDistributionRequest
+	if len(req.Data) != 0 {
+		if err := cdc.UnmarshalJSON(req.Data, &request); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+	distribution, err := k.GetSupplyDistribution(path[0], request.Thresholds, request.Percentiles)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get supply distribution")
+	}
+
+	return commontypes.QueryOK(cdc, telemetrytypes.QuerySupplyDistributionResponse{
+		SupplyDistribution: distribution,
+	})
+}
+
+func queryValidatorUptime(
+	ctx sdk.Context, _ []string, k Keeper, cdc *codec.LegacyAmino, req abci.RequestQuery,
+) ([]byte, error) {
+	var request telemetrytypes.QueryValidatorUptimeRequest
+	if err := cdc.UnmarshalJSON(req.Data, &request); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	address, err := sdk.ValAddressFromBech32(request.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	uptime, changes, err := k.GetValidatorUptime(
+		ctx,
+		address,
+		request.GetStartDate(),
+		request.GetEndDate(),
+		request.GetGranularity(),
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get validator uptime")
+	}
+
+	return commontypes.QueryOK(cdc, telemetrytypes.QueryValidatorUptimeResponse{
+		Uptime:              uptime,
+		OracleStatusChanges: changes,
+	})
+}
+
+func queryTopValidatorsByUptime(
+	ctx sdk.Context, _ []string, k Keeper, cdc *codec.LegacyAmino, req abci.RequestQuery,
+) ([]byte, error) {
+	var request telemetrytypes.QueryTopValidatorsByUptimeRequest
+	if err := cdc.UnmarshalJSON(req.Data, &request); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	validators, total, err := k.GetTopValidatorsByUptime(
+		ctx,
+		request.GetStartDate(),
+		request.GetEndDate(),
+		request.GetDesc(),
+		request.GetPagination(),
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to get top validators by uptime")
+	}
+
+	return commontypes.QueryOK(cdc, telemetrytypes.QueryTopValidatorsByUptimeResponse{
+		Validators: validators,
+		Pagination: &query.PageResponse{
+			Total: total,
+		},
+	})
+}
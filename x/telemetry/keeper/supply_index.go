@@ -0,0 +1,300 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	telemetrytypes "github.com/GeoDB-Limited/odin-core/x/telemetry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// SupplySnapshotInterval is the number of blocks between two supply snapshots.
+const SupplySnapshotInterval = 600
+
+// DefaultPercentiles are the percentiles of the supply distribution when none are requested.
+var DefaultPercentiles = []uint32{50, 90, 99}
+
+// supplySnapshotDue returns whether the supply is to be snapshotted at the given height, which is the case every
+// SupplySnapshotInterval blocks and as soon as possible when there is no snapshot yet nor one being taken.
+func (i *Index) supplySnapshotDue(height int64) (bool, error) {
+	if height%SupplySnapshotInterval == 0 {
+		return true, nil
+	}
+	if i.supplySnapshotted {
+		return false, nil
+	}
+	it, err := i.db.Iterator(telemetrytypes.SupplySnapshotKeyPrefix, sdk.PrefixEndBytes(telemetrytypes.SupplySnapshotKeyPrefix))
+	if err != nil {
+		return false, err
+	}
+	defer it.Close()
+	i.supplySnapshotted = it.Valid()
+	return !i.supplySnapshotted, it.Error()
+}
+
+// startSupplySnapshot starts taking the scheduled supply snapshot in the background unless the previous one is still
+// being taken, in which case it is started at a later block.
+func (i *Index) startSupplySnapshot() {
+	if i.snapshotHeight == 0 {
+		return
+	}
+	if i.snapshotDone != nil {
+		select {
+		case <-i.snapshotDone:
+		default:
+			return
+		}
+	}
+	height, blockTime := i.snapshotHeight, i.snapshotTime
+	done := make(chan struct{})
+	i.snapshotHeight, i.snapshotTime, i.snapshotDone = 0, time.Time{}, done
+	i.supplySnapshotted = true
+	go func() {
+		defer close(done)
+		if err := i.takeSupplySnapshot(height, blockTime); err != nil {
+			i.logger.Error("Failed to snapshot supply", "height", height, "err", err)
+		}
+	}()
+}
+
+// takeSupplySnapshot replaces the last supply snapshot with the one of the state committed at the given height.
+func (i *Index) takeSupplySnapshot(height int64, blockTime time.Time) error {
+	ctx, err := i.queryContext(height)
+	if err != nil {
+		return err
+	}
+	snapshots, balances := i.snapshotSupply(ctx, height, blockTime)
+	batch := i.db.NewBatch()
+	defer batch.Close()
+	if err := i.setSupplySnapshot(batch, snapshots, balances); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// snapshotSupply returns the holdings of every denomination of the total supply at the end of the block, sorted by
+// denomination, along with the non-zero balances of the user accounts sorted ascending, keyed by denomination.
+func (i *Index) snapshotSupply(
+	ctx sdk.Context,
+	height int64,
+	blockTime time.Time,
+) ([]telemetrytypes.DenomSnapshot, map[string][]sdk.Int) {
+	treasuryPool := i.mintKeeper.GetMintPool(ctx).TreasuryPool
+	communityPool, _ := i.distrKeeper.GetFeePoolCommunityCoins(ctx).TruncateDecimal()
+
+	snapshots := make(map[string]*telemetrytypes.DenomSnapshot)
+	i.bankKeeper.IterateTotalSupply(ctx, func(supply sdk.Coin) bool {
+		snapshots[supply.Denom] = &telemetrytypes.DenomSnapshot{
+			Denom:          supply.Denom,
+			Height:         height,
+			Time:           blockTime,
+			TotalSupply:    supply.Amount,
+			TreasuryPool:   treasuryPool.AmountOf(supply.Denom),
+			CommunityPool:  communityPool.AmountOf(supply.Denom),
+			ModuleAccounts: sdk.ZeroInt(),
+		}
+		return false
+	})
+
+	balances := make(map[string][]sdk.Int)
+	moduleAccounts := make(map[string]bool)
+	i.bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, balance sdk.Coin) bool {
+		snapshot, ok := snapshots[balance.Denom]
+		if !ok || !balance.IsPositive() {
+			return false
+		}
+		isModule, ok := moduleAccounts[string(addr)]
+		if !ok {
+			_, isModule = i.accountKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI)
+			moduleAccounts[string(addr)] = isModule
+		}
+		if isModule {
+			snapshot.ModuleAccounts = snapshot.ModuleAccounts.Add(balance.Amount)
+		} else {
+			balances[balance.Denom] = append(balances[balance.Denom], balance.Amount)
+		}
+		return false
+	})
+
+	sorted := make([]telemetrytypes.DenomSnapshot, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		sorted = append(sorted, *snapshot)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Denom < sorted[j].Denom
+	})
+	for _, denomBalances := range balances {
+		sort.Slice(denomBalances, func(i, j int) bool {
+			return denomBalances[i].LT(denomBalances[j])
+		})
+	}
+	return sorted, balances
+}
+
+// setSupplySnapshot replaces the last supply snapshot with the given one, storing each user balance under its rank.
+func (i *Index) setSupplySnapshot(
+	batch dbm.Batch,
+	snapshots []telemetrytypes.DenomSnapshot,
+	balances map[string][]sdk.Int,
+) error {
+	if err := i.deletePrefix(batch, telemetrytypes.SupplySnapshotKeyPrefix); err != nil {
+		return err
+	}
+	if err := i.deletePrefix(batch, telemetrytypes.SupplyHolderKeyPrefix); err != nil {
+		return err
+	}
+	for idx := range snapshots {
+		denom := snapshots[idx].Denom
+		bz, err := i.cdc.Marshal(&snapshots[idx])
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(telemetrytypes.SupplySnapshotKey(denom), bz); err != nil {
+			return err
+		}
+		for rank, balance := range balances[denom] {
+			bz, err := balance.Marshal()
+			if err != nil {
+				return err
+			}
+			if err := batch.Set(telemetrytypes.SupplyHolderKey(denom, uint64(rank)), bz); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// deletePrefix deletes the entries whose key starts with the prefix.
+func (i *Index) deletePrefix(batch dbm.Batch, prefix []byte) error {
+	it, err := i.db.Iterator(prefix, sdk.PrefixEndBytes(prefix))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if err := batch.Delete(it.Key()); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// getSupplyHolders returns the user balances of the last supply snapshot of the denomination, sorted ascending.
+func (i *Index) getSupplyHolders(denom string) ([]sdk.Int, error) {
+	prefix := telemetrytypes.SupplyHoldersPrefix(denom)
+	it, err := i.db.Iterator(prefix, sdk.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	var balances []sdk.Int
+	for ; it.Valid(); it.Next() {
+		var balance sdk.Int
+		if err := balance.Unmarshal(it.Value()); err != nil {
+			return nil, err
+		}
+		balances = append(balances, balance)
+	}
+	return balances, it.Error()
+}
+
+// GetSupplyDistribution returns how the supply of the denomination was distributed at the last supply snapshot,
+// counting the user accounts holding at least each threshold and computing the cut-offs of the percentiles.
+func (i *Index) GetSupplyDistribution(
+	denom string,
+	thresholds []sdk.Int,
+	percentiles []uint32,
+) (telemetrytypes.SupplyDistribution, error) {
+	var snapshot telemetrytypes.DenomSnapshot
+	bz, err := i.db.Get(telemetrytypes.SupplySnapshotKey(denom))
+	if err != nil {
+		return telemetrytypes.SupplyDistribution{}, err
+	}
+	if bz == nil {
+		return telemetrytypes.SupplyDistribution{}, sdkerrors.Wrapf(telemetrytypes.ErrSnapshotNotFound, "denom: %s", denom)
+	}
+	if err := i.cdc.Unmarshal(bz, &snapshot); err != nil {
+		return telemetrytypes.SupplyDistribution{}, err
+	}
+	if len(percentiles) == 0 {
+		percentiles = DefaultPercentiles
+	}
+	for _, percentile := range percentiles {
+		if percentile == 0 || percentile > 100 {
+			return telemetrytypes.SupplyDistribution{}, sdkerrors.Wrapf(
+				telemetrytypes.ErrInvalidPercentile, "%d is not between 1 and 100", percentile,
+			)
+		}
+	}
+
+	balances, err := i.getSupplyHolders(denom)
+	if err != nil {
+		return telemetrytypes.SupplyDistribution{}, err
+	}
+	users := sdk.ZeroInt()
+	for _, balance := range balances {
+		users = users.Add(balance)
+	}
+	distribution := telemetrytypes.SupplyDistribution{
+		Denom:               snapshot.Denom,
+		Height:              snapshot.Height,
+		Time:                snapshot.Time,
+		TotalSupply:         snapshot.TotalSupply,
+		Circulating:         snapshot.TotalSupply.Sub(snapshot.TreasuryPool).Sub(snapshot.CommunityPool),
+		TreasuryPool:        snapshot.TreasuryPool,
+		CommunityPool:       snapshot.CommunityPool,
+		ModuleAccountsShare: share(snapshot.ModuleAccounts, snapshot.TotalSupply),
+		UsersShare:          share(users, snapshot.TotalSupply),
+		HoldersCount:        uint64(len(balances)),
+		Gini:                gini(balances, users),
+	}
+	for _, threshold := range thresholds {
+		// Balances are sorted ascending, so the holders of at least the threshold are the ones after the first of them.
+		first := sort.Search(len(balances), func(idx int) bool {
+			return balances[idx].GTE(threshold)
+		})
+		distribution.Thresholds = append(distribution.Thresholds, telemetrytypes.HoldersThreshold{
+			Amount:       threshold,
+			HoldersCount: uint64(len(balances) - first),
+		})
+	}
+	for _, percentile := range percentiles {
+		amount := sdk.ZeroInt()
+		if len(balances) > 0 {
+			// The nearest rank is the smallest one with at least the percentile of balances at or below it.
+			rank := (uint64(percentile)*uint64(len(balances)) + 99) / 100
+			amount = balances[rank-1]
+		}
+		distribution.Percentiles = append(distribution.Percentiles, telemetrytypes.PercentileCutoff{
+			Percentile: percentile,
+			Amount:     amount,
+		})
+	}
+	return distribution, nil
+}
+
+// share returns the share of the total held, or zero if the total is zero.
+func share(held, total sdk.Int) sdk.Dec {
+	if total.IsZero() {
+		return sdk.ZeroDec()
+	}
+	return held.ToDec().Quo(total.ToDec())
+}
+
+// gini returns the Gini coefficient of the balances sorted ascending that add up to total.
+func gini(balances []sdk.Int, total sdk.Int) sdk.Dec {
+	n := int64(len(balances))
+	if n == 0 || total.IsZero() {
+		return sdk.ZeroDec()
+	}
+	// G = 2 * sum(i * x_i) / (n * sum(x_i)) - (n + 1) / n, with i the 1-based rank of x_i in ascending order.
+	weighted := sdk.ZeroInt()
+	for idx, balance := range balances {
+		weighted = weighted.Add(balance.MulRaw(int64(idx) + 1))
+	}
+	return weighted.MulRaw(2).ToDec().Quo(total.MulRaw(n).ToDec()).Sub(sdk.NewDec(n + 1).QuoInt64(n))
+}
@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
+	telemetrytypes "github.com/GeoDB-Limited/odin-core/x/telemetry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// validatorUptime is the number of blocks a validator signed and missed.
+type validatorUptime struct {
+	signed uint64
+	missed uint64
+}
+
+// uptime returns the share of the blocks the validator signed, or zero if it was asked for none.
+func (u validatorUptime) uptime() sdk.Dec {
+	if u.signed+u.missed == 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDec(int64(u.signed)).QuoInt64(int64(u.signed + u.missed))
+}
+
+// addUptime adds the blocks signed and missed to the validator uptime of the given key.
+func (i *Index) addUptime(batch dbm.Batch, key []byte, delta validatorUptime) error {
+	bz, err := i.db.Get(key)
+	if err != nil {
+		return err
+	}
+	uptime := parseValidatorUptime(bz)
+	uptime.signed += delta.signed
+	uptime.missed += delta.missed
+	return batch.Set(key, uptime.value())
+}
+
+// parseValidatorUptime parses the validator uptime stored by addUptime, zero if none is stored.
+func parseValidatorUptime(bz []byte) validatorUptime {
+	if len(bz) != 16 {
+		return validatorUptime{}
+	}
+	return validatorUptime{
+		signed: binary.BigEndian.Uint64(bz[:8]),
+		missed: binary.BigEndian.Uint64(bz[8:]),
+	}
+}
+
+func (u validatorUptime) value() []byte {
+	return append(sdk.Uint64ToBigEndian(u.signed), sdk.Uint64ToBigEndian(u.missed)...)
+}
+
+// setOracleStatuses records the current oracle status of the validators at the time it took effect, skipping the
+// validators that were never active.
+func (i *Index) setOracleStatuses(batch dbm.Batch, statuses map[string]oracletypes.ValidatorStatus) error {
+	for valAddr, status := range statuses {
+		if status.Since.IsZero() {
+			continue
+		}
+		key := telemetrytypes.OracleStatusChangeKey(sdk.ValAddress(valAddr), status.Since.UTC())
+		if err := batch.Set(key, oracleStatusValue(status.IsActive)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setOracleStatusChanges records the oracle status of the validators activated or deactivated in the block.
+func (i *Index) setOracleStatusChanges(batch dbm.Batch, blockTime time.Time, statuses map[string]bool) error {
+	for valAddr, active := range statuses {
+		key := telemetrytypes.OracleStatusChangeKey(sdk.ValAddress(valAddr), blockTime)
+		if err := batch.Set(key, oracleStatusValue(active)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// oracleStatusValue returns the stored value of an oracle status.
+func oracleStatusValue(active bool) []byte {
+	if active {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+// GetValidatorUptime returns the number of blocks the validator signed and missed in the time buckets of the given
+// granularity between the buckets containing startDate and endDate inclusive, sorted by time.
+func (i *Index) GetValidatorUptime(
+	consAddr sdk.ConsAddress,
+	startDate, endDate *time.Time,
+	granularity telemetrytypes.Granularity,
+) ([]telemetrytypes.ValidatorUptime, error) {
+	prefix := telemetrytypes.ValidatorUptimePrefix(consAddr)
+	start, end, err := dateRange(prefix, startDate, endDate, granularity)
+	if err != nil {
+		return nil, err
+	}
+	it, err := i.db.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var uptime []telemetrytypes.ValidatorUptime
+	for ; it.Valid(); it.Next() {
+		hour, err := keyHour(prefix, it.Key())
+		if err != nil {
+			return nil, err
+		}
+		// Hours come sorted, so an hour either falls in the last bucket or starts a new one.
+		start := granularity.Truncate(hour)
+		if len(uptime) == 0 || !uptime[len(uptime)-1].Start.Equal(start) {
+			uptime = append(uptime, telemetrytypes.ValidatorUptime{Start: start})
+		}
+		hourUptime := parseValidatorUptime(it.Value())
+		bucket := &uptime[len(uptime)-1]
+		bucket.SignedBlocks += hourUptime.signed
+		bucket.MissedBlocks += hourUptime.missed
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	for idx := range uptime {
+		uptime[idx].Uptime = validatorUptime{signed: uptime[idx].SignedBlocks, missed: uptime[idx].MissedBlocks}.uptime()
+	}
+	return uptime, nil
+}
+
+// GetValidatorsUptime returns the number of blocks signed and missed by each validator between startDate and
+// endDate inclusive, keyed by consensus address. Validators with no blocks in the interval are left out.
+func (i *Index) GetValidatorsUptime(startDate, endDate *time.Time) (map[string]telemetrytypes.ValidatorUptime, error) {
+	if _, _, err := dateRange(nil, startDate, endDate, telemetrytypes.GRANULARITY_DAY); err != nil {
+		return nil, err
+	}
+	uptime := make(map[string]telemetrytypes.ValidatorUptime)
+	next := telemetrytypes.ValidatorUptimeKeyPrefix
+	for {
+		consAddr, found, err := i.nextUptimeValidator(next)
+		if err != nil || !found {
+			return uptime, err
+		}
+		prefix := telemetrytypes.ValidatorUptimePrefix(consAddr)
+		validator, err := i.sumUptime(prefix, startDate, endDate)
+		if err != nil {
+			return nil, err
+		}
+		if validator.signed+validator.missed > 0 {
+			uptime[string(consAddr)] = telemetrytypes.ValidatorUptime{
+				SignedBlocks: validator.signed,
+				MissedBlocks: validator.missed,
+				Uptime:       validator.uptime(),
+			}
+		}
+		next = sdk.PrefixEndBytes(prefix)
+	}
+}
+
+// nextUptimeValidator returns the first validator with an uptime key from the given key on, false if there is none.
+func (i *Index) nextUptimeValidator(from []byte) (sdk.ConsAddress, bool, error) {
+	it, err := i.db.Iterator(from, sdk.PrefixEndBytes(telemetrytypes.ValidatorUptimeKeyPrefix))
+	if err != nil {
+		return nil, false, err
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return nil, false, it.Error()
+	}
+	key := it.Key()[len(telemetrytypes.ValidatorUptimeKeyPrefix):]
+	if len(key) == 0 || len(key) < 1+int(key[0]) {
+		return nil, false, fmt.Errorf("invalid validator uptime key")
+	}
+	return sdk.ConsAddress(append([]byte{}, key[1:1+int(key[0])]...)), true, nil
+}
+
+// sumUptime sums the hourly validator uptime stored under the prefix between startDate and endDate inclusive.
+func (i *Index) sumUptime(prefix []byte, startDate, endDate *time.Time) (validatorUptime, error) {
+	start, end, err := dateRange(prefix, startDate, endDate, telemetrytypes.GRANULARITY_DAY)
+	if err != nil {
+		return validatorUptime{}, err
+	}
+	it, err := i.db.Iterator(start, end)
+	if err != nil {
+		return validatorUptime{}, err
+	}
+	defer it.Close()
+
+	var uptime validatorUptime
+	for ; it.Valid(); it.Next() {
+		hourly := parseValidatorUptime(it.Value())
+		uptime.signed += hourly.signed
+		uptime.missed += hourly.missed
+	}
+	return uptime, it.Error()
+}
+
+// GetOracleStatusChanges returns all the oracle activations and deactivations of the validator, sorted by time.
+func (i *Index) GetOracleStatusChanges(valAddr sdk.ValAddress) ([]telemetrytypes.OracleStatusChange, error) {
+	prefix := telemetrytypes.OracleStatusChangesPrefix(valAddr)
+	it, err := i.db.Iterator(prefix, sdk.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var changes []telemetrytypes.OracleStatusChange
+	for ; it.Valid(); it.Next() {
+		changeTime, err := sdk.ParseTimeBytes(it.Key()[len(prefix):])
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, telemetrytypes.OracleStatusChange{
+			Time:     changeTime,
+			IsActive: bytes.Equal(it.Value(), []byte{1}),
+		})
+	}
+	return changes, it.Error()
+}
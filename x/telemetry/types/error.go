@@ -4,4 +4,8 @@ import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 var (
 	ErrInvalidDateInterval = sdkerrors.Register(ModuleName, 1, "Invalid Date interval")
+	ErrInvalidGranularity  = sdkerrors.Register(ModuleName, 2, "Invalid granularity")
+	ErrInvalidPercentile   = sdkerrors.Register(ModuleName, 3, "Invalid percentile")
+	ErrSnapshotNotFound    = sdkerrors.Register(ModuleName, 4, "Supply snapshot not found")
+	ErrIndexDisabled       = sdkerrors.Register(ModuleName, 5, "Telemetry index is disabled")
 )
@@ -1,11 +1,39 @@
 package types
 
 import (
+	minttypes "github.com/GeoDB-Limited/odin-core/x/mint/types"
+	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 // DistrKeeper defines the expected distribution keeper.
 type DistrKeeper interface {
 	GetValidatorHistoricalRewards(ctx sdk.Context, val sdk.ValAddress, period uint64) (rewards distrtypes.ValidatorHistoricalRewards)
+	GetFeePoolCommunityCoins(ctx sdk.Context) sdk.DecCoins
+}
+
+// OracleKeeper defines the expected oracle keeper.
+type OracleKeeper interface {
+	MustGetRequest(ctx sdk.Context, id oracletypes.RequestID) oracletypes.Request
+	GetValidatorStatus(ctx sdk.Context, val sdk.ValAddress) oracletypes.ValidatorStatus
+	IterateValidatorStatuses(ctx sdk.Context, cb func(val sdk.ValAddress, status oracletypes.ValidatorStatus) (stop bool))
+}
+
+// AccountKeeper defines the expected account keeper.
+type AccountKeeper interface {
+	IterateAccounts(ctx sdk.Context, cb func(account authtypes.AccountI) (stop bool))
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+}
+
+// BankKeeper defines the expected bank keeper.
+type BankKeeper interface {
+	IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool)
+	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
+}
+
+// MintKeeper defines the expected mint keeper.
+type MintKeeper interface {
+	GetMintPool(ctx sdk.Context) (mintPool minttypes.MintPool)
 }
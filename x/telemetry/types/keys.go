@@ -1,5 +1,12 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName is the name of the module.
 	ModuleName = "telemetry"
@@ -19,7 +26,157 @@ const (
 	QueryValidatorBlocks        = "validator_blocks"
 	QueryTopValidators          = "top_validators"
 	QueryValidatorByConsAddress = "validator_by_cons_addr"
+	QueryOracleRequests         = "oracle_requests"
+	QueryTopDataSources         = "top_data_sources"
+	QueryReportParticipation    = "report_participation"
+	QueryDataProviderRewards    = "data_provider_rewards"
+	QueryActiveAccounts         = "active_accounts"
+	QueryAccountGrowth          = "account_growth"
+	QueryTopAccounts            = "top_accounts"
+	QuerySupplyDistribution     = "supply_distribution"
+	QueryValidatorUptime        = "validator_uptime"
+	QueryTopValidatorsByUptime  = "top_validators_by_uptime"
+
+	DenomTag       = "denom"
+	StatusTag      = "status"
+	StartDateTag   = "start_date"
+	EndDateTag     = "end_date"
+	GranularityTag = "granularity"
+	ThresholdsTag  = "thresholds"
+	PercentilesTag = "percentiles"
+
+	ValidatorAddressTag = "validator_address"
+)
 
-	DenomTag  = "denom"
-	StatusTag = "status"
+var (
+	// IndexStateKey is the key of the last block processed by the telemetry index.
+	IndexStateKey = []byte{0x00}
+	// BlockStatsKeyPrefix is the prefix for the hourly block statistics in the telemetry index.
+	BlockStatsKeyPrefix = []byte{0x01}
+	// ValidatorBlocksKeyPrefix is the prefix for the number of blocks signed by validators per hour.
+	ValidatorBlocksKeyPrefix = []byte{0x02}
+	// OracleScriptStatsKeyPrefix is the prefix for the requests of each oracle script per hour.
+	OracleScriptStatsKeyPrefix = []byte{0x03}
+	// DataSourceRequestsKeyPrefix is the prefix for the number of raw requests of each data source per hour.
+	DataSourceRequestsKeyPrefix = []byte{0x04}
+	// ValidatorReportsKeyPrefix is the prefix for the requests and reports of each validator per hour.
+	ValidatorReportsKeyPrefix = []byte{0x05}
+	// DataProviderRewardsKeyPrefix is the prefix for the rewards paid out to data providers per hour.
+	DataProviderRewardsKeyPrefix = []byte{0x06}
+	// AccountKeyPrefix is the prefix for the hour each account was first seen at.
+	AccountKeyPrefix = []byte{0x07}
+	// NewAccountsKeyPrefix is the prefix for the number of accounts first seen per hour.
+	NewAccountsKeyPrefix = []byte{0x08}
+	// AccountTxsKeyPrefix is the prefix for the number of transactions signed by each account per hour.
+	AccountTxsKeyPrefix = []byte{0x09}
+	// SupplySnapshotKeyPrefix is the prefix for the last supply snapshot of each denomination.
+	SupplySnapshotKeyPrefix = []byte{0x0A}
+	// 0x0B held the number of blocks missed by validators per hour, now read from the validator uptime.
+	// OracleStatusChangeKeyPrefix is the prefix for the oracle activations and deactivations of each validator.
+	OracleStatusChangeKeyPrefix = []byte{0x0C}
+	// IndexRunKeyPrefix is the prefix for the runs of consecutive blocks processed by the telemetry index.
+	IndexRunKeyPrefix = []byte{0x0D}
+	// SupplyHolderKeyPrefix is the prefix for the user balances of the last supply snapshot of each denomination.
+	SupplyHolderKeyPrefix = []byte{0x0E}
+	// ValidatorUptimeKeyPrefix is the prefix for the number of blocks signed and missed by each validator per hour,
+	// keyed by validator first so the uptime of a single validator is a prefix scan.
+	ValidatorUptimeKeyPrefix = []byte{0x0F}
+	// SeededAccountsKey is the key of the number of accounts that existed when the index was enabled on a running
+	// chain.
+	SeededAccountsKey = []byte{0x10}
 )
+
+// BlockStatsKey returns the key to retrieve the block statistics of the hour starting at start from the telemetry
+// index.
+func BlockStatsKey(start time.Time) []byte {
+	return append(BlockStatsKeyPrefix, sdk.FormatTimeBytes(start)...)
+}
+
+// ValidatorBlocksKey returns the key to retrieve the number of blocks signed by the validator during the hour
+// starting at start.
+func ValidatorBlocksKey(start time.Time, consAddr sdk.ConsAddress) []byte {
+	return append(ValidatorBlocksKeyPrefix, append(sdk.FormatTimeBytes(start), consAddr...)...)
+}
+
+// OracleScriptStatsKey returns the key to retrieve the requests of the oracle script during the hour starting at
+// start.
+func OracleScriptStatsKey(start time.Time, oracleScriptID uint64) []byte {
+	return append(OracleScriptStatsKeyPrefix, append(sdk.FormatTimeBytes(start), sdk.Uint64ToBigEndian(oracleScriptID)...)...)
+}
+
+// DataSourceRequestsKey returns the key to retrieve the number of raw requests of the data source during the hour
+// starting at start.
+func DataSourceRequestsKey(start time.Time, dataSourceID uint64) []byte {
+	return append(DataSourceRequestsKeyPrefix, append(sdk.FormatTimeBytes(start), sdk.Uint64ToBigEndian(dataSourceID)...)...)
+}
+
+// ValidatorReportsKey returns the key to retrieve the requests and reports of the validator during the hour starting
+// at start.
+func ValidatorReportsKey(start time.Time, valAddr sdk.ValAddress) []byte {
+	return append(ValidatorReportsKeyPrefix, append(sdk.FormatTimeBytes(start), valAddr...)...)
+}
+
+// DataProviderRewardsKey returns the key to retrieve the rewards paid out to data providers during the hour starting
+// at start.
+func DataProviderRewardsKey(start time.Time) []byte {
+	return append(DataProviderRewardsKeyPrefix, sdk.FormatTimeBytes(start)...)
+}
+
+// AccountKey returns the key to retrieve the hour the account was first seen at.
+func AccountKey(addr sdk.AccAddress) []byte {
+	return append(AccountKeyPrefix, addr...)
+}
+
+// NewAccountsKey returns the key to retrieve the number of accounts first seen during the hour starting at start.
+func NewAccountsKey(start time.Time) []byte {
+	return append(NewAccountsKeyPrefix, sdk.FormatTimeBytes(start)...)
+}
+
+// AccountTxsKey returns the key to retrieve the number of transactions signed by the account during the hour
+// starting at start.
+func AccountTxsKey(start time.Time, addr sdk.AccAddress) []byte {
+	return append(AccountTxsKeyPrefix, append(sdk.FormatTimeBytes(start), addr...)...)
+}
+
+// SupplySnapshotKey returns the key to retrieve the last supply snapshot of the denomination.
+func SupplySnapshotKey(denom string) []byte {
+	return append(SupplySnapshotKeyPrefix, []byte(denom)...)
+}
+
+// SupplyHoldersPrefix returns the prefix of the user balances of the last supply snapshot of the denomination.
+func SupplyHoldersPrefix(denom string) []byte {
+	return append(SupplyHolderKeyPrefix, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// SupplyHolderKey returns the key to retrieve the user balance of the given rank, in ascending order, in the last
+// supply snapshot of the denomination.
+func SupplyHolderKey(denom string, rank uint64) []byte {
+	return append(SupplyHoldersPrefix(denom), sdk.Uint64ToBigEndian(rank)...)
+}
+
+// ValidatorUptimePrefix returns the prefix of the hourly uptime of the validator.
+func ValidatorUptimePrefix(consAddr sdk.ConsAddress) []byte {
+	return append(ValidatorUptimeKeyPrefix, address.MustLengthPrefix(consAddr)...)
+}
+
+// ValidatorUptimeKey returns the key to retrieve the number of blocks signed and missed by the validator during the
+// hour starting at start.
+func ValidatorUptimeKey(consAddr sdk.ConsAddress, start time.Time) []byte {
+	return append(ValidatorUptimePrefix(consAddr), sdk.FormatTimeBytes(start)...)
+}
+
+// OracleStatusChangesPrefix returns the prefix of the oracle status changes of the validator.
+func OracleStatusChangesPrefix(valAddr sdk.ValAddress) []byte {
+	return append(OracleStatusChangeKeyPrefix, address.MustLengthPrefix(valAddr)...)
+}
+
+// OracleStatusChangeKey returns the key to retrieve whether the validator was active in the oracle after the block
+// at the given time.
+func OracleStatusChangeKey(valAddr sdk.ValAddress, blockTime time.Time) []byte {
+	return append(OracleStatusChangesPrefix(valAddr), sdk.FormatTimeBytes(blockTime)...)
+}
+
+// IndexRunKey returns the key to retrieve the run of consecutive indexed blocks starting at the given height.
+func IndexRunKey(startHeight int64) []byte {
+	return append(IndexRunKeyPrefix, sdk.Uint64ToBigEndian(uint64(startHeight))...)
+}
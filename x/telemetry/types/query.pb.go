@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	github_com_cosmos_cosmos_sdk_x_bank_types "github.com/cosmos/cosmos-sdk/x/bank/types"
 	github_com_cosmos_cosmos_sdk_x_staking_types "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -266,8 +267,9 @@ func (m *QueryExtendedValidatorsResponse) GetPagination() *query.PageResponse {
 
 // QueryAvgBlockSizeRequest is request type for the Query/AvgBlockSize RPC method.
 type QueryAvgBlockSizeRequest struct {
-	StartDate *time.Time `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,stdtime" json:"start_date,omitempty"`
-	EndDate   *time.Time `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,stdtime" json:"end_date,omitempty"`
+	StartDate   *time.Time  `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,stdtime" json:"start_date,omitempty"`
+	EndDate     *time.Time  `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,stdtime" json:"end_date,omitempty"`
+	Granularity Granularity `protobuf:"varint,3,opt,name=granularity,proto3,enum=telemetry.Granularity" json:"granularity,omitempty"`
 }
 
 func (m *QueryAvgBlockSizeRequest) Reset()         { *m = QueryAvgBlockSizeRequest{} }
@@ -317,9 +319,16 @@ func (m *QueryAvgBlockSizeRequest) GetEndDate() *time.Time {
 	return nil
 }
 
+func (m *QueryAvgBlockSizeRequest) GetGranularity() Granularity {
+	if m != nil {
+		return m.Granularity
+	}
+	return GRANULARITY_DAY
+}
+
 // QueryAvgBlockSizeResponse is response type for the Query/AvgBlockSize RPC method.
 type QueryAvgBlockSizeResponse struct {
-	AvgBlockSizePerDay []AverageBlockSizePerDay `protobuf:"bytes,1,rep,name=avg_block_size_per_day,json=avgBlockSizePerDay,proto3" json:"avg_block_size_per_day"`
+	AvgBlockSize []AverageBlockSize `protobuf:"bytes,1,rep,name=avg_block_size,json=avgBlockSize,proto3" json:"avg_block_size"`
 }
 
 func (m *QueryAvgBlockSizeResponse) Reset()         { *m = QueryAvgBlockSizeResponse{} }
@@ -355,17 +364,18 @@ func (m *QueryAvgBlockSizeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAvgBlockSizeResponse proto.InternalMessageInfo
 
-func (m *QueryAvgBlockSizeResponse) GetAvgBlockSizePerDay() []AverageBlockSizePerDay {
+func (m *QueryAvgBlockSizeResponse) GetAvgBlockSize() []AverageBlockSize {
 	if m != nil {
-		return m.AvgBlockSizePerDay
+		return m.AvgBlockSize
 	}
 	return nil
 }
 
 // QueryAvgBlockTimeRequest is request type for the Query/AvgBlockTime RPC method.
 type QueryAvgBlockTimeRequest struct {
-	StartDate *time.Time `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,stdtime" json:"start_date,omitempty"`
-	EndDate   *time.Time `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,stdtime" json:"end_date,omitempty"`
+	StartDate   *time.Time  `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,stdtime" json:"start_date,omitempty"`
+	EndDate     *time.Time  `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,stdtime" json:"end_date,omitempty"`
+	Granularity Granularity `protobuf:"varint,3,opt,name=granularity,proto3,enum=telemetry.Granularity" json:"granularity,omitempty"`
 }
 
 func (m *QueryAvgBlockTimeRequest) Reset()         { *m = QueryAvgBlockTimeRequest{} }
@@ -415,9 +425,16 @@ func (m *QueryAvgBlockTimeRequest) GetEndDate() *time.Time {
 	return nil
 }
 
+func (m *QueryAvgBlockTimeRequest) GetGranularity() Granularity {
+	if m != nil {
+		return m.Granularity
+	}
+	return GRANULARITY_DAY
+}
+
 // QueryAvgBlockTimeResponse is response type for the Query/AvgBlockTime RPC method.
 type QueryAvgBlockTimeResponse struct {
-	AvgBlockTimePerDay []AverageBlockTimePerDay `protobuf:"bytes,1,rep,name=avg_block_time_per_day,json=avgBlockTimePerDay,proto3" json:"avg_block_time_per_day"`
+	AvgBlockTime []AverageBlockTime `protobuf:"bytes,1,rep,name=avg_block_time,json=avgBlockTime,proto3" json:"avg_block_time"`
 }
 
 func (m *QueryAvgBlockTimeResponse) Reset()         { *m = QueryAvgBlockTimeResponse{} }
@@ -453,17 +470,18 @@ func (m *QueryAvgBlockTimeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAvgBlockTimeResponse proto.InternalMessageInfo
 
-func (m *QueryAvgBlockTimeResponse) GetAvgBlockTimePerDay() []AverageBlockTimePerDay {
+func (m *QueryAvgBlockTimeResponse) GetAvgBlockTime() []AverageBlockTime {
 	if m != nil {
-		return m.AvgBlockTimePerDay
+		return m.AvgBlockTime
 	}
 	return nil
 }
 
 // QueryAvgTxFeeRequest is request type for the Query/AvgTxFee RPC method.
 type QueryAvgTxFeeRequest struct {
-	StartDate *time.Time `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,stdtime" json:"start_date,omitempty"`
-	EndDate   *time.Time `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,stdtime" json:"end_date,omitempty"`
+	StartDate   *time.Time  `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,stdtime" json:"start_date,omitempty"`
+	EndDate     *time.Time  `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,stdtime" json:"end_date,omitempty"`
+	Granularity Granularity `protobuf:"varint,3,opt,name=granularity,proto3,enum=telemetry.Granularity" json:"granularity,omitempty"`
 }
 
 func (m *QueryAvgTxFeeRequest) Reset()         { *m = QueryAvgTxFeeRequest{} }
@@ -513,9 +531,16 @@ func (m *QueryAvgTxFeeRequest) GetEndDate() *time.Time {
 	return nil
 }
 
+func (m *QueryAvgTxFeeRequest) GetGranularity() Granularity {
+	if m != nil {
+		return m.Granularity
+	}
+	return GRANULARITY_DAY
+}
+
 // QueryAvgTxFeeResponse is response type for the Query/AvgTxFee RPC method.
 type QueryAvgTxFeeResponse struct {
-	AvgTxFeePerDay []AverageTxFeePerDay `protobuf:"bytes,1,rep,name=avg_tx_fee_per_day,json=avgTxFeePerDay,proto3" json:"avg_tx_fee_per_day"`
+	AvgTxFee []AverageTxFee `protobuf:"bytes,1,rep,name=avg_tx_fee,json=avgTxFee,proto3" json:"avg_tx_fee"`
 }
 
 func (m *QueryAvgTxFeeResponse) Reset()         { *m = QueryAvgTxFeeResponse{} }
@@ -551,17 +576,18 @@ func (m *QueryAvgTxFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAvgTxFeeResponse proto.InternalMessageInfo
 
-func (m *QueryAvgTxFeeResponse) GetAvgTxFeePerDay() []AverageTxFeePerDay {
+func (m *QueryAvgTxFeeResponse) GetAvgTxFee() []AverageTxFee {
 	if m != nil {
-		return m.AvgTxFeePerDay
+		return m.AvgTxFee
 	}
 	return nil
 }
 
 // QueryTxVolumeRequest is request type for the Query/TxVolume RPC method.
 type QueryTxVolumeRequest struct {
-	StartDate *time.Time `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,stdtime" json:"start_date,omitempty"`
-	EndDate   *time.Time `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,stdtime" json:"end_date,omitempty"`
+	StartDate   *time.Time  `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,stdtime" json:"start_date,omitempty"`
+	EndDate     *time.Time  `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,stdtime" json:"end_date,omitempty"`
+	Granularity Granularity `protobuf:"varint,3,opt,name=granularity,proto3,enum=telemetry.Granularity" json:"granularity,omitempty"`
 }
 
 func (m *QueryTxVolumeRequest) Reset()         { *m = QueryTxVolumeRequest{} }
@@ -611,9 +637,16 @@ func (m *QueryTxVolumeRequest) GetEndDate() *time.Time {
 	return nil
 }
 
+func (m *QueryTxVolumeRequest) GetGranularity() Granularity {
+	if m != nil {
+		return m.Granularity
+	}
+	return GRANULARITY_DAY
+}
+
 // QueryAvgTxFeeResponse is response type for the Query/TxVolume RPC method.
 type QueryTxVolumeResponse struct {
-	TxVolumePerDay []TxVolumePerDay `protobuf:"bytes,1,rep,name=tx_volume_per_day,json=txVolumePerDay,proto3" json:"tx_volume_per_day"`
+	TxVolume []TxVolume `protobuf:"bytes,1,rep,name=tx_volume,json=txVolume,proto3" json:"tx_volume"`
 }
 
 func (m *QueryTxVolumeResponse) Reset()         { *m = QueryTxVolumeResponse{} }
@@ -649,9 +682,9 @@ func (m *QueryTxVolumeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTxVolumeResponse proto.InternalMessageInfo
 
-func (m *QueryTxVolumeResponse) GetTxVolumePerDay() []TxVolumePerDay {
+func (m *QueryTxVolumeResponse) GetTxVolume() []TxVolume {
 	if m != nil {
-		return m.TxVolumePerDay
+		return m.TxVolume
 	}
 	return nil
 }
@@ -980,1471 +1013,7193 @@ func (m *QueryValidatorByConsAddrResponse) GetValidator() github_com_cosmos_cosm
 	return github_com_cosmos_cosmos_sdk_x_staking_types.Validator{}
 }
 
-func init() {
-	proto.RegisterType((*QueryTopBalancesRequest)(nil), "telemetry.QueryTopBalancesRequest")
-	proto.RegisterType((*QueryTopBalancesResponse)(nil), "telemetry.QueryTopBalancesResponse")
-	proto.RegisterType((*QueryExtendedValidatorsRequest)(nil), "telemetry.QueryExtendedValidatorsRequest")
-	proto.RegisterType((*QueryExtendedValidatorsResponse)(nil), "telemetry.QueryExtendedValidatorsResponse")
-	proto.RegisterType((*QueryAvgBlockSizeRequest)(nil), "telemetry.QueryAvgBlockSizeRequest")
-	proto.RegisterType((*QueryAvgBlockSizeResponse)(nil), "telemetry.QueryAvgBlockSizeResponse")
-	proto.RegisterType((*QueryAvgBlockTimeRequest)(nil), "telemetry.QueryAvgBlockTimeRequest")
-	proto.RegisterType((*QueryAvgBlockTimeResponse)(nil), "telemetry.QueryAvgBlockTimeResponse")
-	proto.RegisterType((*QueryAvgTxFeeRequest)(nil), "telemetry.QueryAvgTxFeeRequest")
-	proto.RegisterType((*QueryAvgTxFeeResponse)(nil), "telemetry.QueryAvgTxFeeResponse")
-	proto.RegisterType((*QueryTxVolumeRequest)(nil), "telemetry.QueryTxVolumeRequest")
-	proto.RegisterType((*QueryTxVolumeResponse)(nil), "telemetry.QueryTxVolumeResponse")
-	proto.RegisterType((*QueryTopValidatorsRequest)(nil), "telemetry.QueryTopValidatorsRequest")
-	proto.RegisterType((*QueryTopValidatorsResponse)(nil), "telemetry.QueryTopValidatorsResponse")
-	proto.RegisterType((*QueryValidatorBlocksRequest)(nil), "telemetry.QueryValidatorBlocksRequest")
-	proto.RegisterType((*QueryValidatorBlocksResponse)(nil), "telemetry.QueryValidatorBlocksResponse")
-	proto.RegisterType((*QueryValidatorByConsAddrRequest)(nil), "telemetry.QueryValidatorByConsAddrRequest")
-	proto.RegisterType((*QueryValidatorByConsAddrResponse)(nil), "telemetry.QueryValidatorByConsAddrResponse")
+// QueryOracleRequestsRequest is request type for the Query/OracleRequests RPC method.
+type QueryOracleRequestsRequest struct {
+	StartDate   *time.Time  `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,stdtime" json:"start_date,omitempty"`
+	EndDate     *time.Time  `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,stdtime" json:"end_date,omitempty"`
+	Granularity Granularity `protobuf:"varint,3,opt,name=granularity,proto3,enum=telemetry.Granularity" json:"granularity,omitempty"`
 }
 
-func init() { proto.RegisterFile("telemetry/query.proto", fileDescriptor_4346fb254048dbbd) }
-
-var fileDescriptor_4346fb254048dbbd = []byte{
-	// 1092 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0xf9, 0x45, 0x32, 0x51, 0x0b, 0x1d, 0xd2, 0x36, 0x76, 0xd2, 0xb5, 0xe3, 0x40,
-	0x1a, 0x52, 0x65, 0x57, 0x09, 0x3f, 0x2a, 0x0e, 0x80, 0xe2, 0x86, 0x56, 0x02, 0x54, 0x8a, 0xb1,
-	0x7a, 0x80, 0xc3, 0x6a, 0xec, 0x9d, 0x6e, 0x57, 0xb1, 0x77, 0xb6, 0x3b, 0x63, 0x63, 0x97, 0x9f,
-	0xe2, 0xc2, 0x81, 0x4b, 0xa5, 0x5e, 0x39, 0xf4, 0xc4, 0x8d, 0x0b, 0x7f, 0x45, 0x8e, 0x45, 0x5c,
-	0x7a, 0x4a, 0x51, 0xc2, 0x5f, 0x81, 0x84, 0x84, 0x76, 0xf6, 0xed, 0x0f, 0xaf, 0xd7, 0x24, 0x40,
-	0x2a, 0x72, 0x8a, 0x77, 0xe7, 0xcd, 0x7b, 0xdf, 0xf7, 0x79, 0xf3, 0x76, 0x5e, 0xf0, 0x79, 0xc9,
-	0x5a, 0xac, 0xcd, 0xa4, 0xdf, 0x37, 0xee, 0x75, 0x98, 0xdf, 0xd7, 0x3d, 0x9f, 0x4b, 0x4e, 0x66,
-	0xe3, 0xd7, 0xc5, 0x79, 0x9b, 0xdb, 0x5c, 0xbd, 0x35, 0x82, 0x5f, 0xa1, 0x41, 0x71, 0xc9, 0xe6,
-	0xdc, 0x6e, 0x31, 0x83, 0x7a, 0x8e, 0x41, 0x5d, 0x97, 0x4b, 0x2a, 0x1d, 0xee, 0x0a, 0x58, 0xd5,
-	0x9a, 0x5c, 0xb4, 0xb9, 0x30, 0x1a, 0x54, 0x30, 0xa3, 0xbb, 0xd9, 0x60, 0x92, 0x6e, 0x1a, 0x4d,
-	0xee, 0xb8, 0xb0, 0xbe, 0x9c, 0xb7, 0xde, 0xa0, 0x2d, 0xea, 0x36, 0x19, 0x98, 0xac, 0xa7, 0x4d,
-	0x94, 0xb4, 0xd8, 0xd0, 0xa3, 0xb6, 0xe3, 0xaa, 0x78, 0x60, 0x5b, 0x02, 0x31, 0xea, 0xa9, 0xd1,
-	0xb9, 0x63, 0x48, 0xa7, 0xcd, 0x84, 0xa4, 0x6d, 0x0f, 0x0c, 0x0a, 0x49, 0x96, 0xf1, 0x2f, 0x58,
-	0x5a, 0xc9, 0x93, 0xd2, 0xa5, 0x2d, 0xc7, 0xa2, 0x92, 0xfb, 0xa1, 0x51, 0xe5, 0x7b, 0x84, 0x2f,
-	0x7e, 0x14, 0x68, 0xa8, 0x73, 0xaf, 0x1a, 0xca, 0x14, 0x35, 0x76, 0xaf, 0xc3, 0x84, 0x24, 0xf3,
-	0x78, 0xca, 0x62, 0x2e, 0x6f, 0x2f, 0xa0, 0x32, 0x5a, 0x9b, 0xad, 0x85, 0x0f, 0xe4, 0x3a, 0xc6,
-	0x89, 0xcc, 0x85, 0xf1, 0x32, 0x5a, 0x9b, 0xdb, 0x5a, 0xd5, 0xc3, 0x58, 0x7a, 0x10, 0x4b, 0x0f,
-	0x71, 0x43, 0x44, 0xfd, 0x16, 0xb5, 0x19, 0x78, 0xac, 0xa5, 0x76, 0x12, 0x82, 0x27, 0x2d, 0x26,
-	0x9a, 0x0b, 0x13, 0x65, 0xb4, 0x36, 0x53, 0x53, 0xbf, 0x2b, 0x4f, 0x10, 0x5e, 0x18, 0x56, 0x23,
-	0x3c, 0xee, 0x0a, 0x46, 0x04, 0x9e, 0x01, 0x90, 0x62, 0x01, 0x95, 0x27, 0xd6, 0xe6, 0xb6, 0x96,
-	0x06, 0xc2, 0x46, 0x01, 0x61, 0x63, 0xf5, 0xcd, 0xbd, 0xfd, 0xd2, 0xd8, 0x1f, 0xfb, 0xa5, 0x4d,
-	0xdb, 0x91, 0x77, 0x3b, 0x0d, 0xbd, 0xc9, 0xdb, 0x06, 0x20, 0x09, 0xff, 0x6c, 0x08, 0x6b, 0xd7,
-	0xe8, 0x19, 0x0d, 0xea, 0xee, 0x1a, 0xb2, 0xef, 0x31, 0x11, 0x6d, 0xad, 0xc5, 0x81, 0xc8, 0x8d,
-	0x9c, 0x6c, 0x2f, 0x1f, 0x99, 0x6d, 0xa8, 0x38, 0x9d, 0x6e, 0xe5, 0x1b, 0x84, 0x35, 0x95, 0xda,
-	0xbb, 0x3d, 0xc9, 0x5c, 0x8b, 0x59, 0xb7, 0xa3, 0x4a, 0xc4, 0xbc, 0x2f, 0xe0, 0x69, 0x21, 0xa9,
-	0xec, 0x08, 0x00, 0x0e, 0x4f, 0x27, 0x45, 0xbc, 0xf2, 0x74, 0x1c, 0x97, 0x46, 0x4a, 0x00, 0xc8,
-	0x5f, 0x61, 0x1c, 0x1f, 0x91, 0x08, 0xb3, 0x96, 0x8b, 0x39, 0xde, 0x5c, 0x7d, 0x1b, 0x40, 0xbf,
-	0x71, 0x04, 0x68, 0x21, 0xe9, 0xae, 0xe3, 0xda, 0xc0, 0x3a, 0xde, 0x5f, 0x4b, 0x45, 0x1c, 0x28,
-	0xf2, 0xf8, 0xff, 0x53, 0xe4, 0x89, 0x7f, 0x5f, 0xe4, 0x47, 0xd1, 0xf9, 0xdd, 0xee, 0xda, 0xd5,
-	0x16, 0x6f, 0xee, 0x7e, 0xec, 0xdc, 0x8f, 0x4a, 0x41, 0xae, 0x61, 0x2c, 0x24, 0xf5, 0xa5, 0x69,
-	0x51, 0xc9, 0x54, 0x89, 0xe7, 0xb6, 0x8a, 0x7a, 0xd8, 0xe0, 0x7a, 0xd4, 0xe0, 0x7a, 0x3d, 0x6a,
-	0xf0, 0xea, 0xcc, 0xde, 0x7e, 0x09, 0x3d, 0x78, 0x5a, 0x42, 0xb5, 0x59, 0xb5, 0x6f, 0x87, 0x4a,
-	0x46, 0xde, 0xc1, 0x33, 0xcc, 0xb5, 0x42, 0x17, 0xe3, 0xff, 0xc0, 0xc5, 0x73, 0xcc, 0xb5, 0x02,
-	0x07, 0x95, 0x1e, 0x2e, 0xe4, 0x28, 0x84, 0xea, 0x7f, 0x8a, 0x2f, 0xd0, 0xae, 0x6d, 0x36, 0x82,
-	0x05, 0x53, 0x38, 0xf7, 0x99, 0xe9, 0x31, 0xdf, 0xb4, 0x68, 0x1f, 0x4e, 0xc2, 0xb2, 0x9e, 0x7c,
-	0x64, 0xb6, 0xbb, 0xcc, 0xa7, 0x36, 0x8b, 0x9d, 0xdc, 0x62, 0xfe, 0x0e, 0xed, 0x57, 0x27, 0x83,
-	0x82, 0xd4, 0x08, 0x4d, 0xb9, 0x0f, 0x57, 0x86, 0xe1, 0x04, 0x3a, 0x4f, 0x37, 0x9c, 0x50, 0x61,
-	0x1e, 0x9c, 0xe0, 0x3b, 0x7c, 0x4c, 0x38, 0x81, 0x93, 0x7c, 0x38, 0xc9, 0x4a, 0xe5, 0x07, 0x84,
-	0xe7, 0xa3, 0xd0, 0xf5, 0xde, 0x75, 0x76, 0xca, 0xc0, 0xdc, 0xc5, 0xe7, 0x33, 0xea, 0x00, 0xca,
-	0x87, 0x38, 0xc8, 0xc6, 0x94, 0x3d, 0xf3, 0x0e, 0xcb, 0x02, 0xb9, 0x34, 0x0c, 0x44, 0x6d, 0x1e,
-	0x80, 0x71, 0x96, 0x82, 0xcb, 0x2c, 0x88, 0x7a, 0xef, 0x36, 0x6f, 0x75, 0x4e, 0xdb, 0x09, 0x69,
-	0x02, 0x88, 0x44, 0x1d, 0x80, 0x78, 0x0f, 0x9f, 0x93, 0x3d, 0xb3, 0xab, 0x5e, 0x66, 0x38, 0x14,
-	0x52, 0x1c, 0xa2, 0x7d, 0x83, 0x0c, 0xe4, 0xc0, 0xdb, 0xca, 0x9f, 0x08, 0xce, 0x61, 0x9d, 0x7b,
-	0xc3, 0xd7, 0xc4, 0xa9, 0x00, 0x91, 0xb9, 0x94, 0x26, 0xfe, 0xf3, 0x18, 0x30, 0x99, 0x1a, 0x03,
-	0x7e, 0x46, 0xb8, 0x98, 0x97, 0x3f, 0xa0, 0x7e, 0x1f, 0x9f, 0x95, 0xdc, 0x33, 0x73, 0xee, 0xa9,
-	0x84, 0x73, 0x72, 0x3b, 0xa9, 0xaf, 0x90, 0xa4, 0x52, 0x00, 0xec, 0x33, 0x32, 0xed, 0xf4, 0xe4,
-	0x2e, 0xf8, 0x1f, 0x11, 0x5e, 0x54, 0xa2, 0x07, 0x43, 0xc7, 0x65, 0xbb, 0x82, 0xcf, 0xc5, 0x8a,
-	0x4d, 0x6a, 0x59, 0x3e, 0x13, 0xd1, 0x45, 0xff, 0x42, 0xbc, 0xb0, 0x1d, 0xbe, 0x7f, 0xa6, 0x43,
-	0xd6, 0x23, 0x84, 0x97, 0xf2, 0x85, 0x02, 0xdf, 0xab, 0x78, 0x5a, 0x7d, 0xe4, 0x44, 0xce, 0xf9,
-	0x1d, 0xdc, 0x03, 0x48, 0xc1, 0xfc, 0xe4, 0x58, 0xde, 0x84, 0x41, 0x25, 0x89, 0xd6, 0xbf, 0xc6,
-	0x5d, 0x11, 0xd0, 0x49, 0xe1, 0x6c, 0x06, 0xfb, 0x5c, 0xd1, 0x11, 0x59, 0x9c, 0xf1, 0x02, 0xe0,
-	0x0c, 0x52, 0x2e, 0x8f, 0x76, 0x08, 0x69, 0x7f, 0x81, 0x67, 0xe3, 0x3a, 0x40, 0x5b, 0x3d, 0xeb,
-	0xc9, 0x27, 0x09, 0xb8, 0xf5, 0xcb, 0x14, 0x9e, 0x52, 0x12, 0xc9, 0x67, 0x78, 0x2e, 0x35, 0xfe,
-	0x92, 0x4a, 0x8a, 0xfe, 0x88, 0x49, 0xbd, 0xb8, 0xf2, 0xb7, 0x36, 0x61, 0x7e, 0x95, 0xd2, 0xb7,
-	0xbf, 0xfe, 0xfe, 0x70, 0xbc, 0x40, 0x2e, 0x1a, 0xa9, 0xff, 0x19, 0xb8, 0x67, 0xc6, 0x63, 0xd0,
-	0x43, 0x84, 0xc9, 0xf0, 0x68, 0x48, 0x5e, 0xc9, 0x3a, 0x1f, 0x39, 0xc1, 0x16, 0xd7, 0x8f, 0x63,
-	0x0a, 0x72, 0x56, 0x95, 0x9c, 0x32, 0xd1, 0x52, 0x72, 0x92, 0x96, 0x4e, 0x54, 0x7d, 0x8d, 0xcf,
-	0x0c, 0x7c, 0x06, 0xc8, 0x4b, 0x39, 0xc9, 0x0e, 0x4b, 0x79, 0xf9, 0x08, 0x2b, 0x50, 0xb1, 0xac,
-	0x54, 0x2c, 0x92, 0x42, 0x06, 0x4a, 0x6a, 0x24, 0xfd, 0x0e, 0xe1, 0xe7, 0x33, 0xad, 0x42, 0x56,
-	0xb3, 0xde, 0xf3, 0x9b, 0xbe, 0x78, 0xf9, 0x48, 0x3b, 0xd0, 0xb1, 0xa2, 0x74, 0x5c, 0x22, 0x8b,
-	0x79, 0x34, 0x4c, 0xe8, 0xaf, 0x9f, 0x10, 0x7e, 0x31, 0xe7, 0x04, 0x93, 0xf5, 0xd1, 0x51, 0xb2,
-	0x7d, 0x53, 0xbc, 0x72, 0x2c, 0x5b, 0x50, 0xf5, 0x96, 0x52, 0x75, 0x95, 0xbc, 0x9e, 0xaf, 0xaa,
-	0x6f, 0x06, 0x9d, 0xa6, 0xba, 0xcf, 0xf8, 0x7c, 0xa8, 0x1b, 0xbf, 0xac, 0xde, 0xdc, 0x3b, 0xd0,
-	0xd0, 0xe3, 0x03, 0x0d, 0xfd, 0x76, 0xa0, 0xa1, 0x07, 0x87, 0xda, 0xd8, 0xe3, 0x43, 0x6d, 0xec,
-	0xc9, 0xa1, 0x36, 0xf6, 0xc9, 0x6b, 0xa9, 0x86, 0xb9, 0xc1, 0xf8, 0x4e, 0x75, 0xe3, 0x03, 0xa7,
-	0xed, 0x48, 0x66, 0x19, 0xdc, 0x72, 0xdc, 0x8d, 0x26, 0xf7, 0x99, 0xd1, 0x4b, 0x97, 0x24, 0x68,
-	0x9c, 0xc6, 0xb4, 0xba, 0x9a, 0x5e, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x6d, 0xaf, 0x20, 0x9b,
-	0xdb, 0x0f, 0x00, 0x00,
+func (m *QueryOracleRequestsRequest) Reset()         { *m = QueryOracleRequestsRequest{} }
+func (m *QueryOracleRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleRequestsRequest) ProtoMessage()    {}
+func (*QueryOracleRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4346fb254048dbbd, []int{18}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// TopBalances returns all the system balances for specific denom.
-	TopBalances(ctx context.Context, in *QueryTopBalancesRequest, opts ...grpc.CallOption) (*QueryTopBalancesResponse, error)
-	// ExtendedValidators returns validators balances.
-	ExtendedValidators(ctx context.Context, in *QueryExtendedValidatorsRequest, opts ...grpc.CallOption) (*QueryExtendedValidatorsResponse, error)
-	// TopValidators returns validators blocks and stake percentage.
-	TopValidators(ctx context.Context, in *QueryTopValidatorsRequest, opts ...grpc.CallOption) (*QueryTopValidatorsResponse, error)
-	// ValidatorBlocks returns validator approved blocks.
-	ValidatorBlocks(ctx context.Context, in *QueryValidatorBlocksRequest, opts ...grpc.CallOption) (*QueryValidatorBlocksResponse, error)
-	ValidatorByConsAddr(ctx context.Context, in *QueryValidatorByConsAddrRequest, opts ...grpc.CallOption) (*QueryValidatorByConsAddrResponse, error)
+func (m *QueryOracleRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryOracleRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleRequestsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryOracleRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleRequestsRequest.Merge(m, src)
+}
+func (m *QueryOracleRequestsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleRequestsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) TopBalances(ctx context.Context, in *QueryTopBalancesRequest, opts ...grpc.CallOption) (*QueryTopBalancesResponse, error) {
-	out := new(QueryTopBalancesResponse)
-	err := c.cc.Invoke(ctx, "/telemetry.Query/TopBalances", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOracleRequestsRequest proto.InternalMessageInfo
+
+func (m *QueryOracleRequestsRequest) GetStartDate() *time.Time {
+	if m != nil {
+		return m.StartDate
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ExtendedValidators(ctx context.Context, in *QueryExtendedValidatorsRequest, opts ...grpc.CallOption) (*QueryExtendedValidatorsResponse, error) {
-	out := new(QueryExtendedValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/telemetry.Query/ExtendedValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOracleRequestsRequest) GetEndDate() *time.Time {
+	if m != nil {
+		return m.EndDate
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TopValidators(ctx context.Context, in *QueryTopValidatorsRequest, opts ...grpc.CallOption) (*QueryTopValidatorsResponse, error) {
-	out := new(QueryTopValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/telemetry.Query/TopValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOracleRequestsRequest) GetGranularity() Granularity {
+	if m != nil {
+		return m.Granularity
 	}
-	return out, nil
+	return GRANULARITY_DAY
 }
 
-func (c *queryClient) ValidatorBlocks(ctx context.Context, in *QueryValidatorBlocksRequest, opts ...grpc.CallOption) (*QueryValidatorBlocksResponse, error) {
-	out := new(QueryValidatorBlocksResponse)
-	err := c.cc.Invoke(ctx, "/telemetry.Query/ValidatorBlocks", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryOracleRequestsResponse is response type for the Query/OracleRequests RPC method.
+type QueryOracleRequestsResponse struct {
+	OracleRequests []OracleRequests `protobuf:"bytes,1,rep,name=oracle_requests,json=oracleRequests,proto3" json:"oracle_requests"`
 }
 
-func (c *queryClient) ValidatorByConsAddr(ctx context.Context, in *QueryValidatorByConsAddrRequest, opts ...grpc.CallOption) (*QueryValidatorByConsAddrResponse, error) {
-	out := new(QueryValidatorByConsAddrResponse)
-	err := c.cc.Invoke(ctx, "/telemetry.Query/ValidatorByConsAddr", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOracleRequestsResponse) Reset()         { *m = QueryOracleRequestsResponse{} }
+func (m *QueryOracleRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleRequestsResponse) ProtoMessage()    {}
+func (*QueryOracleRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4346fb254048dbbd, []int{19}
+}
+func (m *QueryOracleRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleRequestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryOracleRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleRequestsResponse.Merge(m, src)
+}
+func (m *QueryOracleRequestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleRequestsResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// TopBalances returns all the system balances for specific denom.
-	TopBalances(context.Context, *QueryTopBalancesRequest) (*QueryTopBalancesResponse, error)
-	// ExtendedValidators returns validators balances.
-	ExtendedValidators(context.Context, *QueryExtendedValidatorsRequest) (*QueryExtendedValidatorsResponse, error)
-	// TopValidators returns validators blocks and stake percentage.
-	TopValidators(context.Context, *QueryTopValidatorsRequest) (*QueryTopValidatorsResponse, error)
-	// ValidatorBlocks returns validator approved blocks.
-	ValidatorBlocks(context.Context, *QueryValidatorBlocksRequest) (*QueryValidatorBlocksResponse, error)
-	ValidatorByConsAddr(context.Context, *QueryValidatorByConsAddrRequest) (*QueryValidatorByConsAddrResponse, error)
+var xxx_messageInfo_QueryOracleRequestsResponse proto.InternalMessageInfo
+
+func (m *QueryOracleRequestsResponse) GetOracleRequests() []OracleRequests {
+	if m != nil {
+		return m.OracleRequests
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryTopDataSourcesRequest is request type for the Query/TopDataSources RPC method.
+type QueryTopDataSourcesRequest struct {
+	StartDate  *time.Time         `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,stdtime" json:"start_date,omitempty"`
+	EndDate    *time.Time         `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,stdtime" json:"end_date,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Desc       bool               `protobuf:"varint,4,opt,name=desc,proto3" json:"desc,omitempty"`
 }
 
-func (*UnimplementedQueryServer) TopBalances(ctx context.Context, req *QueryTopBalancesRequest) (*QueryTopBalancesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TopBalances not implemented")
+func (m *QueryTopDataSourcesRequest) Reset()         { *m = QueryTopDataSourcesRequest{} }
+func (m *QueryTopDataSourcesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopDataSourcesRequest) ProtoMessage()    {}
+func (*QueryTopDataSourcesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4346fb254048dbbd, []int{20}
 }
-func (*UnimplementedQueryServer) ExtendedValidators(ctx context.Context, req *QueryExtendedValidatorsRequest) (*QueryExtendedValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExtendedValidators not implemented")
+func (m *QueryTopDataSourcesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) TopValidators(ctx context.Context, req *QueryTopValidatorsRequest) (*QueryTopValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TopValidators not implemented")
+func (m *QueryTopDataSourcesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopDataSourcesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ValidatorBlocks(ctx context.Context, req *QueryValidatorBlocksRequest) (*QueryValidatorBlocksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorBlocks not implemented")
+func (m *QueryTopDataSourcesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopDataSourcesRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ValidatorByConsAddr(ctx context.Context, req *QueryValidatorByConsAddrRequest) (*QueryValidatorByConsAddrResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorByConsAddr not implemented")
+func (m *QueryTopDataSourcesRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryTopDataSourcesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopDataSourcesRequest.DiscardUnknown(m)
 }
 
-func _Query_TopBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTopBalancesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TopBalances(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/telemetry.Query/TopBalances",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TopBalances(ctx, req.(*QueryTopBalancesRequest))
+var xxx_messageInfo_QueryTopDataSourcesRequest proto.InternalMessageInfo
+
+func (m *QueryTopDataSourcesRequest) GetStartDate() *time.Time {
+	if m != nil {
+		return m.StartDate
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ExtendedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExtendedValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExtendedValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/telemetry.Query/ExtendedValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExtendedValidators(ctx, req.(*QueryExtendedValidatorsRequest))
+func (m *QueryTopDataSourcesRequest) GetEndDate() *time.Time {
+	if m != nil {
+		return m.EndDate
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_TopValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTopValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TopValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/telemetry.Query/TopValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TopValidators(ctx, req.(*QueryTopValidatorsRequest))
+func (m *QueryTopDataSourcesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ValidatorBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorBlocksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorBlocks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/telemetry.Query/ValidatorBlocks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorBlocks(ctx, req.(*QueryValidatorBlocksRequest))
+func (m *QueryTopDataSourcesRequest) GetDesc() bool {
+	if m != nil {
+		return m.Desc
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_ValidatorByConsAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorByConsAddrRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorByConsAddr(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/telemetry.Query/ValidatorByConsAddr",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorByConsAddr(ctx, req.(*QueryValidatorByConsAddrRequest))
+// QueryTopDataSourcesResponse is response type for the Query/TopDataSources RPC method.
+type QueryTopDataSourcesResponse struct {
+	DataSources []DataSourceStats   `protobuf:"bytes,1,rep,name=data_sources,json=dataSources,proto3" json:"data_sources"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTopDataSourcesResponse) Reset()         { *m = QueryTopDataSourcesResponse{} }
+func (m *QueryTopDataSourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopDataSourcesResponse) ProtoMessage()    {}
+func (*QueryTopDataSourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4346fb254048dbbd, []int{21}
+}
+func (m *QueryTopDataSourcesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopDataSourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopDataSourcesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryTopDataSourcesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopDataSourcesResponse.Merge(m, src)
+}
+func (m *QueryTopDataSourcesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopDataSourcesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopDataSourcesResponse.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "telemetry.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "TopBalances",
-			Handler:    _Query_TopBalances_Handler,
-		},
-		{
-			MethodName: "ExtendedValidators",
-			Handler:    _Query_ExtendedValidators_Handler,
-		},
-		{
-			MethodName: "TopValidators",
-			Handler:    _Query_TopValidators_Handler,
-		},
-		{
-			MethodName: "ValidatorBlocks",
-			Handler:    _Query_ValidatorBlocks_Handler,
-		},
-		{
-			MethodName: "ValidatorByConsAddr",
-			Handler:    _Query_ValidatorByConsAddr_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "telemetry/query.proto",
+var xxx_messageInfo_QueryTopDataSourcesResponse proto.InternalMessageInfo
+
+func (m *QueryTopDataSourcesResponse) GetDataSources() []DataSourceStats {
+	if m != nil {
+		return m.DataSources
+	}
+	return nil
 }
 
-func (m *QueryTopBalancesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTopDataSourcesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTopBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryReportParticipationRequest is request type for the Query/ReportParticipation RPC method.
+type QueryReportParticipationRequest struct {
+	StartDate  *time.Time         `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,stdtime" json:"start_date,omitempty"`
+	EndDate    *time.Time         `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,stdtime" json:"end_date,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Desc       bool               `protobuf:"varint,4,opt,name=desc,proto3" json:"desc,omitempty"`
 }
 
-func (m *QueryTopBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Desc {
-		i--
-		if m.Desc {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryReportParticipationRequest) Reset()         { *m = QueryReportParticipationRequest{} }
+func (m *QueryReportParticipationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReportParticipationRequest) ProtoMessage()    {}
+func (*QueryReportParticipationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4346fb254048dbbd, []int{22}
+}
+func (m *QueryReportParticipationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReportParticipationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReportParticipationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryReportParticipationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReportParticipationRequest.Merge(m, src)
+}
+func (m *QueryReportParticipationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReportParticipationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReportParticipationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTopBalancesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReportParticipationRequest proto.InternalMessageInfo
+
+func (m *QueryReportParticipationRequest) GetStartDate() *time.Time {
+	if m != nil {
+		return m.StartDate
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTopBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryReportParticipationRequest) GetEndDate() *time.Time {
+	if m != nil {
+		return m.EndDate
+	}
+	return nil
 }
 
-func (m *QueryTopBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Balances) > 0 {
-		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryReportParticipationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryExtendedValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryReportParticipationRequest) GetDesc() bool {
+	if m != nil {
+		return m.Desc
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryExtendedValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryReportParticipationResponse is response type for the Query/ReportParticipation RPC method.
+type QueryReportParticipationResponse struct {
+	Validators []ReportParticipation `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators"`
+	Pagination *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryExtendedValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryReportParticipationResponse) Reset()         { *m = QueryReportParticipationResponse{} }
+func (m *QueryReportParticipationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReportParticipationResponse) ProtoMessage()    {}
+func (*QueryReportParticipationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4346fb254048dbbd, []int{23}
+}
+func (m *QueryReportParticipationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReportParticipationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReportParticipationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryReportParticipationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReportParticipationResponse.Merge(m, src)
+}
+func (m *QueryReportParticipationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReportParticipationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReportParticipationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReportParticipationResponse proto.InternalMessageInfo
+
+func (m *QueryReportParticipationResponse) GetValidators() []ReportParticipation {
+	if m != nil {
+		return m.Validators
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryExtendedValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryReportParticipationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryExtendedValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDataProviderRewardsRequest is request type for the Query/DataProviderRewards RPC method.
+type QueryDataProviderRewardsRequest struct {
+	StartDate   *time.Time  `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,stdtime" json:"start_date,omitempty"`
+	EndDate     *time.Time  `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,stdtime" json:"end_date,omitempty"`
+	Granularity Granularity `protobuf:"varint,3,opt,name=granularity,proto3,enum=telemetry.Granularity" json:"granularity,omitempty"`
 }
 
-func (m *QueryExtendedValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Balances) > 0 {
-		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDataProviderRewardsRequest) Reset()         { *m = QueryDataProviderRewardsRequest{} }
+func (m *QueryDataProviderRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataProviderRewardsRequest) ProtoMessage()    {}
+func (*QueryDataProviderRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4346fb254048dbbd, []int{24}
+}
+func (m *QueryDataProviderRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataProviderRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataProviderRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAvgBlockSizeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDataProviderRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataProviderRewardsRequest.Merge(m, src)
 }
-
-func (m *QueryAvgBlockSizeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDataProviderRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataProviderRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataProviderRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAvgBlockSizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EndDate != nil {
-		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate):])
-		if err5 != nil {
-			return 0, err5
-		}
-		i -= n5
-		i = encodeVarintQuery(dAtA, i, uint64(n5))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_QueryDataProviderRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryDataProviderRewardsRequest) GetStartDate() *time.Time {
+	if m != nil {
+		return m.StartDate
 	}
-	if m.StartDate != nil {
-		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate):])
-		if err6 != nil {
-			return 0, err6
-		}
-		i -= n6
-		i = encodeVarintQuery(dAtA, i, uint64(n6))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *QueryDataProviderRewardsRequest) GetEndDate() *time.Time {
+	if m != nil {
+		return m.EndDate
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAvgBlockSizeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDataProviderRewardsRequest) GetGranularity() Granularity {
+	if m != nil {
+		return m.Granularity
 	}
-	return dAtA[:n], nil
+	return GRANULARITY_DAY
 }
 
-func (m *QueryAvgBlockSizeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDataProviderRewardsResponse is response type for the Query/DataProviderRewards RPC method.
+type QueryDataProviderRewardsResponse struct {
+	DataProviderRewards []DataProviderRewards `protobuf:"bytes,1,rep,name=data_provider_rewards,json=dataProviderRewards,proto3" json:"data_provider_rewards"`
 }
 
-func (m *QueryAvgBlockSizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AvgBlockSizePerDay) > 0 {
-		for iNdEx := len(m.AvgBlockSizePerDay) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AvgBlockSizePerDay[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDataProviderRewardsResponse) Reset()         { *m = QueryDataProviderRewardsResponse{} }
+func (m *QueryDataProviderRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataProviderRewardsResponse) ProtoMessage()    {}
+func (*QueryDataProviderRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4346fb254048dbbd, []int{25}
+}
+func (m *QueryDataProviderRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataProviderRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataProviderRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDataProviderRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataProviderRewardsResponse.Merge(m, src)
+}
+func (m *QueryDataProviderRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataProviderRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataProviderRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAvgBlockTimeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDataProviderRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryDataProviderRewardsResponse) GetDataProviderRewards() []DataProviderRewards {
+	if m != nil {
+		return m.DataProviderRewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAvgBlockTimeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryActiveAccountsRequest is request type for the Query/ActiveAccounts RPC method.
+type QueryActiveAccountsRequest struct {
+	StartDate   *time.Time  `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,stdtime" json:"start_date,omitempty"`
+	EndDate     *time.Time  `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,stdtime" json:"end_date,omitempty"`
+	Granularity Granularity `protobuf:"varint,3,opt,name=granularity,proto3,enum=telemetry.Granularity" json:"granularity,omitempty"`
 }
 
-func (m *QueryAvgBlockTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EndDate != nil {
-		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate):])
-		if err7 != nil {
-			return 0, err7
+func (m *QueryActiveAccountsRequest) Reset()         { *m = QueryActiveAccountsRequest{} }
+func (m *QueryActiveAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveAccountsRequest) ProtoMessage()    {}
+func (*QueryActiveAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4346fb254048dbbd, []int{26}
+}
+func (m *QueryActiveAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n7
-		i = encodeVarintQuery(dAtA, i, uint64(n7))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.StartDate != nil {
-		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate):])
-		if err8 != nil {
-			return 0, err8
-		}
-		i -= n8
-		i = encodeVarintQuery(dAtA, i, uint64(n8))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryActiveAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveAccountsRequest.Merge(m, src)
+}
+func (m *QueryActiveAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryActiveAccountsRequest) GetStartDate() *time.Time {
+	if m != nil {
+		return m.StartDate
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAvgBlockTimeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryActiveAccountsRequest) GetEndDate() *time.Time {
+	if m != nil {
+		return m.EndDate
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAvgBlockTimeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryActiveAccountsRequest) GetGranularity() Granularity {
+	if m != nil {
+		return m.Granularity
+	}
+	return GRANULARITY_DAY
 }
 
-func (m *QueryAvgBlockTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AvgBlockTimePerDay) > 0 {
-		for iNdEx := len(m.AvgBlockTimePerDay) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AvgBlockTimePerDay[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+// QueryActiveAccountsResponse is response type for the Query/ActiveAccounts RPC method.
+type QueryActiveAccountsResponse struct {
+	ActiveAccounts []ActiveAccounts `protobuf:"bytes,1,rep,name=active_accounts,json=activeAccounts,proto3" json:"active_accounts"`
+}
+
+func (m *QueryActiveAccountsResponse) Reset()         { *m = QueryActiveAccountsResponse{} }
+func (m *QueryActiveAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveAccountsResponse) ProtoMessage()    {}
+func (*QueryActiveAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4346fb254048dbbd, []int{27}
+}
+func (m *QueryActiveAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryActiveAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveAccountsResponse.Merge(m, src)
+}
+func (m *QueryActiveAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveAccountsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAvgTxFeeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryActiveAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryActiveAccountsResponse) GetActiveAccounts() []ActiveAccounts {
+	if m != nil {
+		return m.ActiveAccounts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAvgTxFeeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAccountGrowthRequest is request type for the Query/AccountGrowth RPC method.
+type QueryAccountGrowthRequest struct {
+	StartDate   *time.Time  `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,stdtime" json:"start_date,omitempty"`
+	EndDate     *time.Time  `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,stdtime" json:"end_date,omitempty"`
+	Granularity Granularity `protobuf:"varint,3,opt,name=granularity,proto3,enum=telemetry.Granularity" json:"granularity,omitempty"`
 }
 
-func (m *QueryAvgTxFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EndDate != nil {
-		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate):])
-		if err9 != nil {
-			return 0, err9
-		}
-		i -= n9
-		i = encodeVarintQuery(dAtA, i, uint64(n9))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.StartDate != nil {
-		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate):])
-		if err10 != nil {
-			return 0, err10
+func (m *QueryAccountGrowthRequest) Reset()         { *m = QueryAccountGrowthRequest{} }
+func (m *QueryAccountGrowthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountGrowthRequest) ProtoMessage()    {}
+func (*QueryAccountGrowthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4346fb254048dbbd, []int{28}
+}
+func (m *QueryAccountGrowthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountGrowthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountGrowthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n10
-		i = encodeVarintQuery(dAtA, i, uint64(n10))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAvgTxFeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAccountGrowthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountGrowthRequest.Merge(m, src)
+}
+func (m *QueryAccountGrowthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountGrowthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountGrowthRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAvgTxFeeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAccountGrowthRequest proto.InternalMessageInfo
+
+func (m *QueryAccountGrowthRequest) GetStartDate() *time.Time {
+	if m != nil {
+		return m.StartDate
+	}
+	return nil
 }
 
-func (m *QueryAvgTxFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AvgTxFeePerDay) > 0 {
-		for iNdEx := len(m.AvgTxFeePerDay) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AvgTxFeePerDay[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryAccountGrowthRequest) GetEndDate() *time.Time {
+	if m != nil {
+		return m.EndDate
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryTxVolumeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAccountGrowthRequest) GetGranularity() Granularity {
+	if m != nil {
+		return m.Granularity
 	}
-	return dAtA[:n], nil
+	return GRANULARITY_DAY
 }
 
-func (m *QueryTxVolumeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAccountGrowthResponse is response type for the Query/AccountGrowth RPC method.
+type QueryAccountGrowthResponse struct {
+	AccountGrowth []AccountGrowth `protobuf:"bytes,1,rep,name=account_growth,json=accountGrowth,proto3" json:"account_growth"`
 }
 
-func (m *QueryTxVolumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EndDate != nil {
-		n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate):])
-		if err11 != nil {
-			return 0, err11
-		}
-		i -= n11
-		i = encodeVarintQuery(dAtA, i, uint64(n11))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.StartDate != nil {
-		n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate):])
-		if err12 != nil {
-			return 0, err12
+func (m *QueryAccountGrowthResponse) Reset()         { *m = QueryAccountGrowthResponse{} }
+func (m *QueryAccountGrowthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountGrowthResponse) ProtoMessage()    {}
+func (*QueryAccountGrowthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4346fb254048dbbd, []int{29}
+}
+func (m *QueryAccountGrowthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountGrowthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountGrowthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n12
-		i = encodeVarintQuery(dAtA, i, uint64(n12))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAccountGrowthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountGrowthResponse.Merge(m, src)
+}
+func (m *QueryAccountGrowthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountGrowthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountGrowthResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTxVolumeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccountGrowthResponse proto.InternalMessageInfo
+
+func (m *QueryAccountGrowthResponse) GetAccountGrowth() []AccountGrowth {
+	if m != nil {
+		return m.AccountGrowth
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTxVolumeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTopAccountsRequest is request type for the Query/TopAccounts RPC method.
+type QueryTopAccountsRequest struct {
+	StartDate  *time.Time         `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,stdtime" json:"start_date,omitempty"`
+	EndDate    *time.Time         `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,stdtime" json:"end_date,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Desc       bool               `protobuf:"varint,4,opt,name=desc,proto3" json:"desc,omitempty"`
 }
 
-func (m *QueryTxVolumeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxVolumePerDay) > 0 {
-		for iNdEx := len(m.TxVolumePerDay) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TxVolumePerDay[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryTopAccountsRequest) Reset()         { *m = QueryTopAccountsRequest{} }
+func (m *QueryTopAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopAccountsRequest) ProtoMessage()    {}
+func (*QueryTopAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4346fb254048dbbd, []int{30}
+}
+func (m *QueryTopAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTopAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopAccountsRequest.Merge(m, src)
+}
+func (m *QueryTopAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopAccountsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTopValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTopAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryTopAccountsRequest) GetStartDate() *time.Time {
+	if m != nil {
+		return m.StartDate
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTopValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTopAccountsRequest) GetEndDate() *time.Time {
+	if m != nil {
+		return m.EndDate
+	}
+	return nil
 }
 
-func (m *QueryTopValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Desc {
-		i--
-		if m.Desc {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (m *QueryTopAccountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if m.EndDate != nil {
-		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate):])
-		if err14 != nil {
-			return 0, err14
-		}
-		i -= n14
-		i = encodeVarintQuery(dAtA, i, uint64(n14))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *QueryTopAccountsRequest) GetDesc() bool {
+	if m != nil {
+		return m.Desc
 	}
-	if m.StartDate != nil {
-		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate):])
-		if err15 != nil {
-			return 0, err15
+	return false
+}
+
+// QueryTopAccountsResponse is response type for the Query/TopAccounts RPC method.
+type QueryTopAccountsResponse struct {
+	Accounts   []AccountTxs        `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTopAccountsResponse) Reset()         { *m = QueryTopAccountsResponse{} }
+func (m *QueryTopAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopAccountsResponse) ProtoMessage()    {}
+func (*QueryTopAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4346fb254048dbbd, []int{31}
+}
+func (m *QueryTopAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n15
-		i = encodeVarintQuery(dAtA, i, uint64(n15))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTopAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopAccountsResponse.Merge(m, src)
+}
+func (m *QueryTopAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopAccountsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTopValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTopAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryTopAccountsResponse) GetAccounts() []AccountTxs {
+	if m != nil {
+		return m.Accounts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTopValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTopAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryTopValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QuerySupplyDistributionRequest is request type for the Query/SupplyDistribution RPC method.
+type QuerySupplyDistributionRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// thresholds are the amounts to count the holders of at least, none by default.
+	Thresholds []github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,rep,name=thresholds,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"thresholds"`
+	// percentiles are the percentiles to return the cut-offs of, 50, 90 and 99 by default.
+	Percentiles []uint32 `protobuf:"varint,3,rep,packed,name=percentiles,proto3" json:"percentiles,omitempty"`
+}
+
+func (m *QuerySupplyDistributionRequest) Reset()         { *m = QuerySupplyDistributionRequest{} }
+func (m *QuerySupplyDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyDistributionRequest) ProtoMessage()    {}
+func (*QuerySupplyDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4346fb254048dbbd, []int{32}
+}
+func (m *QuerySupplyDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.TopValidators) > 0 {
-		for iNdEx := len(m.TopValidators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TopValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QuerySupplyDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyDistributionRequest.Merge(m, src)
+}
+func (m *QuerySupplyDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyDistributionRequest proto.InternalMessageInfo
+
+func (m *QuerySupplyDistributionRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryValidatorBlocksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySupplyDistributionRequest) GetPercentiles() []uint32 {
+	if m != nil {
+		return m.Percentiles
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryValidatorBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySupplyDistributionResponse is response type for the Query/SupplyDistribution RPC method.
+type QuerySupplyDistributionResponse struct {
+	SupplyDistribution SupplyDistribution `protobuf:"bytes,1,opt,name=supply_distribution,json=supplyDistribution,proto3" json:"supply_distribution"`
 }
 
-func (m *QueryValidatorBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Desc {
-		i--
-		if m.Desc {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySupplyDistributionResponse) Reset()         { *m = QuerySupplyDistributionResponse{} }
+func (m *QuerySupplyDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyDistributionResponse) ProtoMessage()    {}
+func (*QuerySupplyDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4346fb254048dbbd, []int{33}
+}
+func (m *QuerySupplyDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySupplyDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyDistributionResponse.Merge(m, src)
+}
+func (m *QuerySupplyDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyDistributionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorBlocksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySupplyDistributionResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyDistributionResponse) GetSupplyDistribution() SupplyDistribution {
+	if m != nil {
+		return m.SupplyDistribution
 	}
-	return dAtA[:n], nil
+	return SupplyDistribution{}
 }
 
-func (m *QueryValidatorBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorUptimeRequest is request type for the Query/ValidatorUptime RPC method.
+type QueryValidatorUptimeRequest struct {
+	ValidatorAddress string      `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	StartDate        *time.Time  `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3,stdtime" json:"start_date,omitempty"`
+	EndDate          *time.Time  `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3,stdtime" json:"end_date,omitempty"`
+	Granularity      Granularity `protobuf:"varint,4,opt,name=granularity,proto3,enum=telemetry.Granularity" json:"granularity,omitempty"`
 }
 
-func (m *QueryValidatorBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Blocks) > 0 {
-		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryValidatorUptimeRequest) Reset()         { *m = QueryValidatorUptimeRequest{} }
+func (m *QueryValidatorUptimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorUptimeRequest) ProtoMessage()    {}
+func (*QueryValidatorUptimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4346fb254048dbbd, []int{34}
+}
+func (m *QueryValidatorUptimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorUptimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorUptimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorUptimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorUptimeRequest.Merge(m, src)
+}
+func (m *QueryValidatorUptimeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorUptimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorUptimeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorByConsAddrRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorUptimeRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorUptimeRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryValidatorByConsAddrRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValidatorUptimeRequest) GetStartDate() *time.Time {
+	if m != nil {
+		return m.StartDate
+	}
+	return nil
 }
 
-func (m *QueryValidatorByConsAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsensusAddress) > 0 {
-		i -= len(m.ConsensusAddress)
-		copy(dAtA[i:], m.ConsensusAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsensusAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryValidatorUptimeRequest) GetEndDate() *time.Time {
+	if m != nil {
+		return m.EndDate
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryValidatorByConsAddrResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorUptimeRequest) GetGranularity() Granularity {
+	if m != nil {
+		return m.Granularity
 	}
-	return dAtA[:n], nil
+	return GRANULARITY_DAY
 }
 
-func (m *QueryValidatorByConsAddrResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorUptimeResponse is response type for the Query/ValidatorUptime RPC method.
+type QueryValidatorUptimeResponse struct {
+	Uptime []ValidatorUptime `protobuf:"bytes,1,rep,name=uptime,proto3" json:"uptime"`
+	// oracle_status_changes is the activations and deactivations of the validator in the oracle, sorted by time.
+	OracleStatusChanges []OracleStatusChange `protobuf:"bytes,2,rep,name=oracle_status_changes,json=oracleStatusChanges,proto3" json:"oracle_status_changes"`
 }
 
-func (m *QueryValidatorByConsAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryValidatorUptimeResponse) Reset()         { *m = QueryValidatorUptimeResponse{} }
+func (m *QueryValidatorUptimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorUptimeResponse) ProtoMessage()    {}
+func (*QueryValidatorUptimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4346fb254048dbbd, []int{35}
+}
+func (m *QueryValidatorUptimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorUptimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorUptimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorUptimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorUptimeResponse.Merge(m, src)
+}
+func (m *QueryValidatorUptimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorUptimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorUptimeResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryValidatorUptimeResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorUptimeResponse) GetUptime() []ValidatorUptime {
+	if m != nil {
+		return m.Uptime
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryTopBalancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Desc {
-		n += 2
-	}
-	return n
+	return nil
 }
 
-func (m *QueryTopBalancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryValidatorUptimeResponse) GetOracleStatusChanges() []OracleStatusChange {
+	if m != nil {
+		return m.OracleStatusChanges
 	}
-	return n
+	return nil
 }
 
-func (m *QueryExtendedValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryTopValidatorsByUptimeRequest is request type for the Query/TopValidatorsByUptime RPC method.
+type QueryTopValidatorsByUptimeRequest struct {
+	StartDate  *time.Time         `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,stdtime" json:"start_date,omitempty"`
+	EndDate    *time.Time         `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,stdtime" json:"end_date,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Desc       bool               `protobuf:"varint,4,opt,name=desc,proto3" json:"desc,omitempty"`
 }
 
-func (m *QueryExtendedValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTopValidatorsByUptimeRequest) Reset()         { *m = QueryTopValidatorsByUptimeRequest{} }
+func (m *QueryTopValidatorsByUptimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopValidatorsByUptimeRequest) ProtoMessage()    {}
+func (*QueryTopValidatorsByUptimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4346fb254048dbbd, []int{36}
+}
+func (m *QueryTopValidatorsByUptimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopValidatorsByUptimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopValidatorsByUptimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryTopValidatorsByUptimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopValidatorsByUptimeRequest.Merge(m, src)
+}
+func (m *QueryTopValidatorsByUptimeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopValidatorsByUptimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopValidatorsByUptimeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAvgBlockSizeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StartDate != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate)
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.EndDate != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate)
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryTopValidatorsByUptimeRequest proto.InternalMessageInfo
+
+func (m *QueryTopValidatorsByUptimeRequest) GetStartDate() *time.Time {
+	if m != nil {
+		return m.StartDate
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAvgBlockSizeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AvgBlockSizePerDay) > 0 {
-		for _, e := range m.AvgBlockSizePerDay {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryTopValidatorsByUptimeRequest) GetEndDate() *time.Time {
+	if m != nil {
+		return m.EndDate
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAvgBlockTimeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StartDate != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate)
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.EndDate != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate)
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTopValidatorsByUptimeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAvgBlockTimeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AvgBlockTimePerDay) > 0 {
-		for _, e := range m.AvgBlockTimePerDay {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryTopValidatorsByUptimeRequest) GetDesc() bool {
+	if m != nil {
+		return m.Desc
 	}
-	return n
+	return false
 }
 
-func (m *QueryAvgTxFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StartDate != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate)
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.EndDate != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate)
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryTopValidatorsByUptimeResponse is response type for the Query/TopValidatorsByUptime RPC method.
+type QueryTopValidatorsByUptimeResponse struct {
+	Validators []ValidatorUptimeStats `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators"`
+	Pagination *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAvgTxFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AvgTxFeePerDay) > 0 {
-		for _, e := range m.AvgTxFeePerDay {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTopValidatorsByUptimeResponse) Reset()         { *m = QueryTopValidatorsByUptimeResponse{} }
+func (m *QueryTopValidatorsByUptimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopValidatorsByUptimeResponse) ProtoMessage()    {}
+func (*QueryTopValidatorsByUptimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4346fb254048dbbd, []int{37}
+}
+func (m *QueryTopValidatorsByUptimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopValidatorsByUptimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopValidatorsByUptimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *QueryTxVolumeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StartDate != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate)
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.EndDate != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate)
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryTopValidatorsByUptimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopValidatorsByUptimeResponse.Merge(m, src)
+}
+func (m *QueryTopValidatorsByUptimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopValidatorsByUptimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopValidatorsByUptimeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTxVolumeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.TxVolumePerDay) > 0 {
-		for _, e := range m.TxVolumePerDay {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+var xxx_messageInfo_QueryTopValidatorsByUptimeResponse proto.InternalMessageInfo
+
+func (m *QueryTopValidatorsByUptimeResponse) GetValidators() []ValidatorUptimeStats {
+	if m != nil {
+		return m.Validators
 	}
-	return n
+	return nil
 }
 
-func (m *QueryTopValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StartDate != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate)
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.EndDate != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate)
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Desc {
-		n += 2
+func (m *QueryTopValidatorsByUptimeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryTopValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.TopValidators) > 0 {
-		for _, e := range m.TopValidators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func init() {
+	proto.RegisterType((*QueryTopBalancesRequest)(nil), "telemetry.QueryTopBalancesRequest")
+	proto.RegisterType((*QueryTopBalancesResponse)(nil), "telemetry.QueryTopBalancesResponse")
+	proto.RegisterType((*QueryExtendedValidatorsRequest)(nil), "telemetry.QueryExtendedValidatorsRequest")
+	proto.RegisterType((*QueryExtendedValidatorsResponse)(nil), "telemetry.QueryExtendedValidatorsResponse")
+	proto.RegisterType((*QueryAvgBlockSizeRequest)(nil), "telemetry.QueryAvgBlockSizeRequest")
+	proto.RegisterType((*QueryAvgBlockSizeResponse)(nil), "telemetry.QueryAvgBlockSizeResponse")
+	proto.RegisterType((*QueryAvgBlockTimeRequest)(nil), "telemetry.QueryAvgBlockTimeRequest")
+	proto.RegisterType((*QueryAvgBlockTimeResponse)(nil), "telemetry.QueryAvgBlockTimeResponse")
+	proto.RegisterType((*QueryAvgTxFeeRequest)(nil), "telemetry.QueryAvgTxFeeRequest")
+	proto.RegisterType((*QueryAvgTxFeeResponse)(nil), "telemetry.QueryAvgTxFeeResponse")
+	proto.RegisterType((*QueryTxVolumeRequest)(nil), "telemetry.QueryTxVolumeRequest")
+	proto.RegisterType((*QueryTxVolumeResponse)(nil), "telemetry.QueryTxVolumeResponse")
+	proto.RegisterType((*QueryTopValidatorsRequest)(nil), "telemetry.QueryTopValidatorsRequest")
+	proto.RegisterType((*QueryTopValidatorsResponse)(nil), "telemetry.QueryTopValidatorsResponse")
+	proto.RegisterType((*QueryValidatorBlocksRequest)(nil), "telemetry.QueryValidatorBlocksRequest")
+	proto.RegisterType((*QueryValidatorBlocksResponse)(nil), "telemetry.QueryValidatorBlocksResponse")
+	proto.RegisterType((*QueryValidatorByConsAddrRequest)(nil), "telemetry.QueryValidatorByConsAddrRequest")
+	proto.RegisterType((*QueryValidatorByConsAddrResponse)(nil), "telemetry.QueryValidatorByConsAddrResponse")
+	proto.RegisterType((*QueryOracleRequestsRequest)(nil), "telemetry.QueryOracleRequestsRequest")
+	proto.RegisterType((*QueryOracleRequestsResponse)(nil), "telemetry.QueryOracleRequestsResponse")
+	proto.RegisterType((*QueryTopDataSourcesRequest)(nil), "telemetry.QueryTopDataSourcesRequest")
+	proto.RegisterType((*QueryTopDataSourcesResponse)(nil), "telemetry.QueryTopDataSourcesResponse")
+	proto.RegisterType((*QueryReportParticipationRequest)(nil), "telemetry.QueryReportParticipationRequest")
+	proto.RegisterType((*QueryReportParticipationResponse)(nil), "telemetry.QueryReportParticipationResponse")
+	proto.RegisterType((*QueryDataProviderRewardsRequest)(nil), "telemetry.QueryDataProviderRewardsRequest")
+	proto.RegisterType((*QueryDataProviderRewardsResponse)(nil), "telemetry.QueryDataProviderRewardsResponse")
+	proto.RegisterType((*QueryActiveAccountsRequest)(nil), "telemetry.QueryActiveAccountsRequest")
+	proto.RegisterType((*QueryActiveAccountsResponse)(nil), "telemetry.QueryActiveAccountsResponse")
+	proto.RegisterType((*QueryAccountGrowthRequest)(nil), "telemetry.QueryAccountGrowthRequest")
+	proto.RegisterType((*QueryAccountGrowthResponse)(nil), "telemetry.QueryAccountGrowthResponse")
+	proto.RegisterType((*QueryTopAccountsRequest)(nil), "telemetry.QueryTopAccountsRequest")
+	proto.RegisterType((*QueryTopAccountsResponse)(nil), "telemetry.QueryTopAccountsResponse")
+	proto.RegisterType((*QuerySupplyDistributionRequest)(nil), "telemetry.QuerySupplyDistributionRequest")
+	proto.RegisterType((*QuerySupplyDistributionResponse)(nil), "telemetry.QuerySupplyDistributionResponse")
+	proto.RegisterType((*QueryValidatorUptimeRequest)(nil), "telemetry.QueryValidatorUptimeRequest")
+	proto.RegisterType((*QueryValidatorUptimeResponse)(nil), "telemetry.QueryValidatorUptimeResponse")
+	proto.RegisterType((*QueryTopValidatorsByUptimeRequest)(nil), "telemetry.QueryTopValidatorsByUptimeRequest")
+	proto.RegisterType((*QueryTopValidatorsByUptimeResponse)(nil), "telemetry.QueryTopValidatorsByUptimeResponse")
 }
 
-func (m *QueryValidatorBlocksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func init() { proto.RegisterFile("telemetry/query.proto", fileDescriptor_4346fb254048dbbd) }
+
+var fileDescriptor_4346fb254048dbbd = []byte{
+	// 2003 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x51, 0x6f, 0x1c, 0x49,
+	0x11, 0xce, 0xd8, 0xb9, 0x60, 0xb7, 0x13, 0x1f, 0xb4, 0xe3, 0xc4, 0x1e, 0x3b, 0xbb, 0x9b, 0x71,
+	0xe2, 0x98, 0x98, 0xec, 0x28, 0x06, 0x2e, 0x87, 0x10, 0x20, 0x6f, 0x7c, 0x31, 0x08, 0x94, 0x0b,
+	0x1b, 0x73, 0x20, 0x5e, 0x46, 0xbd, 0x33, 0x7d, 0xeb, 0x91, 0xd7, 0xd3, 0x73, 0xd3, 0xbd, 0x8e,
+	0xf7, 0x92, 0x00, 0xe2, 0x40, 0x48, 0xf0, 0x72, 0xd2, 0x3d, 0x80, 0x74, 0x3c, 0xc0, 0x0b, 0x42,
+	0x42, 0x3c, 0x1c, 0xe2, 0x47, 0x44, 0x3c, 0x9d, 0x84, 0x84, 0x4e, 0x07, 0xca, 0x41, 0xc2, 0xaf,
+	0x00, 0x81, 0xd0, 0xf4, 0xd4, 0xcc, 0xf6, 0xcc, 0xf4, 0xee, 0xfa, 0x38, 0x5b, 0x44, 0xe6, 0xc9,
+	0xde, 0xe9, 0xea, 0xae, 0xaf, 0xbe, 0xaa, 0xa9, 0xae, 0xaa, 0x41, 0xb3, 0x82, 0x76, 0xe8, 0x2e,
+	0x15, 0x51, 0xcf, 0x7e, 0xad, 0x4b, 0xa3, 0x5e, 0x3d, 0x8c, 0x98, 0x60, 0x78, 0x32, 0x7b, 0x6c,
+	0x9e, 0x6d, 0xb3, 0x36, 0x93, 0x4f, 0xed, 0xf8, 0xbf, 0x44, 0xc0, 0x5c, 0x6c, 0x33, 0xd6, 0xee,
+	0x50, 0x9b, 0x84, 0xbe, 0x4d, 0x82, 0x80, 0x09, 0x22, 0x7c, 0x16, 0x70, 0x58, 0xad, 0xb8, 0x8c,
+	0xef, 0x32, 0x6e, 0xb7, 0x08, 0xa7, 0xf6, 0xde, 0xf5, 0x16, 0x15, 0xe4, 0xba, 0xed, 0x32, 0x3f,
+	0x80, 0xf5, 0x8b, 0xba, 0xf5, 0x16, 0xe9, 0x90, 0xc0, 0xa5, 0x20, 0x72, 0x55, 0x15, 0x91, 0xd0,
+	0x32, 0xc1, 0x90, 0xb4, 0xfd, 0x40, 0xea, 0x03, 0xd9, 0x2a, 0x80, 0x91, 0xbf, 0x5a, 0xdd, 0x57,
+	0x6d, 0xe1, 0xef, 0x52, 0x2e, 0xc8, 0x6e, 0x08, 0x02, 0xf3, 0x7d, 0x2b, 0xb3, 0xff, 0x60, 0x69,
+	0x49, 0x07, 0x65, 0x8f, 0x74, 0x7c, 0x8f, 0x08, 0x16, 0x25, 0x42, 0xd6, 0x4f, 0x0c, 0x74, 0xfe,
+	0xeb, 0x31, 0x86, 0x2d, 0x16, 0x36, 0x12, 0x98, 0xbc, 0x49, 0x5f, 0xeb, 0x52, 0x2e, 0xf0, 0x59,
+	0xf4, 0x9c, 0x47, 0x03, 0xb6, 0x3b, 0x67, 0xd4, 0x8c, 0x95, 0xc9, 0x66, 0xf2, 0x03, 0xdf, 0x42,
+	0xa8, 0x0f, 0x73, 0x6e, 0xac, 0x66, 0xac, 0x4c, 0xad, 0x2d, 0xd7, 0x13, 0x5d, 0xf5, 0x58, 0x57,
+	0x3d, 0xa1, 0x1b, 0x34, 0xd6, 0xef, 0x90, 0x36, 0x85, 0x13, 0x9b, 0xca, 0x4e, 0x8c, 0xd1, 0x49,
+	0x8f, 0x72, 0x77, 0x6e, 0xbc, 0x66, 0xac, 0x4c, 0x34, 0xe5, 0xff, 0xd6, 0x7b, 0x06, 0x9a, 0x2b,
+	0xa3, 0xe1, 0x21, 0x0b, 0x38, 0xc5, 0x1c, 0x4d, 0x00, 0x91, 0x7c, 0xce, 0xa8, 0x8d, 0xaf, 0x4c,
+	0xad, 0x2d, 0xe6, 0xd4, 0xa6, 0x0a, 0x61, 0x63, 0xe3, 0x73, 0x8f, 0x1e, 0x57, 0x4f, 0xfc, 0xe3,
+	0x71, 0xf5, 0x7a, 0xdb, 0x17, 0xdb, 0xdd, 0x56, 0xdd, 0x65, 0xbb, 0x36, 0x50, 0x92, 0xfc, 0xb9,
+	0xc6, 0xbd, 0x1d, 0x7b, 0xdf, 0x6e, 0x91, 0x60, 0xc7, 0x16, 0xbd, 0x90, 0xf2, 0x74, 0x6b, 0x33,
+	0x53, 0x84, 0x37, 0x35, 0xd6, 0x5e, 0x19, 0x69, 0x6d, 0x82, 0x58, 0x35, 0xd7, 0xfa, 0x9e, 0x81,
+	0x2a, 0xd2, 0xb4, 0x97, 0xf6, 0x05, 0x0d, 0x3c, 0xea, 0xbd, 0x92, 0x7a, 0x22, 0xe3, 0xfb, 0x1c,
+	0x3a, 0xc5, 0x05, 0x11, 0x5d, 0x0e, 0x84, 0xc3, 0xaf, 0xc3, 0x62, 0xdc, 0xfa, 0x60, 0x0c, 0x55,
+	0x07, 0x42, 0x00, 0x92, 0xbf, 0x83, 0x50, 0x16, 0x22, 0x29, 0xcd, 0x15, 0x2d, 0xcd, 0xd9, 0xe6,
+	0xc6, 0x17, 0x81, 0xe8, 0x17, 0x46, 0x10, 0xcd, 0x05, 0xd9, 0xf1, 0x83, 0x36, 0x70, 0x9d, 0xed,
+	0x6f, 0x2a, 0x1a, 0x73, 0x4e, 0x1e, 0xfb, 0xdf, 0x38, 0x79, 0xfc, 0xbf, 0x77, 0xf2, 0xfb, 0x69,
+	0xfc, 0xae, 0xef, 0xb5, 0x1b, 0x1d, 0xe6, 0xee, 0xdc, 0xf5, 0x5f, 0x4f, 0x5d, 0x81, 0x6f, 0x22,
+	0xc4, 0x05, 0x89, 0x84, 0xe3, 0x11, 0x41, 0xa5, 0x8b, 0xa7, 0xd6, 0xcc, 0x7a, 0xf2, 0x82, 0xd7,
+	0xd3, 0x17, 0xbc, 0xbe, 0x95, 0xbe, 0xe0, 0x8d, 0x89, 0x47, 0x8f, 0xab, 0xc6, 0x9b, 0x1f, 0x54,
+	0x8d, 0xe6, 0xa4, 0xdc, 0xb7, 0x41, 0x04, 0xc5, 0x5f, 0x42, 0x13, 0x34, 0xf0, 0x92, 0x23, 0xc6,
+	0x3e, 0xc4, 0x11, 0x1f, 0xa3, 0x81, 0x27, 0x0f, 0x78, 0x11, 0x4d, 0xb5, 0x23, 0x12, 0x74, 0x3b,
+	0x24, 0xf2, 0x45, 0x4f, 0x1a, 0x3b, 0xbd, 0x76, 0xae, 0xde, 0x4f, 0x1e, 0x9b, 0xfd, 0xd5, 0xa6,
+	0x2a, 0x6a, 0x79, 0x68, 0x5e, 0x63, 0x1b, 0xc4, 0xcd, 0x26, 0x9a, 0x26, 0x7b, 0x6d, 0xa7, 0x15,
+	0x2f, 0x38, 0xdc, 0x7f, 0x9d, 0x42, 0xec, 0x2c, 0x28, 0x27, 0xaf, 0xef, 0xd1, 0x88, 0xb4, 0x69,
+	0xb6, 0xb9, 0x71, 0x32, 0x76, 0x5e, 0xf3, 0x34, 0x51, 0x0e, 0x2c, 0x53, 0x18, 0x5b, 0x73, 0x5c,
+	0x29, 0x4c, 0x6c, 0xd3, 0x51, 0x18, 0xe7, 0xf9, 0x11, 0x14, 0xc6, 0x9b, 0x8b, 0x14, 0xc6, 0xcf,
+	0xac, 0x3f, 0x19, 0xe8, 0x6c, 0xaa, 0x66, 0x6b, 0xff, 0x16, 0x3d, 0x36, 0xf4, 0x6d, 0xa1, 0xd9,
+	0x82, 0x5d, 0x40, 0xdd, 0xe7, 0x11, 0x8a, 0xa9, 0x13, 0xfb, 0xce, 0xab, 0x34, 0xa5, 0xed, 0x7c,
+	0x99, 0x36, 0xb9, 0x09, 0x28, 0x9b, 0x20, 0x70, 0x48, 0x9f, 0xae, 0xad, 0xfd, 0x57, 0x58, 0xa7,
+	0x7b, 0x7c, 0xa2, 0xed, 0x65, 0x34, 0x5b, 0xb0, 0x0b, 0xe8, 0x7a, 0x01, 0x4d, 0x8a, 0x7d, 0x67,
+	0x4f, 0x3e, 0x04, 0xb6, 0x66, 0x94, 0x03, 0x53, 0xf9, 0x94, 0x29, 0x01, 0xbf, 0xad, 0x7f, 0x19,
+	0x10, 0xbf, 0x5b, 0x2c, 0x2c, 0x5f, 0x5f, 0xcf, 0x06, 0x5d, 0xb7, 0x34, 0xb9, 0xfc, 0xa3, 0x94,
+	0x27, 0x27, 0x95, 0xf2, 0xe4, 0x77, 0x06, 0x32, 0x75, 0xf6, 0x03, 0xad, 0x5f, 0x45, 0xd3, 0x82,
+	0x85, 0x8e, 0xe6, 0xfe, 0xec, 0x73, 0xdb, 0xbf, 0x35, 0x65, 0xc6, 0x13, 0x44, 0x70, 0xa0, 0xf9,
+	0x8c, 0x50, 0x0f, 0x3d, 0xbc, 0xc2, 0xe3, 0x57, 0x06, 0x5a, 0x90, 0xa0, 0xf3, 0xaa, 0x33, 0xb7,
+	0xad, 0xa2, 0x4f, 0x64, 0x88, 0x1d, 0xe2, 0x79, 0x11, 0xe5, 0x69, 0x01, 0xf2, 0xf1, 0x6c, 0x61,
+	0x3d, 0x79, 0x7e, 0xa4, 0xc5, 0xdf, 0x2f, 0x0c, 0xb4, 0xa8, 0x07, 0x0a, 0xfc, 0xde, 0x40, 0xa7,
+	0x64, 0x72, 0x4c, 0x79, 0x9d, 0x1f, 0xc8, 0x2b, 0x50, 0x0a, 0xe2, 0x87, 0xc7, 0xe5, 0x6d, 0x28,
+	0xa0, 0xfa, 0xda, 0x7a, 0x37, 0x59, 0xc0, 0x63, 0x76, 0x14, 0x3a, 0xdd, 0x78, 0x5f, 0xc0, 0xbb,
+	0xbc, 0x48, 0x67, 0xb6, 0x00, 0x74, 0xc6, 0x26, 0xd7, 0x06, 0x1f, 0x08, 0x66, 0x3f, 0x40, 0x93,
+	0x99, 0x1f, 0xe0, 0xb5, 0x3a, 0xea, 0x8a, 0xac, 0xaf, 0xd0, 0xfa, 0x4b, 0x1a, 0xf3, 0x2f, 0x47,
+	0xc4, 0xed, 0xa4, 0xce, 0xe4, 0xc7, 0x25, 0x47, 0xb6, 0xd1, 0x82, 0xd6, 0x3a, 0xe0, 0xfe, 0xcb,
+	0xe8, 0x79, 0x26, 0x57, 0x9c, 0x08, 0x96, 0x34, 0xb1, 0x97, 0xdf, 0x0b, 0xb1, 0x37, 0xcd, 0x72,
+	0x4f, 0xad, 0x7f, 0x2b, 0xb9, 0x63, 0x83, 0x08, 0x72, 0x97, 0x75, 0x23, 0x97, 0x3e, 0x63, 0x3c,
+	0x1e, 0x65, 0xf2, 0xfc, 0x4d, 0x9a, 0x87, 0x8a, 0x04, 0x00, 0xd5, 0x37, 0xd1, 0x69, 0x8f, 0x08,
+	0xe2, 0xf0, 0xe4, 0x39, 0xf0, 0x6c, 0x2a, 0x3c, 0xf7, 0x77, 0xa9, 0x79, 0x73, 0xca, 0xeb, 0x1f,
+	0x76, 0x78, 0x6f, 0xfa, 0x1b, 0x69, 0xaf, 0xd4, 0xa4, 0x21, 0x8b, 0xc4, 0x1d, 0x12, 0x09, 0xdf,
+	0xf5, 0x43, 0xb9, 0xf8, 0xff, 0xe3, 0xb3, 0x77, 0xd2, 0xfc, 0xa4, 0x65, 0x01, 0x1c, 0xb7, 0xa1,
+	0x6d, 0x19, 0xfb, 0x6e, 0xd3, 0xec, 0x05, 0xd7, 0x29, 0xfb, 0x0e, 0xcf, 0x73, 0x7f, 0x33, 0xc0,
+	0x73, 0x71, 0xb8, 0xdc, 0x89, 0xd8, 0x9e, 0xef, 0xd1, 0xa8, 0x49, 0xef, 0x91, 0xc8, 0x3b, 0x36,
+	0x59, 0xeb, 0x01, 0xaa, 0x0d, 0x36, 0x11, 0xdc, 0xf2, 0x2d, 0x34, 0x2b, 0xdf, 0xa7, 0x10, 0xd6,
+	0x9d, 0x28, 0x11, 0xd0, 0x78, 0x48, 0x73, 0x0c, 0x78, 0x68, 0xc6, 0x2b, 0x2f, 0xf5, 0xaf, 0x84,
+	0x75, 0x57, 0xf8, 0x7b, 0x74, 0xdd, 0x75, 0x59, 0x37, 0x38, 0x7e, 0x57, 0x42, 0xd1, 0xba, 0xfe,
+	0x95, 0x40, 0xe4, 0x8a, 0x43, 0x60, 0x49, 0x73, 0x25, 0xe4, 0xf7, 0xa6, 0x57, 0x02, 0xc9, 0x3d,
+	0xb5, 0xfe, 0x9c, 0x96, 0xd3, 0xf0, 0x64, 0x33, 0x62, 0xf7, 0xc4, 0xf6, 0x71, 0xa1, 0xd1, 0x45,
+	0xa6, 0xce, 0x38, 0x60, 0xf1, 0x25, 0x34, 0x0d, 0xf4, 0x39, 0x6d, 0xb9, 0x02, 0x24, 0xce, 0xe5,
+	0x48, 0x54, 0x76, 0xa6, 0x55, 0x32, 0x51, 0x1f, 0x5a, 0xff, 0x54, 0xc6, 0x97, 0xcf, 0x66, 0x1c,
+	0x1e, 0x65, 0x7a, 0xfe, 0xb9, 0x32, 0x2e, 0x2d, 0xc5, 0xe9, 0x0d, 0x34, 0x51, 0x08, 0xd0, 0xd9,
+	0x32, 0xb7, 0x5b, 0xfb, 0x3c, 0xeb, 0x87, 0x41, 0xf8, 0xf0, 0x32, 0xf1, 0xaf, 0xd3, 0x91, 0xe7,
+	0xdd, 0x6e, 0x18, 0x76, 0x7a, 0x1b, 0x3e, 0x17, 0x91, 0xdf, 0xea, 0xaa, 0x57, 0xa8, 0x7e, 0xc4,
+	0x7c, 0x1b, 0x21, 0xb1, 0x1d, 0x51, 0xbe, 0xcd, 0x3a, 0x5e, 0x32, 0x06, 0x9c, 0x6c, 0xd4, 0x63,
+	0x94, 0xef, 0x3f, 0xae, 0x2e, 0x0f, 0x2d, 0x69, 0x93, 0x42, 0xf6, 0x2b, 0x81, 0x68, 0x2a, 0x27,
+	0xe0, 0x1a, 0x9a, 0x0a, 0x69, 0xe4, 0xd2, 0x40, 0xf8, 0x1d, 0xca, 0xe7, 0xc6, 0x6b, 0xe3, 0x2b,
+	0x67, 0x9a, 0xea, 0x23, 0xeb, 0x1e, 0xaa, 0x0e, 0x44, 0x0a, 0x7c, 0x6e, 0xa1, 0x19, 0x2e, 0x57,
+	0x1d, 0x4f, 0x59, 0x86, 0xb8, 0xba, 0xa0, 0x50, 0x5b, 0x3e, 0x03, 0x28, 0xc6, 0xbc, 0xb4, 0x62,
+	0xfd, 0x78, 0xac, 0xd8, 0x9d, 0x7d, 0x23, 0x14, 0xca, 0xc4, 0xeb, 0x43, 0x75, 0x67, 0xf9, 0x88,
+	0x1f, 0xfb, 0xe8, 0x11, 0x3f, 0x7e, 0x08, 0x29, 0xe3, 0xe4, 0xc1, 0x53, 0xc6, 0x3b, 0xa5, 0x0e,
+	0x30, 0x25, 0x03, 0x7c, 0xf0, 0x22, 0x3a, 0xd5, 0x0d, 0x95, 0xd1, 0x98, 0xa9, 0xeb, 0x00, 0x93,
+	0x3d, 0x69, 0x0b, 0x98, 0xc8, 0xe3, 0x6f, 0xa2, 0x59, 0x28, 0xe4, 0x93, 0xa1, 0xba, 0xe3, 0x6e,
+	0x93, 0xa0, 0x9d, 0x0d, 0x99, 0x2f, 0x94, 0xca, 0xf9, 0xbb, 0x52, 0xec, 0xa6, 0x94, 0x4a, 0x2f,
+	0x43, 0x56, 0x5a, 0xe1, 0xd6, 0x0f, 0xc7, 0xd0, 0xc5, 0xf2, 0x4c, 0xa0, 0xd1, 0xcb, 0xbb, 0xf1,
+	0xf8, 0xe7, 0xa2, 0xdf, 0x1b, 0xc8, 0x1a, 0xc6, 0x43, 0x96, 0xf7, 0xcb, 0xc5, 0x62, 0x75, 0xb0,
+	0x17, 0xd5, 0x42, 0xff, 0x28, 0xaa, 0xc5, 0xb5, 0x3f, 0x9c, 0x45, 0xcf, 0x49, 0xd8, 0xf8, 0x1e,
+	0x9a, 0x52, 0xbe, 0x3a, 0x61, 0x4b, 0x01, 0x35, 0xe0, 0x03, 0x99, 0xb9, 0x34, 0x54, 0x26, 0xd1,
+	0x66, 0x55, 0xbf, 0xff, 0xc7, 0xbf, 0xbf, 0x35, 0x36, 0x8f, 0xcf, 0xdb, 0xca, 0xa7, 0x3a, 0x16,
+	0x3a, 0xd9, 0xd7, 0x87, 0xb7, 0x0c, 0x84, 0xcb, 0x5f, 0x64, 0xf0, 0x27, 0x8b, 0x87, 0x0f, 0xfc,
+	0x70, 0x64, 0x5e, 0x3d, 0x88, 0x28, 0xc0, 0x59, 0x96, 0x70, 0x6a, 0xb8, 0xa2, 0xc0, 0xe9, 0x13,
+	0xdb, 0x47, 0xf5, 0x00, 0x9d, 0x56, 0x07, 0xfd, 0xb8, 0x64, 0xab, 0xe6, 0x13, 0x87, 0x79, 0x69,
+	0xb8, 0x10, 0x40, 0xb8, 0x28, 0x21, 0x2c, 0xe0, 0x79, 0x05, 0x42, 0xfe, 0xe3, 0x81, 0xaa, 0x3d,
+	0x8e, 0xe8, 0xc1, 0xda, 0x95, 0xaf, 0x03, 0xe6, 0xa5, 0xe1, 0x42, 0x07, 0xd2, 0x2e, 0x93, 0x45,
+	0x07, 0x4d, 0xa4, 0x23, 0x66, 0x5c, 0xd5, 0x1c, 0xaa, 0x0e, 0xd5, 0xcd, 0xda, 0x60, 0x01, 0xd0,
+	0x78, 0x41, 0x6a, 0x3c, 0x8f, 0x67, 0x0b, 0x1a, 0x93, 0x71, 0x35, 0xde, 0x41, 0x13, 0xe9, 0xc4,
+	0xb5, 0xac, 0xad, 0x30, 0x93, 0x36, 0x6b, 0x83, 0x05, 0x40, 0xdb, 0xa2, 0xd4, 0x76, 0x0e, 0x9f,
+	0x55, 0xe3, 0x2d, 0x9d, 0xf6, 0xe2, 0xef, 0xa2, 0x33, 0xb9, 0x17, 0x14, 0x5f, 0xd2, 0xc4, 0x70,
+	0x39, 0xc2, 0x2e, 0x8f, 0x90, 0x1a, 0xc2, 0x6d, 0x7e, 0x24, 0x8a, 0x7f, 0x64, 0xa0, 0xe7, 0x0b,
+	0x03, 0x3e, 0xbc, 0x5c, 0x3c, 0x5d, 0x3f, 0xaa, 0x34, 0xaf, 0x8c, 0x94, 0x03, 0x1c, 0x4b, 0x12,
+	0xc7, 0x05, 0xbc, 0xa0, 0x0b, 0x72, 0x07, 0xa6, 0x82, 0xbf, 0x35, 0xd0, 0x8c, 0x66, 0xee, 0x86,
+	0xaf, 0x0e, 0xd6, 0x52, 0x9c, 0xf6, 0x99, 0xab, 0x07, 0x92, 0x05, 0x54, 0x5f, 0x90, 0xa8, 0x6e,
+	0xe0, 0xcf, 0xea, 0x51, 0xf5, 0x9c, 0x78, 0x3e, 0x28, 0x2f, 0x79, 0xfb, 0x7e, 0x69, 0x86, 0xf8,
+	0x10, 0xbf, 0x61, 0xa0, 0xe9, 0xfc, 0xa8, 0x09, 0x97, 0xdc, 0xa2, 0x1d, 0xd2, 0x99, 0xcb, 0xa3,
+	0xc4, 0x00, 0xa0, 0x25, 0x01, 0x2e, 0x62, 0x53, 0x01, 0x58, 0x18, 0x7f, 0xe1, 0x1f, 0x18, 0x68,
+	0x3a, 0x3f, 0xc1, 0xc1, 0xba, 0xe0, 0x28, 0x8f, 0xb8, 0xcc, 0xe5, 0x51, 0x62, 0x43, 0x9c, 0x17,
+	0x07, 0x91, 0x3a, 0x1d, 0xc2, 0x3f, 0x35, 0xd0, 0x8c, 0x66, 0xb0, 0x50, 0x76, 0xde, 0xe0, 0xf9,
+	0x8d, 0xb9, 0x7a, 0x20, 0x59, 0x40, 0x75, 0x45, 0xa2, 0xba, 0x88, 0xab, 0x0a, 0xaa, 0x48, 0xca,
+	0x3b, 0x61, 0x0e, 0xc1, 0xcf, 0x0c, 0x34, 0xa3, 0x69, 0xa8, 0xcb, 0xc8, 0x06, 0xcf, 0x27, 0xcc,
+	0xd5, 0x03, 0xc9, 0x02, 0xb2, 0x15, 0x89, 0xcc, 0xc2, 0x35, 0x05, 0x99, 0xb6, 0xf3, 0x97, 0x11,
+	0x94, 0xef, 0x4c, 0xcb, 0xbe, 0xd3, 0xf6, 0xf4, 0xe6, 0xf2, 0x28, 0xb1, 0x21, 0x11, 0x54, 0xe8,
+	0x96, 0xe3, 0x14, 0x94, 0xeb, 0xec, 0xca, 0x29, 0x48, 0xd7, 0x0f, 0x9b, 0x97, 0x47, 0x48, 0x0d,
+	0x4b, 0xef, 0xb9, 0x4e, 0x13, 0x6e, 0xfa, 0x8c, 0x02, 0xdd, 0x4d, 0x5f, 0xb4, 0x7f, 0x69, 0xa8,
+	0xcc, 0x88, 0x9b, 0x3e, 0xb3, 0xfc, 0x6d, 0x03, 0xe1, 0x72, 0x77, 0x50, 0xbe, 0xe9, 0x07, 0xf6,
+	0x4b, 0xe6, 0xd5, 0x83, 0x88, 0x02, 0x9c, 0xba, 0x84, 0xb3, 0x82, 0x97, 0x15, 0x38, 0x9a, 0x0e,
+	0xc6, 0xbe, 0x2f, 0x9b, 0xae, 0x87, 0xf8, 0x6d, 0x35, 0x33, 0x27, 0xe5, 0xd7, 0x90, 0xcc, 0x9c,
+	0xab, 0x6f, 0xcd, 0x2b, 0x23, 0xe5, 0x00, 0xd4, 0x0d, 0x09, 0xea, 0x3a, 0xb6, 0xb5, 0x39, 0x30,
+	0x29, 0xd6, 0xed, 0xfb, 0xa5, 0x96, 0xe7, 0x21, 0xfe, 0xa5, 0x81, 0x66, 0xb5, 0xa5, 0x25, 0xfe,
+	0xd4, 0xd0, 0xbb, 0xa9, 0x50, 0x89, 0x9b, 0xd7, 0x0e, 0x28, 0x0d, 0x78, 0x57, 0x25, 0xde, 0xcb,
+	0x78, 0x69, 0xe0, 0x8d, 0x16, 0x27, 0xee, 0x04, 0x77, 0xe3, 0xf6, 0xa3, 0x27, 0x15, 0xe3, 0xdd,
+	0x27, 0x15, 0xe3, 0xaf, 0x4f, 0x2a, 0xc6, 0x9b, 0x4f, 0x2b, 0x27, 0xde, 0x7d, 0x5a, 0x39, 0xf1,
+	0xde, 0xd3, 0xca, 0x89, 0x6f, 0x7f, 0x46, 0xe9, 0x5a, 0x37, 0x29, 0xdb, 0x68, 0x5c, 0xfb, 0x9a,
+	0xbf, 0xeb, 0x0b, 0xea, 0xd9, 0xcc, 0xf3, 0x83, 0x6b, 0x2e, 0x8b, 0xa8, 0xbd, 0xaf, 0xaa, 0xe8,
+	0x85, 0x94, 0xb7, 0x4e, 0xc9, 0xb2, 0xfe, 0xd3, 0xff, 0x19, 0x00, 0x1c, 0x7f, 0xbf, 0xcc, 0xcb,
+	0x26, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// TopBalances returns all the system balances for specific denom.
+	TopBalances(ctx context.Context, in *QueryTopBalancesRequest, opts ...grpc.CallOption) (*QueryTopBalancesResponse, error)
+	// ExtendedValidators returns validators balances.
+	ExtendedValidators(ctx context.Context, in *QueryExtendedValidatorsRequest, opts ...grpc.CallOption) (*QueryExtendedValidatorsResponse, error)
+	// AvgBlockSize returns average block size per time bucket.
+	AvgBlockSize(ctx context.Context, in *QueryAvgBlockSizeRequest, opts ...grpc.CallOption) (*QueryAvgBlockSizeResponse, error)
+	// AvgBlockTime returns average block time per time bucket.
+	AvgBlockTime(ctx context.Context, in *QueryAvgBlockTimeRequest, opts ...grpc.CallOption) (*QueryAvgBlockTimeResponse, error)
+	// AvgTxFee returns average transaction fee per time bucket.
+	AvgTxFee(ctx context.Context, in *QueryAvgTxFeeRequest, opts ...grpc.CallOption) (*QueryAvgTxFeeResponse, error)
+	// TxVolume returns count of transactions per time bucket.
+	TxVolume(ctx context.Context, in *QueryTxVolumeRequest, opts ...grpc.CallOption) (*QueryTxVolumeResponse, error)
+	// TopValidators returns validators blocks and stake percentage.
+	TopValidators(ctx context.Context, in *QueryTopValidatorsRequest, opts ...grpc.CallOption) (*QueryTopValidatorsResponse, error)
+	// ValidatorBlocks returns validator approved blocks.
+	ValidatorBlocks(ctx context.Context, in *QueryValidatorBlocksRequest, opts ...grpc.CallOption) (*QueryValidatorBlocksResponse, error)
+	ValidatorByConsAddr(ctx context.Context, in *QueryValidatorByConsAddrRequest, opts ...grpc.CallOption) (*QueryValidatorByConsAddrResponse, error)
+	// OracleRequests returns oracle requests per time bucket by oracle script and resolve status.
+	OracleRequests(ctx context.Context, in *QueryOracleRequestsRequest, opts ...grpc.CallOption) (*QueryOracleRequestsResponse, error)
+	// TopDataSources returns data sources by number of raw requests.
+	TopDataSources(ctx context.Context, in *QueryTopDataSourcesRequest, opts ...grpc.CallOption) (*QueryTopDataSourcesResponse, error)
+	// ReportParticipation returns validators reports compared to the requests they were asked for.
+	ReportParticipation(ctx context.Context, in *QueryReportParticipationRequest, opts ...grpc.CallOption) (*QueryReportParticipationResponse, error)
+	// DataProviderRewards returns rewards paid out to data providers per time bucket.
+	DataProviderRewards(ctx context.Context, in *QueryDataProviderRewardsRequest, opts ...grpc.CallOption) (*QueryDataProviderRewardsResponse, error)
+	// ActiveAccounts returns the number of distinct transaction signers per time bucket.
+	ActiveAccounts(ctx context.Context, in *QueryActiveAccountsRequest, opts ...grpc.CallOption) (*QueryActiveAccountsResponse, error)
+	// AccountGrowth returns the new and total accounts per time bucket.
+	AccountGrowth(ctx context.Context, in *QueryAccountGrowthRequest, opts ...grpc.CallOption) (*QueryAccountGrowthResponse, error)
+	// TopAccounts returns accounts by number of signed transactions.
+	TopAccounts(ctx context.Context, in *QueryTopAccountsRequest, opts ...grpc.CallOption) (*QueryTopAccountsResponse, error)
+	// SupplyDistribution returns how the supply of a denomination is distributed as of the last supply snapshot.
+	SupplyDistribution(ctx context.Context, in *QuerySupplyDistributionRequest, opts ...grpc.CallOption) (*QuerySupplyDistributionResponse, error)
+	// ValidatorUptime returns the blocks a validator signed and missed per time bucket along with its oracle status.
+	ValidatorUptime(ctx context.Context, in *QueryValidatorUptimeRequest, opts ...grpc.CallOption) (*QueryValidatorUptimeResponse, error)
+	// TopValidatorsByUptime returns validators by the share of blocks they signed.
+	TopValidatorsByUptime(ctx context.Context, in *QueryTopValidatorsByUptimeRequest, opts ...grpc.CallOption) (*QueryTopValidatorsByUptimeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) TopBalances(ctx context.Context, in *QueryTopBalancesRequest, opts ...grpc.CallOption) (*QueryTopBalancesResponse, error) {
+	out := new(QueryTopBalancesResponse)
+	err := c.cc.Invoke(ctx, "/telemetry.Query/TopBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ExtendedValidators(ctx context.Context, in *QueryExtendedValidatorsRequest, opts ...grpc.CallOption) (*QueryExtendedValidatorsResponse, error) {
+	out := new(QueryExtendedValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/telemetry.Query/ExtendedValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) AvgBlockSize(ctx context.Context, in *QueryAvgBlockSizeRequest, opts ...grpc.CallOption) (*QueryAvgBlockSizeResponse, error) {
+	out := new(QueryAvgBlockSizeResponse)
+	err := c.cc.Invoke(ctx, "/telemetry.Query/AvgBlockSize", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Desc {
-		n += 2
+	return out, nil
+}
+
+func (c *queryClient) AvgBlockTime(ctx context.Context, in *QueryAvgBlockTimeRequest, opts ...grpc.CallOption) (*QueryAvgBlockTimeResponse, error) {
+	out := new(QueryAvgBlockTimeResponse)
+	err := c.cc.Invoke(ctx, "/telemetry.Query/AvgBlockTime", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryValidatorBlocksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) AvgTxFee(ctx context.Context, in *QueryAvgTxFeeRequest, opts ...grpc.CallOption) (*QueryAvgTxFeeResponse, error) {
+	out := new(QueryAvgTxFeeResponse)
+	err := c.cc.Invoke(ctx, "/telemetry.Query/AvgTxFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Blocks) > 0 {
-		for _, e := range m.Blocks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) TxVolume(ctx context.Context, in *QueryTxVolumeRequest, opts ...grpc.CallOption) (*QueryTxVolumeResponse, error) {
+	out := new(QueryTxVolumeResponse)
+	err := c.cc.Invoke(ctx, "/telemetry.Query/TxVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TopValidators(ctx context.Context, in *QueryTopValidatorsRequest, opts ...grpc.CallOption) (*QueryTopValidatorsResponse, error) {
+	out := new(QueryTopValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/telemetry.Query/TopValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorBlocks(ctx context.Context, in *QueryValidatorBlocksRequest, opts ...grpc.CallOption) (*QueryValidatorBlocksResponse, error) {
+	out := new(QueryValidatorBlocksResponse)
+	err := c.cc.Invoke(ctx, "/telemetry.Query/ValidatorBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorByConsAddr(ctx context.Context, in *QueryValidatorByConsAddrRequest, opts ...grpc.CallOption) (*QueryValidatorByConsAddrResponse, error) {
+	out := new(QueryValidatorByConsAddrResponse)
+	err := c.cc.Invoke(ctx, "/telemetry.Query/ValidatorByConsAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OracleRequests(ctx context.Context, in *QueryOracleRequestsRequest, opts ...grpc.CallOption) (*QueryOracleRequestsResponse, error) {
+	out := new(QueryOracleRequestsResponse)
+	err := c.cc.Invoke(ctx, "/telemetry.Query/OracleRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TopDataSources(ctx context.Context, in *QueryTopDataSourcesRequest, opts ...grpc.CallOption) (*QueryTopDataSourcesResponse, error) {
+	out := new(QueryTopDataSourcesResponse)
+	err := c.cc.Invoke(ctx, "/telemetry.Query/TopDataSources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReportParticipation(ctx context.Context, in *QueryReportParticipationRequest, opts ...grpc.CallOption) (*QueryReportParticipationResponse, error) {
+	out := new(QueryReportParticipationResponse)
+	err := c.cc.Invoke(ctx, "/telemetry.Query/ReportParticipation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DataProviderRewards(ctx context.Context, in *QueryDataProviderRewardsRequest, opts ...grpc.CallOption) (*QueryDataProviderRewardsResponse, error) {
+	out := new(QueryDataProviderRewardsResponse)
+	err := c.cc.Invoke(ctx, "/telemetry.Query/DataProviderRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActiveAccounts(ctx context.Context, in *QueryActiveAccountsRequest, opts ...grpc.CallOption) (*QueryActiveAccountsResponse, error) {
+	out := new(QueryActiveAccountsResponse)
+	err := c.cc.Invoke(ctx, "/telemetry.Query/ActiveAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountGrowth(ctx context.Context, in *QueryAccountGrowthRequest, opts ...grpc.CallOption) (*QueryAccountGrowthResponse, error) {
+	out := new(QueryAccountGrowthResponse)
+	err := c.cc.Invoke(ctx, "/telemetry.Query/AccountGrowth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TopAccounts(ctx context.Context, in *QueryTopAccountsRequest, opts ...grpc.CallOption) (*QueryTopAccountsResponse, error) {
+	out := new(QueryTopAccountsResponse)
+	err := c.cc.Invoke(ctx, "/telemetry.Query/TopAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyDistribution(ctx context.Context, in *QuerySupplyDistributionRequest, opts ...grpc.CallOption) (*QuerySupplyDistributionResponse, error) {
+	out := new(QuerySupplyDistributionResponse)
+	err := c.cc.Invoke(ctx, "/telemetry.Query/SupplyDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorUptime(ctx context.Context, in *QueryValidatorUptimeRequest, opts ...grpc.CallOption) (*QueryValidatorUptimeResponse, error) {
+	out := new(QueryValidatorUptimeResponse)
+	err := c.cc.Invoke(ctx, "/telemetry.Query/ValidatorUptime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TopValidatorsByUptime(ctx context.Context, in *QueryTopValidatorsByUptimeRequest, opts ...grpc.CallOption) (*QueryTopValidatorsByUptimeResponse, error) {
+	out := new(QueryTopValidatorsByUptimeResponse)
+	err := c.cc.Invoke(ctx, "/telemetry.Query/TopValidatorsByUptime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// TopBalances returns all the system balances for specific denom.
+	TopBalances(context.Context, *QueryTopBalancesRequest) (*QueryTopBalancesResponse, error)
+	// ExtendedValidators returns validators balances.
+	ExtendedValidators(context.Context, *QueryExtendedValidatorsRequest) (*QueryExtendedValidatorsResponse, error)
+	// AvgBlockSize returns average block size per time bucket.
+	AvgBlockSize(context.Context, *QueryAvgBlockSizeRequest) (*QueryAvgBlockSizeResponse, error)
+	// AvgBlockTime returns average block time per time bucket.
+	AvgBlockTime(context.Context, *QueryAvgBlockTimeRequest) (*QueryAvgBlockTimeResponse, error)
+	// AvgTxFee returns average transaction fee per time bucket.
+	AvgTxFee(context.Context, *QueryAvgTxFeeRequest) (*QueryAvgTxFeeResponse, error)
+	// TxVolume returns count of transactions per time bucket.
+	TxVolume(context.Context, *QueryTxVolumeRequest) (*QueryTxVolumeResponse, error)
+	// TopValidators returns validators blocks and stake percentage.
+	TopValidators(context.Context, *QueryTopValidatorsRequest) (*QueryTopValidatorsResponse, error)
+	// ValidatorBlocks returns validator approved blocks.
+	ValidatorBlocks(context.Context, *QueryValidatorBlocksRequest) (*QueryValidatorBlocksResponse, error)
+	ValidatorByConsAddr(context.Context, *QueryValidatorByConsAddrRequest) (*QueryValidatorByConsAddrResponse, error)
+	// OracleRequests returns oracle requests per time bucket by oracle script and resolve status.
+	OracleRequests(context.Context, *QueryOracleRequestsRequest) (*QueryOracleRequestsResponse, error)
+	// TopDataSources returns data sources by number of raw requests.
+	TopDataSources(context.Context, *QueryTopDataSourcesRequest) (*QueryTopDataSourcesResponse, error)
+	// ReportParticipation returns validators reports compared to the requests they were asked for.
+	ReportParticipation(context.Context, *QueryReportParticipationRequest) (*QueryReportParticipationResponse, error)
+	// DataProviderRewards returns rewards paid out to data providers per time bucket.
+	DataProviderRewards(context.Context, *QueryDataProviderRewardsRequest) (*QueryDataProviderRewardsResponse, error)
+	// ActiveAccounts returns the number of distinct transaction signers per time bucket.
+	ActiveAccounts(context.Context, *QueryActiveAccountsRequest) (*QueryActiveAccountsResponse, error)
+	// AccountGrowth returns the new and total accounts per time bucket.
+	AccountGrowth(context.Context, *QueryAccountGrowthRequest) (*QueryAccountGrowthResponse, error)
+	// TopAccounts returns accounts by number of signed transactions.
+	TopAccounts(context.Context, *QueryTopAccountsRequest) (*QueryTopAccountsResponse, error)
+	// SupplyDistribution returns how the supply of a denomination is distributed as of the last supply snapshot.
+	SupplyDistribution(context.Context, *QuerySupplyDistributionRequest) (*QuerySupplyDistributionResponse, error)
+	// ValidatorUptime returns the blocks a validator signed and missed per time bucket along with its oracle status.
+	ValidatorUptime(context.Context, *QueryValidatorUptimeRequest) (*QueryValidatorUptimeResponse, error)
+	// TopValidatorsByUptime returns validators by the share of blocks they signed.
+	TopValidatorsByUptime(context.Context, *QueryTopValidatorsByUptimeRequest) (*QueryTopValidatorsByUptimeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) TopBalances(ctx context.Context, req *QueryTopBalancesRequest) (*QueryTopBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopBalances not implemented")
+}
+func (*UnimplementedQueryServer) ExtendedValidators(ctx context.Context, req *QueryExtendedValidatorsRequest) (*QueryExtendedValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendedValidators not implemented")
+}
+func (*UnimplementedQueryServer) AvgBlockSize(ctx context.Context, req *QueryAvgBlockSizeRequest) (*QueryAvgBlockSizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AvgBlockSize not implemented")
+}
+func (*UnimplementedQueryServer) AvgBlockTime(ctx context.Context, req *QueryAvgBlockTimeRequest) (*QueryAvgBlockTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AvgBlockTime not implemented")
+}
+func (*UnimplementedQueryServer) AvgTxFee(ctx context.Context, req *QueryAvgTxFeeRequest) (*QueryAvgTxFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AvgTxFee not implemented")
+}
+func (*UnimplementedQueryServer) TxVolume(ctx context.Context, req *QueryTxVolumeRequest) (*QueryTxVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxVolume not implemented")
+}
+func (*UnimplementedQueryServer) TopValidators(ctx context.Context, req *QueryTopValidatorsRequest) (*QueryTopValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopValidators not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorBlocks(ctx context.Context, req *QueryValidatorBlocksRequest) (*QueryValidatorBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorBlocks not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorByConsAddr(ctx context.Context, req *QueryValidatorByConsAddrRequest) (*QueryValidatorByConsAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorByConsAddr not implemented")
+}
+func (*UnimplementedQueryServer) OracleRequests(ctx context.Context, req *QueryOracleRequestsRequest) (*QueryOracleRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleRequests not implemented")
+}
+func (*UnimplementedQueryServer) TopDataSources(ctx context.Context, req *QueryTopDataSourcesRequest) (*QueryTopDataSourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopDataSources not implemented")
+}
+func (*UnimplementedQueryServer) ReportParticipation(ctx context.Context, req *QueryReportParticipationRequest) (*QueryReportParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportParticipation not implemented")
+}
+func (*UnimplementedQueryServer) DataProviderRewards(ctx context.Context, req *QueryDataProviderRewardsRequest) (*QueryDataProviderRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataProviderRewards not implemented")
+}
+func (*UnimplementedQueryServer) ActiveAccounts(ctx context.Context, req *QueryActiveAccountsRequest) (*QueryActiveAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveAccounts not implemented")
+}
+func (*UnimplementedQueryServer) AccountGrowth(ctx context.Context, req *QueryAccountGrowthRequest) (*QueryAccountGrowthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountGrowth not implemented")
+}
+func (*UnimplementedQueryServer) TopAccounts(ctx context.Context, req *QueryTopAccountsRequest) (*QueryTopAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopAccounts not implemented")
+}
+func (*UnimplementedQueryServer) SupplyDistribution(ctx context.Context, req *QuerySupplyDistributionRequest) (*QuerySupplyDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyDistribution not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorUptime(ctx context.Context, req *QueryValidatorUptimeRequest) (*QueryValidatorUptimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorUptime not implemented")
+}
+func (*UnimplementedQueryServer) TopValidatorsByUptime(ctx context.Context, req *QueryTopValidatorsByUptimeRequest) (*QueryTopValidatorsByUptimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopValidatorsByUptime not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_TopBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TopBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telemetry.Query/TopBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TopBalances(ctx, req.(*QueryTopBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExtendedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExtendedValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExtendedValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telemetry.Query/ExtendedValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExtendedValidators(ctx, req.(*QueryExtendedValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AvgBlockSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAvgBlockSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AvgBlockSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telemetry.Query/AvgBlockSize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AvgBlockSize(ctx, req.(*QueryAvgBlockSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AvgBlockTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAvgBlockTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AvgBlockTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telemetry.Query/AvgBlockTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AvgBlockTime(ctx, req.(*QueryAvgBlockTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AvgTxFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAvgTxFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AvgTxFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telemetry.Query/AvgTxFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AvgTxFee(ctx, req.(*QueryAvgTxFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telemetry.Query/TxVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxVolume(ctx, req.(*QueryTxVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TopValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TopValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telemetry.Query/TopValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TopValidators(ctx, req.(*QueryTopValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telemetry.Query/ValidatorBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorBlocks(ctx, req.(*QueryValidatorBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorByConsAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorByConsAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorByConsAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telemetry.Query/ValidatorByConsAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorByConsAddr(ctx, req.(*QueryValidatorByConsAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OracleRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telemetry.Query/OracleRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleRequests(ctx, req.(*QueryOracleRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TopDataSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopDataSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TopDataSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telemetry.Query/TopDataSources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TopDataSources(ctx, req.(*QueryTopDataSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReportParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReportParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReportParticipation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telemetry.Query/ReportParticipation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReportParticipation(ctx, req.(*QueryReportParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DataProviderRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataProviderRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataProviderRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telemetry.Query/DataProviderRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataProviderRewards(ctx, req.(*QueryDataProviderRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActiveAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telemetry.Query/ActiveAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveAccounts(ctx, req.(*QueryActiveAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountGrowth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountGrowthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountGrowth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telemetry.Query/AccountGrowth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountGrowth(ctx, req.(*QueryAccountGrowthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TopAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TopAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telemetry.Query/TopAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TopAccounts(ctx, req.(*QueryTopAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telemetry.Query/SupplyDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyDistribution(ctx, req.(*QuerySupplyDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorUptime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorUptimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorUptime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telemetry.Query/ValidatorUptime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorUptime(ctx, req.(*QueryValidatorUptimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TopValidatorsByUptime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopValidatorsByUptimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TopValidatorsByUptime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telemetry.Query/TopValidatorsByUptime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TopValidatorsByUptime(ctx, req.(*QueryTopValidatorsByUptimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "telemetry.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TopBalances",
+			Handler:    _Query_TopBalances_Handler,
+		},
+		{
+			MethodName: "ExtendedValidators",
+			Handler:    _Query_ExtendedValidators_Handler,
+		},
+		{
+			MethodName: "AvgBlockSize",
+			Handler:    _Query_AvgBlockSize_Handler,
+		},
+		{
+			MethodName: "AvgBlockTime",
+			Handler:    _Query_AvgBlockTime_Handler,
+		},
+		{
+			MethodName: "AvgTxFee",
+			Handler:    _Query_AvgTxFee_Handler,
+		},
+		{
+			MethodName: "TxVolume",
+			Handler:    _Query_TxVolume_Handler,
+		},
+		{
+			MethodName: "TopValidators",
+			Handler:    _Query_TopValidators_Handler,
+		},
+		{
+			MethodName: "ValidatorBlocks",
+			Handler:    _Query_ValidatorBlocks_Handler,
+		},
+		{
+			MethodName: "ValidatorByConsAddr",
+			Handler:    _Query_ValidatorByConsAddr_Handler,
+		},
+		{
+			MethodName: "OracleRequests",
+			Handler:    _Query_OracleRequests_Handler,
+		},
+		{
+			MethodName: "TopDataSources",
+			Handler:    _Query_TopDataSources_Handler,
+		},
+		{
+			MethodName: "ReportParticipation",
+			Handler:    _Query_ReportParticipation_Handler,
+		},
+		{
+			MethodName: "DataProviderRewards",
+			Handler:    _Query_DataProviderRewards_Handler,
+		},
+		{
+			MethodName: "ActiveAccounts",
+			Handler:    _Query_ActiveAccounts_Handler,
+		},
+		{
+			MethodName: "AccountGrowth",
+			Handler:    _Query_AccountGrowth_Handler,
+		},
+		{
+			MethodName: "TopAccounts",
+			Handler:    _Query_TopAccounts_Handler,
+		},
+		{
+			MethodName: "SupplyDistribution",
+			Handler:    _Query_SupplyDistribution_Handler,
+		},
+		{
+			MethodName: "ValidatorUptime",
+			Handler:    _Query_ValidatorUptime_Handler,
+		},
+		{
+			MethodName: "TopValidatorsByUptime",
+			Handler:    _Query_TopValidatorsByUptime_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "telemetry/query.proto",
+}
+
+func (m *QueryTopBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Desc {
+		i--
+		if m.Desc {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExtendedValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExtendedValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExtendedValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExtendedValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExtendedValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExtendedValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAvgBlockSizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAvgBlockSizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAvgBlockSizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Granularity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Granularity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndDate != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintQuery(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartDate != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintQuery(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAvgBlockSizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAvgBlockSizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAvgBlockSizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AvgBlockSize) > 0 {
+		for iNdEx := len(m.AvgBlockSize) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AvgBlockSize[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAvgBlockTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAvgBlockTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAvgBlockTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Granularity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Granularity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndDate != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintQuery(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartDate != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintQuery(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAvgBlockTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAvgBlockTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAvgBlockTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AvgBlockTime) > 0 {
+		for iNdEx := len(m.AvgBlockTime) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AvgBlockTime[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAvgTxFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAvgTxFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAvgTxFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Granularity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Granularity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndDate != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintQuery(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartDate != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintQuery(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAvgTxFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAvgTxFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAvgTxFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AvgTxFee) > 0 {
+		for iNdEx := len(m.AvgTxFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AvgTxFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxVolumeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxVolumeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxVolumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Granularity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Granularity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndDate != nil {
+		n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintQuery(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartDate != nil {
+		n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintQuery(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxVolumeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxVolumeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxVolumeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxVolume) > 0 {
+		for iNdEx := len(m.TxVolume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxVolume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Desc {
+		i--
+		if m.Desc {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndDate != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintQuery(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartDate != nil {
+		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintQuery(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TopValidators) > 0 {
+		for iNdEx := len(m.TopValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Desc {
+		i--
+		if m.Desc {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorBlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorByConsAddrRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorByConsAddrRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorByConsAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorByConsAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorByConsAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorByConsAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Granularity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Granularity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndDate != nil {
+		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintQuery(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartDate != nil {
+		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate):])
+		if err21 != nil {
+			return 0, err21
+		}
+		i -= n21
+		i = encodeVarintQuery(dAtA, i, uint64(n21))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OracleRequests) > 0 {
+		for iNdEx := len(m.OracleRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopDataSourcesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopDataSourcesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopDataSourcesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Desc {
+		i--
+		if m.Desc {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndDate != nil {
+		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate):])
+		if err23 != nil {
+			return 0, err23
+		}
+		i -= n23
+		i = encodeVarintQuery(dAtA, i, uint64(n23))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartDate != nil {
+		n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate):])
+		if err24 != nil {
+			return 0, err24
+		}
+		i -= n24
+		i = encodeVarintQuery(dAtA, i, uint64(n24))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopDataSourcesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopDataSourcesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopDataSourcesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DataSources) > 0 {
+		for iNdEx := len(m.DataSources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataSources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReportParticipationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReportParticipationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReportParticipationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Desc {
+		i--
+		if m.Desc {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndDate != nil {
+		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate):])
+		if err27 != nil {
+			return 0, err27
+		}
+		i -= n27
+		i = encodeVarintQuery(dAtA, i, uint64(n27))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartDate != nil {
+		n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate):])
+		if err28 != nil {
+			return 0, err28
+		}
+		i -= n28
+		i = encodeVarintQuery(dAtA, i, uint64(n28))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReportParticipationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReportParticipationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReportParticipationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataProviderRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataProviderRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataProviderRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Granularity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Granularity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndDate != nil {
+		n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate):])
+		if err30 != nil {
+			return 0, err30
+		}
+		i -= n30
+		i = encodeVarintQuery(dAtA, i, uint64(n30))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartDate != nil {
+		n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate):])
+		if err31 != nil {
+			return 0, err31
+		}
+		i -= n31
+		i = encodeVarintQuery(dAtA, i, uint64(n31))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataProviderRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataProviderRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataProviderRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataProviderRewards) > 0 {
+		for iNdEx := len(m.DataProviderRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataProviderRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Granularity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Granularity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndDate != nil {
+		n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate):])
+		if err32 != nil {
+			return 0, err32
+		}
+		i -= n32
+		i = encodeVarintQuery(dAtA, i, uint64(n32))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartDate != nil {
+		n33, err33 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate):])
+		if err33 != nil {
+			return 0, err33
+		}
+		i -= n33
+		i = encodeVarintQuery(dAtA, i, uint64(n33))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ActiveAccounts) > 0 {
+		for iNdEx := len(m.ActiveAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActiveAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountGrowthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountGrowthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountGrowthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Granularity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Granularity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndDate != nil {
+		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate):])
+		if err34 != nil {
+			return 0, err34
+		}
+		i -= n34
+		i = encodeVarintQuery(dAtA, i, uint64(n34))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartDate != nil {
+		n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate):])
+		if err35 != nil {
+			return 0, err35
+		}
+		i -= n35
+		i = encodeVarintQuery(dAtA, i, uint64(n35))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountGrowthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountGrowthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountGrowthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountGrowth) > 0 {
+		for iNdEx := len(m.AccountGrowth) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountGrowth[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Desc {
+		i--
+		if m.Desc {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndDate != nil {
+		n37, err37 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate):])
+		if err37 != nil {
+			return 0, err37
+		}
+		i -= n37
+		i = encodeVarintQuery(dAtA, i, uint64(n37))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartDate != nil {
+		n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate):])
+		if err38 != nil {
+			return 0, err38
+		}
+		i -= n38
+		i = encodeVarintQuery(dAtA, i, uint64(n38))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Percentiles) > 0 {
+		dAtA41 := make([]byte, len(m.Percentiles)*10)
+		var j40 int
+		for _, num := range m.Percentiles {
+			for num >= 1<<7 {
+				dAtA41[j40] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j40++
+			}
+			dAtA41[j40] = uint8(num)
+			j40++
+		}
+		i -= j40
+		copy(dAtA[i:], dAtA41[:j40])
+		i = encodeVarintQuery(dAtA, i, uint64(j40))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Thresholds) > 0 {
+		for iNdEx := len(m.Thresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Thresholds[iNdEx].Size()
+				i -= size
+				if _, err := m.Thresholds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SupplyDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorUptimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorUptimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorUptimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Granularity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Granularity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EndDate != nil {
+		n43, err43 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate):])
+		if err43 != nil {
+			return 0, err43
+		}
+		i -= n43
+		i = encodeVarintQuery(dAtA, i, uint64(n43))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StartDate != nil {
+		n44, err44 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate):])
+		if err44 != nil {
+			return 0, err44
+		}
+		i -= n44
+		i = encodeVarintQuery(dAtA, i, uint64(n44))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorUptimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorUptimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorUptimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OracleStatusChanges) > 0 {
+		for iNdEx := len(m.OracleStatusChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleStatusChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Uptime) > 0 {
+		for iNdEx := len(m.Uptime) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Uptime[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopValidatorsByUptimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopValidatorsByUptimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopValidatorsByUptimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Desc {
+		i--
+		if m.Desc {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndDate != nil {
+		n46, err46 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate):])
+		if err46 != nil {
+			return 0, err46
+		}
+		i -= n46
+		i = encodeVarintQuery(dAtA, i, uint64(n46))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartDate != nil {
+		n47, err47 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartDate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate):])
+		if err47 != nil {
+			return 0, err47
+		}
+		i -= n47
+		i = encodeVarintQuery(dAtA, i, uint64(n47))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopValidatorsByUptimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopValidatorsByUptimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopValidatorsByUptimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTopBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Desc {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTopBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExtendedValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExtendedValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAvgBlockSizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Granularity != 0 {
+		n += 1 + sovQuery(uint64(m.Granularity))
+	}
+	return n
+}
+
+func (m *QueryAvgBlockSizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AvgBlockSize) > 0 {
+		for _, e := range m.AvgBlockSize {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAvgBlockTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Granularity != 0 {
+		n += 1 + sovQuery(uint64(m.Granularity))
+	}
+	return n
+}
+
+func (m *QueryAvgBlockTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AvgBlockTime) > 0 {
+		for _, e := range m.AvgBlockTime {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAvgTxFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Granularity != 0 {
+		n += 1 + sovQuery(uint64(m.Granularity))
+	}
+	return n
+}
+
+func (m *QueryAvgTxFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AvgTxFee) > 0 {
+		for _, e := range m.AvgTxFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTxVolumeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Granularity != 0 {
+		n += 1 + sovQuery(uint64(m.Granularity))
+	}
+	return n
+}
+
+func (m *QueryTxVolumeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxVolume) > 0 {
+		for _, e := range m.TxVolume {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTopValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Desc {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTopValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TopValidators) > 0 {
+		for _, e := range m.TopValidators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Desc {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryValidatorBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorByConsAddrRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorByConsAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Validator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOracleRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Granularity != 0 {
+		n += 1 + sovQuery(uint64(m.Granularity))
+	}
+	return n
+}
+
+func (m *QueryOracleRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OracleRequests) > 0 {
+		for _, e := range m.OracleRequests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTopDataSourcesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Desc {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTopDataSourcesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DataSources) > 0 {
+		for _, e := range m.DataSources {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReportParticipationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Desc {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryReportParticipationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataProviderRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Granularity != 0 {
+		n += 1 + sovQuery(uint64(m.Granularity))
+	}
+	return n
+}
+
+func (m *QueryDataProviderRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DataProviderRewards) > 0 {
+		for _, e := range m.DataProviderRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryActiveAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Granularity != 0 {
+		n += 1 + sovQuery(uint64(m.Granularity))
+	}
+	return n
+}
+
+func (m *QueryActiveAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActiveAccounts) > 0 {
+		for _, e := range m.ActiveAccounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAccountGrowthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Granularity != 0 {
+		n += 1 + sovQuery(uint64(m.Granularity))
+	}
+	return n
+}
+
+func (m *QueryAccountGrowthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccountGrowth) > 0 {
+		for _, e := range m.AccountGrowth {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTopAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Desc {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTopAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplyDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Thresholds) > 0 {
+		for _, e := range m.Thresholds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Percentiles) > 0 {
+		l = 0
+		for _, e := range m.Percentiles {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QuerySupplyDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SupplyDistribution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorUptimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Granularity != 0 {
+		n += 1 + sovQuery(uint64(m.Granularity))
+	}
+	return n
+}
+
+func (m *QueryValidatorUptimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Uptime) > 0 {
+		for _, e := range m.Uptime {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.OracleStatusChanges) > 0 {
+		for _, e := range m.OracleStatusChanges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTopValidatorsByUptimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndDate != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndDate)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Desc {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTopValidatorsByUptimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTopBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Desc = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, github_com_cosmos_cosmos_sdk_x_bank_types.Balance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExtendedValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExtendedValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExtendedValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExtendedValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExtendedValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExtendedValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, github_com_cosmos_cosmos_sdk_x_staking_types.Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, github_com_cosmos_cosmos_sdk_x_bank_types.Balance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAvgBlockSizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAvgBlockSizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAvgBlockSizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartDate == nil {
+				m.StartDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndDate == nil {
+				m.EndDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granularity", wireType)
+			}
+			m.Granularity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Granularity |= Granularity(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAvgBlockSizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAvgBlockSizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAvgBlockSizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgBlockSize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvgBlockSize = append(m.AvgBlockSize, AverageBlockSize{})
+			if err := m.AvgBlockSize[len(m.AvgBlockSize)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAvgBlockTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAvgBlockTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAvgBlockTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartDate == nil {
+				m.StartDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndDate == nil {
+				m.EndDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granularity", wireType)
+			}
+			m.Granularity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Granularity |= Granularity(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAvgBlockTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAvgBlockTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAvgBlockTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvgBlockTime = append(m.AvgBlockTime, AverageBlockTime{})
+			if err := m.AvgBlockTime[len(m.AvgBlockTime)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAvgTxFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAvgTxFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAvgTxFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartDate == nil {
+				m.StartDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndDate == nil {
+				m.EndDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granularity", wireType)
+			}
+			m.Granularity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Granularity |= Granularity(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAvgTxFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAvgTxFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAvgTxFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgTxFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvgTxFee = append(m.AvgTxFee, AverageTxFee{})
+			if err := m.AvgTxFee[len(m.AvgTxFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxVolumeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxVolumeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxVolumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartDate == nil {
+				m.StartDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndDate == nil {
+				m.EndDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granularity", wireType)
+			}
+			m.Granularity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Granularity |= Granularity(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxVolumeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxVolumeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxVolumeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxVolume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxVolume = append(m.TxVolume, TxVolume{})
+			if err := m.TxVolume[len(m.TxVolume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartDate == nil {
+				m.StartDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndDate == nil {
+				m.EndDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Desc = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopValidators = append(m.TopValidators, ValidatorBlockStats{})
+			if err := m.TopValidators[len(m.TopValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Desc = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorBlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorBlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, ValidatorBlock{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorByConsAddrRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorByConsAddrRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorByConsAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorByConsAddrResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorByConsAddrResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorByConsAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartDate == nil {
+				m.StartDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndDate == nil {
+				m.EndDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granularity", wireType)
+			}
+			m.Granularity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Granularity |= Granularity(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleRequests = append(m.OracleRequests, OracleRequests{})
+			if err := m.OracleRequests[len(m.OracleRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopDataSourcesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopDataSourcesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopDataSourcesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartDate == nil {
+				m.StartDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndDate == nil {
+				m.EndDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Desc = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryValidatorByConsAddrRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsensusAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryValidatorByConsAddrResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Validator.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryTopBalancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTopDataSourcesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2467,17 +8222,17 @@ func (m *QueryTopBalancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTopBalancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopDataSourcesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTopBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopDataSourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2487,23 +8242,25 @@ func (m *QueryTopBalancesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.DataSources = append(m.DataSources, DataSourceStats{})
+			if err := m.DataSources[len(m.DataSources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2535,32 +8292,12 @@ func (m *QueryTopBalancesRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Desc = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2582,7 +8319,7 @@ func (m *QueryTopBalancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTopBalancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReportParticipationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2605,15 +8342,15 @@ func (m *QueryTopBalancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTopBalancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReportParticipationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTopBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReportParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2640,12 +8377,50 @@ func (m *QueryTopBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, github_com_cosmos_cosmos_sdk_x_bank_types.Balance{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StartDate == nil {
+				m.StartDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartDate, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndDate == nil {
+				m.EndDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2675,12 +8450,32 @@ func (m *QueryTopBalancesResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Desc = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2702,7 +8497,7 @@ func (m *QueryTopBalancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExtendedValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReportParticipationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2725,17 +8520,17 @@ func (m *QueryExtendedValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExtendedValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReportParticipationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExtendedValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReportParticipationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2745,23 +8540,25 @@ func (m *QueryExtendedValidatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Validators = append(m.Validators, ReportParticipation{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2793,7 +8590,7 @@ func (m *QueryExtendedValidatorsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2820,7 +8617,7 @@ func (m *QueryExtendedValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExtendedValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDataProviderRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2843,15 +8640,15 @@ func (m *QueryExtendedValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExtendedValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDataProviderRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExtendedValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDataProviderRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2878,16 +8675,54 @@ func (m *QueryExtendedValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, github_com_cosmos_cosmos_sdk_x_staking_types.Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StartDate == nil {
+				m.StartDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartDate, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndDate == nil {
+				m.EndDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granularity", wireType)
 			}
-			var msglen int
+			m.Granularity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2897,29 +8732,64 @@ func (m *QueryExtendedValidatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Granularity |= Granularity(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, github_com_cosmos_cosmos_sdk_x_bank_types.Balance{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataProviderRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataProviderRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataProviderRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProviderRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2946,10 +8816,8 @@ func (m *QueryExtendedValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DataProviderRewards = append(m.DataProviderRewards, DataProviderRewards{})
+			if err := m.DataProviderRewards[len(m.DataProviderRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2974,7 +8842,7 @@ func (m *QueryExtendedValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAvgBlockSizeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2997,10 +8865,10 @@ func (m *QueryAvgBlockSizeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAvgBlockSizeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAvgBlockSizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3075,6 +8943,25 @@ func (m *QueryAvgBlockSizeRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granularity", wireType)
+			}
+			m.Granularity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Granularity |= Granularity(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3096,7 +8983,7 @@ func (m *QueryAvgBlockSizeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAvgBlockSizeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3119,15 +9006,15 @@ func (m *QueryAvgBlockSizeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAvgBlockSizeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAvgBlockSizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvgBlockSizePerDay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveAccounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3154,8 +9041,8 @@ func (m *QueryAvgBlockSizeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AvgBlockSizePerDay = append(m.AvgBlockSizePerDay, AverageBlockSizePerDay{})
-			if err := m.AvgBlockSizePerDay[len(m.AvgBlockSizePerDay)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActiveAccounts = append(m.ActiveAccounts, ActiveAccounts{})
+			if err := m.ActiveAccounts[len(m.ActiveAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3180,7 +9067,7 @@ func (m *QueryAvgBlockSizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAvgBlockTimeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountGrowthRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3203,10 +9090,10 @@ func (m *QueryAvgBlockTimeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAvgBlockTimeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountGrowthRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAvgBlockTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountGrowthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3281,6 +9168,25 @@ func (m *QueryAvgBlockTimeRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granularity", wireType)
+			}
+			m.Granularity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Granularity |= Granularity(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3302,7 +9208,7 @@ func (m *QueryAvgBlockTimeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAvgBlockTimeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountGrowthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3325,15 +9231,15 @@ func (m *QueryAvgBlockTimeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAvgBlockTimeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountGrowthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAvgBlockTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountGrowthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvgBlockTimePerDay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountGrowth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3360,8 +9266,8 @@ func (m *QueryAvgBlockTimeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AvgBlockTimePerDay = append(m.AvgBlockTimePerDay, AverageBlockTimePerDay{})
-			if err := m.AvgBlockTimePerDay[len(m.AvgBlockTimePerDay)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AccountGrowth = append(m.AccountGrowth, AccountGrowth{})
+			if err := m.AccountGrowth[len(m.AccountGrowth)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3386,7 +9292,7 @@ func (m *QueryAvgBlockTimeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAvgTxFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTopAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3409,10 +9315,10 @@ func (m *QueryAvgTxFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAvgTxFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAvgTxFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3487,6 +9393,62 @@ func (m *QueryAvgTxFeeRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Desc = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3508,7 +9470,7 @@ func (m *QueryAvgTxFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAvgTxFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTopAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3531,15 +9493,49 @@ func (m *QueryAvgTxFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAvgTxFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAvgTxFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvgTxFeePerDay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, AccountTxs{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3566,8 +9562,10 @@ func (m *QueryAvgTxFeeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AvgTxFeePerDay = append(m.AvgTxFeePerDay, AverageTxFeePerDay{})
-			if err := m.AvgTxFeePerDay[len(m.AvgTxFeePerDay)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3592,7 +9590,7 @@ func (m *QueryAvgTxFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxVolumeRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySupplyDistributionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3615,17 +9613,17 @@ func (m *QueryTxVolumeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxVolumeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupplyDistributionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxVolumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupplyDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3635,33 +9633,29 @@ func (m *QueryTxVolumeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartDate == nil {
-				m.StartDate = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartDate, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Thresholds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3671,28 +9665,104 @@ func (m *QueryTxVolumeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EndDate == nil {
-				m.EndDate = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndDate, dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.Thresholds = append(m.Thresholds, v)
+			if err := m.Thresholds[len(m.Thresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Percentiles = append(m.Percentiles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Percentiles) == 0 {
+					m.Percentiles = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Percentiles = append(m.Percentiles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3714,7 +9784,7 @@ func (m *QueryTxVolumeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxVolumeResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySupplyDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3737,15 +9807,15 @@ func (m *QueryTxVolumeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxVolumeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupplyDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxVolumeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupplyDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxVolumePerDay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyDistribution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3772,8 +9842,7 @@ func (m *QueryTxVolumeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxVolumePerDay = append(m.TxVolumePerDay, TxVolumePerDay{})
-			if err := m.TxVolumePerDay[len(m.TxVolumePerDay)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SupplyDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3798,7 +9867,7 @@ func (m *QueryTxVolumeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTopValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorUptimeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3821,17 +9890,17 @@ func (m *QueryTopValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTopValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorUptimeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTopValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorUptimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3841,31 +9910,27 @@ func (m *QueryTopValidatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartDate == nil {
-				m.StartDate = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartDate, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3892,16 +9957,16 @@ func (m *QueryTopValidatorsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EndDate == nil {
-				m.EndDate = new(time.Time)
+			if m.StartDate == nil {
+				m.StartDate = new(time.Time)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndDate, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartDate, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3928,18 +9993,18 @@ func (m *QueryTopValidatorsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.EndDate == nil {
+				m.EndDate = new(time.Time)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndDate, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granularity", wireType)
 			}
-			var v int
+			m.Granularity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3949,12 +10014,11 @@ func (m *QueryTopValidatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Granularity |= Granularity(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Desc = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3976,7 +10040,7 @@ func (m *QueryTopValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTopValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorUptimeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3999,15 +10063,15 @@ func (m *QueryTopValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTopValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorUptimeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTopValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorUptimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4034,14 +10098,14 @@ func (m *QueryTopValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopValidators = append(m.TopValidators, ValidatorBlockStats{})
-			if err := m.TopValidators[len(m.TopValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Uptime = append(m.Uptime, ValidatorUptime{})
+			if err := m.Uptime[len(m.Uptime)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleStatusChanges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4068,10 +10132,8 @@ func (m *QueryTopValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OracleStatusChanges = append(m.OracleStatusChanges, OracleStatusChange{})
+			if err := m.OracleStatusChanges[len(m.OracleStatusChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4096,7 +10158,7 @@ func (m *QueryTopValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorBlocksRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTopValidatorsByUptimeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4119,17 +10181,17 @@ func (m *QueryValidatorBlocksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorBlocksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopValidatorsByUptimeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopValidatorsByUptimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4139,27 +10201,31 @@ func (m *QueryValidatorBlocksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if m.StartDate == nil {
+				m.StartDate = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4186,86 +10252,16 @@ func (m *QueryValidatorBlocksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.EndDate == nil {
+				m.EndDate = new(time.Time)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndDate, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Desc = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryValidatorBlocksResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorBlocksResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4292,16 +10288,18 @@ func (m *QueryValidatorBlocksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Blocks = append(m.Blocks, ValidatorBlock{})
-			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4311,28 +10309,12 @@ func (m *QueryValidatorBlocksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Desc = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4354,7 +10336,7 @@ func (m *QueryValidatorBlocksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorByConsAddrRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTopValidatorsByUptimeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4377,17 +10359,17 @@ func (m *QueryValidatorByConsAddrRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorByConsAddrRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopValidatorsByUptimeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorByConsAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopValidatorsByUptimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4397,77 +10379,29 @@ func (m *QueryValidatorByConsAddrRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Validators = append(m.Validators, ValidatorUptimeStats{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryValidatorByConsAddrResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorByConsAddrResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorByConsAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4494,7 +10428,10 @@ func (m *QueryValidatorByConsAddrResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11,6 +11,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -30,24 +31,60 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// AverageBlockSizePerDay represents average block size per day.
-type AverageBlockSizePerDay struct {
-	Date  time.Time `protobuf:"bytes,1,opt,name=date,proto3,stdtime" json:"date"`
+// Granularity defines the size of the time buckets of the telemetry series.
+type Granularity int32
+
+const (
+	// Day - the series are bucketed per UTC day, which is the default.
+	GRANULARITY_DAY Granularity = 0
+	// Hour - the series are bucketed per hour.
+	GRANULARITY_HOUR Granularity = 1
+	// Week - the series are bucketed per week starting on Monday.
+	GRANULARITY_WEEK Granularity = 2
+	// Month - the series are bucketed per calendar month.
+	GRANULARITY_MONTH Granularity = 3
+)
+
+var Granularity_name = map[int32]string{
+	0: "GRANULARITY_DAY_UNSPECIFIED",
+	1: "GRANULARITY_HOUR",
+	2: "GRANULARITY_WEEK",
+	3: "GRANULARITY_MONTH",
+}
+
+var Granularity_value = map[string]int32{
+	"GRANULARITY_DAY_UNSPECIFIED": 0,
+	"GRANULARITY_HOUR":            1,
+	"GRANULARITY_WEEK":            2,
+	"GRANULARITY_MONTH":           3,
+}
+
+func (x Granularity) String() string {
+	return proto.EnumName(Granularity_name, int32(x))
+}
+
+func (Granularity) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_83397851ec684947, []int{0}
+}
+
+// AverageBlockSize represents average block size over a time bucket.
+type AverageBlockSize struct {
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
 	Bytes uint64    `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
 }
 
-func (m *AverageBlockSizePerDay) Reset()         { *m = AverageBlockSizePerDay{} }
-func (m *AverageBlockSizePerDay) String() string { return proto.CompactTextString(m) }
-func (*AverageBlockSizePerDay) ProtoMessage()    {}
-func (*AverageBlockSizePerDay) Descriptor() ([]byte, []int) {
+func (m *AverageBlockSize) Reset()         { *m = AverageBlockSize{} }
+func (m *AverageBlockSize) String() string { return proto.CompactTextString(m) }
+func (*AverageBlockSize) ProtoMessage()    {}
+func (*AverageBlockSize) Descriptor() ([]byte, []int) {
 	return fileDescriptor_83397851ec684947, []int{0}
 }
-func (m *AverageBlockSizePerDay) XXX_Unmarshal(b []byte) error {
+func (m *AverageBlockSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AverageBlockSizePerDay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AverageBlockSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AverageBlockSizePerDay.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AverageBlockSize.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -57,50 +94,50 @@ func (m *AverageBlockSizePerDay) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *AverageBlockSizePerDay) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AverageBlockSizePerDay.Merge(m, src)
+func (m *AverageBlockSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AverageBlockSize.Merge(m, src)
 }
-func (m *AverageBlockSizePerDay) XXX_Size() int {
+func (m *AverageBlockSize) XXX_Size() int {
 	return m.Size()
 }
-func (m *AverageBlockSizePerDay) XXX_DiscardUnknown() {
-	xxx_messageInfo_AverageBlockSizePerDay.DiscardUnknown(m)
+func (m *AverageBlockSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_AverageBlockSize.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AverageBlockSizePerDay proto.InternalMessageInfo
+var xxx_messageInfo_AverageBlockSize proto.InternalMessageInfo
 
-func (m *AverageBlockSizePerDay) GetDate() time.Time {
+func (m *AverageBlockSize) GetStart() time.Time {
 	if m != nil {
-		return m.Date
+		return m.Start
 	}
 	return time.Time{}
 }
 
-func (m *AverageBlockSizePerDay) GetBytes() uint64 {
+func (m *AverageBlockSize) GetBytes() uint64 {
 	if m != nil {
 		return m.Bytes
 	}
 	return 0
 }
 
-// AverageBlockTimePerDay represents average block time per day.
-type AverageBlockTimePerDay struct {
-	Date    time.Time `protobuf:"bytes,1,opt,name=date,proto3,stdtime" json:"date"`
+// AverageBlockTime represents average block time over a time bucket.
+type AverageBlockTime struct {
+	Start   time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
 	Seconds uint64    `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
 }
 
-func (m *AverageBlockTimePerDay) Reset()         { *m = AverageBlockTimePerDay{} }
-func (m *AverageBlockTimePerDay) String() string { return proto.CompactTextString(m) }
-func (*AverageBlockTimePerDay) ProtoMessage()    {}
-func (*AverageBlockTimePerDay) Descriptor() ([]byte, []int) {
+func (m *AverageBlockTime) Reset()         { *m = AverageBlockTime{} }
+func (m *AverageBlockTime) String() string { return proto.CompactTextString(m) }
+func (*AverageBlockTime) ProtoMessage()    {}
+func (*AverageBlockTime) Descriptor() ([]byte, []int) {
 	return fileDescriptor_83397851ec684947, []int{1}
 }
-func (m *AverageBlockTimePerDay) XXX_Unmarshal(b []byte) error {
+func (m *AverageBlockTime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AverageBlockTimePerDay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AverageBlockTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AverageBlockTimePerDay.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AverageBlockTime.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -110,50 +147,50 @@ func (m *AverageBlockTimePerDay) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *AverageBlockTimePerDay) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AverageBlockTimePerDay.Merge(m, src)
+func (m *AverageBlockTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AverageBlockTime.Merge(m, src)
 }
-func (m *AverageBlockTimePerDay) XXX_Size() int {
+func (m *AverageBlockTime) XXX_Size() int {
 	return m.Size()
 }
-func (m *AverageBlockTimePerDay) XXX_DiscardUnknown() {
-	xxx_messageInfo_AverageBlockTimePerDay.DiscardUnknown(m)
+func (m *AverageBlockTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_AverageBlockTime.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AverageBlockTimePerDay proto.InternalMessageInfo
+var xxx_messageInfo_AverageBlockTime proto.InternalMessageInfo
 
-func (m *AverageBlockTimePerDay) GetDate() time.Time {
+func (m *AverageBlockTime) GetStart() time.Time {
 	if m != nil {
-		return m.Date
+		return m.Start
 	}
 	return time.Time{}
 }
 
-func (m *AverageBlockTimePerDay) GetSeconds() uint64 {
+func (m *AverageBlockTime) GetSeconds() uint64 {
 	if m != nil {
 		return m.Seconds
 	}
 	return 0
 }
 
-// AverageTxFeePerDay represents average transaction fee per day.
-type AverageTxFeePerDay struct {
-	Date time.Time                                `protobuf:"bytes,1,opt,name=date,proto3,stdtime" json:"date"`
-	Fee  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+// AverageTxFee represents average transaction fee over a time bucket.
+type AverageTxFee struct {
+	Start time.Time                                `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	Fee   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
 }
 
-func (m *AverageTxFeePerDay) Reset()         { *m = AverageTxFeePerDay{} }
-func (m *AverageTxFeePerDay) String() string { return proto.CompactTextString(m) }
-func (*AverageTxFeePerDay) ProtoMessage()    {}
-func (*AverageTxFeePerDay) Descriptor() ([]byte, []int) {
+func (m *AverageTxFee) Reset()         { *m = AverageTxFee{} }
+func (m *AverageTxFee) String() string { return proto.CompactTextString(m) }
+func (*AverageTxFee) ProtoMessage()    {}
+func (*AverageTxFee) Descriptor() ([]byte, []int) {
 	return fileDescriptor_83397851ec684947, []int{2}
 }
-func (m *AverageTxFeePerDay) XXX_Unmarshal(b []byte) error {
+func (m *AverageTxFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AverageTxFeePerDay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AverageTxFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AverageTxFeePerDay.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AverageTxFee.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -163,50 +200,50 @@ func (m *AverageTxFeePerDay) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *AverageTxFeePerDay) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AverageTxFeePerDay.Merge(m, src)
+func (m *AverageTxFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AverageTxFee.Merge(m, src)
 }
-func (m *AverageTxFeePerDay) XXX_Size() int {
+func (m *AverageTxFee) XXX_Size() int {
 	return m.Size()
 }
-func (m *AverageTxFeePerDay) XXX_DiscardUnknown() {
-	xxx_messageInfo_AverageTxFeePerDay.DiscardUnknown(m)
+func (m *AverageTxFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_AverageTxFee.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AverageTxFeePerDay proto.InternalMessageInfo
+var xxx_messageInfo_AverageTxFee proto.InternalMessageInfo
 
-func (m *AverageTxFeePerDay) GetDate() time.Time {
+func (m *AverageTxFee) GetStart() time.Time {
 	if m != nil {
-		return m.Date
+		return m.Start
 	}
 	return time.Time{}
 }
 
-func (m *AverageTxFeePerDay) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *AverageTxFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.Fee
 	}
 	return nil
 }
 
-// TxVolumePerDay represents count of transactions per day.
-type TxVolumePerDay struct {
-	Date   time.Time `protobuf:"bytes,1,opt,name=date,proto3,stdtime" json:"date"`
+// TxVolume represents count of transactions over a time bucket.
+type TxVolume struct {
+	Start  time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
 	Volume uint64    `protobuf:"varint,2,opt,name=volume,proto3" json:"volume,omitempty"`
 }
 
-func (m *TxVolumePerDay) Reset()         { *m = TxVolumePerDay{} }
-func (m *TxVolumePerDay) String() string { return proto.CompactTextString(m) }
-func (*TxVolumePerDay) ProtoMessage()    {}
-func (*TxVolumePerDay) Descriptor() ([]byte, []int) {
+func (m *TxVolume) Reset()         { *m = TxVolume{} }
+func (m *TxVolume) String() string { return proto.CompactTextString(m) }
+func (*TxVolume) ProtoMessage()    {}
+func (*TxVolume) Descriptor() ([]byte, []int) {
 	return fileDescriptor_83397851ec684947, []int{3}
 }
-func (m *TxVolumePerDay) XXX_Unmarshal(b []byte) error {
+func (m *TxVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TxVolumePerDay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TxVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TxVolumePerDay.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TxVolume.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -216,26 +253,26 @@ func (m *TxVolumePerDay) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *TxVolumePerDay) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxVolumePerDay.Merge(m, src)
+func (m *TxVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxVolume.Merge(m, src)
 }
-func (m *TxVolumePerDay) XXX_Size() int {
+func (m *TxVolume) XXX_Size() int {
 	return m.Size()
 }
-func (m *TxVolumePerDay) XXX_DiscardUnknown() {
-	xxx_messageInfo_TxVolumePerDay.DiscardUnknown(m)
+func (m *TxVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxVolume.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TxVolumePerDay proto.InternalMessageInfo
+var xxx_messageInfo_TxVolume proto.InternalMessageInfo
 
-func (m *TxVolumePerDay) GetDate() time.Time {
+func (m *TxVolume) GetStart() time.Time {
 	if m != nil {
-		return m.Date
+		return m.Start
 	}
 	return time.Time{}
 }
 
-func (m *TxVolumePerDay) GetVolume() uint64 {
+func (m *TxVolume) GetVolume() uint64 {
 	if m != nil {
 		return m.Volume
 	}
@@ -365,614 +402,6027 @@ func (m *ValidatorBlock) GetReward() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*AverageBlockSizePerDay)(nil), "telemetry.AverageBlockSizePerDay")
-	proto.RegisterType((*AverageBlockTimePerDay)(nil), "telemetry.AverageBlockTimePerDay")
-	proto.RegisterType((*AverageTxFeePerDay)(nil), "telemetry.AverageTxFeePerDay")
-	proto.RegisterType((*TxVolumePerDay)(nil), "telemetry.TxVolumePerDay")
-	proto.RegisterType((*ValidatorBlockStats)(nil), "telemetry.ValidatorBlockStats")
-	proto.RegisterType((*ValidatorBlock)(nil), "telemetry.ValidatorBlock")
+// BlockStats represents block statistics of a time bucket accumulated by the telemetry index.
+type BlockStats struct {
+	Start       time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	BlocksCount uint64    `protobuf:"varint,2,opt,name=blocks_count,json=blocksCount,proto3" json:"blocks_count,omitempty"`
+	// blocks_bytes is the total size of the block headers and transactions.
+	BlocksBytes uint64 `protobuf:"varint,3,opt,name=blocks_bytes,json=blocksBytes,proto3" json:"blocks_bytes,omitempty"`
+	// blocks_interval is the total time elapsed between each block and its previous block.
+	BlocksInterval time.Duration                            `protobuf:"bytes,4,opt,name=blocks_interval,json=blocksInterval,proto3,stdduration" json:"blocks_interval"`
+	TxsCount       uint64                                   `protobuf:"varint,5,opt,name=txs_count,json=txsCount,proto3" json:"txs_count,omitempty"`
+	TxsFee         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=txs_fee,json=txsFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"txs_fee"`
 }
 
-func init() { proto.RegisterFile("telemetry/telemetry.proto", fileDescriptor_83397851ec684947) }
+func (m *BlockStats) Reset()         { *m = BlockStats{} }
+func (m *BlockStats) String() string { return proto.CompactTextString(m) }
+func (*BlockStats) ProtoMessage()    {}
+func (*BlockStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83397851ec684947, []int{6}
+}
+func (m *BlockStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockStats.Merge(m, src)
+}
+func (m *BlockStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockStats.DiscardUnknown(m)
+}
 
-var fileDescriptor_83397851ec684947 = []byte{
-	// 559 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x41, 0x6f, 0xd3, 0x30,
-	0x18, 0x6d, 0xd6, 0x52, 0x56, 0x17, 0x8d, 0x11, 0xa6, 0x29, 0x2d, 0x52, 0x52, 0x76, 0x40, 0x95,
-	0x50, 0x63, 0x36, 0x38, 0x20, 0x6e, 0xcb, 0x2a, 0xb8, 0x20, 0x34, 0x85, 0x6a, 0x12, 0x48, 0xa8,
-	0x72, 0x92, 0x6f, 0x69, 0xd4, 0x26, 0xae, 0x6c, 0xb7, 0xb4, 0xfc, 0x8a, 0xfd, 0x04, 0xce, 0x5c,
-	0xf9, 0x0f, 0x68, 0xc7, 0x1d, 0x11, 0x87, 0x0d, 0xb5, 0x17, 0xc4, 0xaf, 0x40, 0x76, 0x9c, 0x6a,
-	0xe3, 0x34, 0x69, 0x3b, 0xc5, 0xdf, 0xb3, 0xbf, 0xf7, 0xbe, 0xf7, 0xe2, 0x04, 0x35, 0x04, 0x8c,
-	0x20, 0x05, 0xc1, 0xe6, 0x78, 0xb5, 0x72, 0xc7, 0x8c, 0x0a, 0x6a, 0xd6, 0x56, 0x40, 0x73, 0x2b,
-	0xa6, 0x31, 0x55, 0x28, 0x96, 0xab, 0xfc, 0x40, 0xd3, 0x89, 0x29, 0x8d, 0x47, 0x80, 0x55, 0x15,
-	0x4c, 0x8e, 0xb1, 0x48, 0x52, 0xe0, 0x82, 0xa4, 0x63, 0x7d, 0xa0, 0xf1, 0xff, 0x01, 0x92, 0x69,
-	0xf2, 0xa6, 0x1d, 0x52, 0x9e, 0x52, 0x8e, 0x03, 0xc2, 0x01, 0x4f, 0x77, 0x03, 0x10, 0x64, 0x17,
-	0x87, 0x34, 0xc9, 0xf2, 0xfd, 0x9d, 0x0c, 0x6d, 0xef, 0x4f, 0x81, 0x91, 0x18, 0xbc, 0x11, 0x0d,
-	0x87, 0xef, 0x93, 0x2f, 0x70, 0x08, 0xac, 0x4b, 0xe6, 0xe6, 0x4b, 0x54, 0x89, 0x88, 0x00, 0xcb,
-	0x68, 0x19, 0xed, 0xfa, 0x5e, 0xd3, 0xcd, 0x35, 0xdc, 0x42, 0xc3, 0xed, 0x15, 0x43, 0x78, 0xeb,
-	0xa7, 0xe7, 0x4e, 0xe9, 0xe4, 0xc2, 0x31, 0x7c, 0xd5, 0x61, 0x6e, 0xa1, 0x3b, 0xc1, 0x5c, 0x00,
-	0xb7, 0xd6, 0x5a, 0x46, 0xbb, 0xe2, 0xe7, 0xc5, 0xab, 0xca, 0x9f, 0xaf, 0x8e, 0xb1, 0xc3, 0xae,
-	0xea, 0x49, 0x92, 0x1b, 0xeb, 0x59, 0xe8, 0x2e, 0x87, 0x90, 0x66, 0x51, 0xa1, 0x58, 0x94, 0x5a,
-	0xf3, 0xbb, 0x81, 0x4c, 0x2d, 0xda, 0x9b, 0xbd, 0x86, 0x9b, 0x0b, 0x7e, 0x42, 0xe5, 0x63, 0x00,
-	0x6b, 0xad, 0x55, 0x6e, 0xd7, 0xf7, 0x1a, 0x6e, 0x1e, 0xb1, 0x2b, 0x23, 0x76, 0x75, 0xc4, 0xee,
-	0x01, 0x4d, 0x32, 0xef, 0x99, 0xec, 0xfb, 0x76, 0xe1, 0xb4, 0xe3, 0x44, 0x0c, 0x26, 0x81, 0x1b,
-	0xd2, 0x14, 0xeb, 0xf7, 0x91, 0x3f, 0x3a, 0x3c, 0x1a, 0x62, 0x31, 0x1f, 0x03, 0x57, 0x0d, 0xdc,
-	0x97, 0xbc, 0x7a, 0xea, 0x01, 0xda, 0xe8, 0xcd, 0x8e, 0xe8, 0x68, 0x72, 0x0b, 0x09, 0x6d, 0xa3,
-	0xea, 0x54, 0x31, 0xe9, 0x80, 0x74, 0xa5, 0x95, 0x7e, 0x18, 0xe8, 0xe1, 0x11, 0x19, 0x25, 0x11,
-	0x11, 0x94, 0xe5, 0xd7, 0x40, 0x10, 0xc1, 0xcd, 0xa7, 0xe8, 0xc1, 0xb4, 0x80, 0xfb, 0x24, 0x8a,
-	0x18, 0x70, 0xae, 0xc4, 0x6b, 0xfe, 0xe6, 0x6a, 0x63, 0x3f, 0xc7, 0xcd, 0xc7, 0xe8, 0x5e, 0x20,
-	0x5b, 0x79, 0x3f, 0xa4, 0x93, 0x4c, 0x68, 0xa1, 0x7a, 0x8e, 0x1d, 0x48, 0xc8, 0xfc, 0x80, 0x36,
-	0xb9, 0x20, 0x43, 0xe8, 0x8f, 0x81, 0x85, 0x90, 0x09, 0x12, 0x83, 0x55, 0x96, 0x74, 0x9e, 0x2b,
-	0xe7, 0xfd, 0x75, 0xee, 0x3c, 0xb9, 0x46, 0x50, 0x5d, 0x08, 0xfd, 0xfb, 0x8a, 0xe7, 0x70, 0x45,
-	0xa3, 0x8d, 0xfc, 0x35, 0xd0, 0xc6, 0x55, 0x23, 0xd2, 0xf9, 0x00, 0x92, 0x78, 0x20, 0xd4, 0xe0,
-	0x15, 0x5f, 0x57, 0x32, 0x4b, 0xf9, 0x15, 0xa9, 0x31, 0xaf, 0x9d, 0xa5, 0xec, 0x30, 0x1f, 0xa1,
-	0x9a, 0x98, 0x15, 0x2e, 0xcb, 0x8a, 0x74, 0x5d, 0xcc, 0xb4, 0xc5, 0x10, 0x55, 0x19, 0x7c, 0x26,
-	0x2c, 0xb2, 0x2a, 0xb7, 0x7f, 0x39, 0x34, 0x75, 0x6e, 0xd6, 0x7b, 0x77, 0xba, 0xb0, 0x8d, 0xb3,
-	0x85, 0x6d, 0xfc, 0x5e, 0xd8, 0xc6, 0xc9, 0xd2, 0x2e, 0x9d, 0x2d, 0xed, 0xd2, 0xcf, 0xa5, 0x5d,
-	0xfa, 0xf8, 0xe2, 0x12, 0xe3, 0x1b, 0xa0, 0x5d, 0xaf, 0xf3, 0x36, 0x49, 0x13, 0x01, 0x11, 0xa6,
-	0x51, 0x92, 0x75, 0x42, 0xca, 0x00, 0xcf, 0xf0, 0xa5, 0x1f, 0x92, 0xd4, 0x08, 0xaa, 0xca, 0xfb,
-	0xf3, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x6b, 0xbc, 0xec, 0xaa, 0x04, 0x00, 0x00,
-}
-
-func (this *AverageBlockSizePerDay) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+var xxx_messageInfo_BlockStats proto.InternalMessageInfo
+
+func (m *BlockStats) GetStart() time.Time {
+	if m != nil {
+		return m.Start
 	}
+	return time.Time{}
+}
 
-	that1, ok := that.(*AverageBlockSizePerDay)
-	if !ok {
-		that2, ok := that.(AverageBlockSizePerDay)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *BlockStats) GetBlocksCount() uint64 {
+	if m != nil {
+		return m.BlocksCount
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *BlockStats) GetBlocksBytes() uint64 {
+	if m != nil {
+		return m.BlocksBytes
 	}
-	if !this.Date.Equal(that1.Date) {
-		return false
+	return 0
+}
+
+func (m *BlockStats) GetBlocksInterval() time.Duration {
+	if m != nil {
+		return m.BlocksInterval
 	}
-	if this.Bytes != that1.Bytes {
-		return false
+	return 0
+}
+
+func (m *BlockStats) GetTxsCount() uint64 {
+	if m != nil {
+		return m.TxsCount
 	}
-	return true
+	return 0
 }
-func (this *AverageBlockTimePerDay) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *BlockStats) GetTxsFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TxsFee
 	}
+	return nil
+}
 
-	that1, ok := that.(*AverageBlockTimePerDay)
-	if !ok {
-		that2, ok := that.(AverageBlockTimePerDay)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// OracleScriptStats represents the requests of an oracle script over a time bucket.
+type OracleScriptStats struct {
+	OracleScriptID uint64 `protobuf:"varint,1,opt,name=oracle_script_id,json=oracleScriptId,proto3" json:"oracle_script_id,omitempty"`
+	RequestsCount  uint64 `protobuf:"varint,2,opt,name=requests_count,json=requestsCount,proto3" json:"requests_count,omitempty"`
+	SuccessCount   uint64 `protobuf:"varint,3,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount   uint64 `protobuf:"varint,4,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	ExpiredCount   uint64 `protobuf:"varint,5,opt,name=expired_count,json=expiredCount,proto3" json:"expired_count,omitempty"`
+	// resolve_blocks is the total number of blocks from request to resolve of the successful and failed requests.
+	ResolveBlocks uint64 `protobuf:"varint,6,opt,name=resolve_blocks,json=resolveBlocks,proto3" json:"resolve_blocks,omitempty"`
+}
+
+func (m *OracleScriptStats) Reset()         { *m = OracleScriptStats{} }
+func (m *OracleScriptStats) String() string { return proto.CompactTextString(m) }
+func (*OracleScriptStats) ProtoMessage()    {}
+func (*OracleScriptStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83397851ec684947, []int{7}
+}
+func (m *OracleScriptStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleScriptStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleScriptStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Date.Equal(that1.Date) {
-		return false
-	}
-	if this.Seconds != that1.Seconds {
-		return false
+}
+func (m *OracleScriptStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleScriptStats.Merge(m, src)
+}
+func (m *OracleScriptStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleScriptStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleScriptStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleScriptStats proto.InternalMessageInfo
+
+func (m *OracleScriptStats) GetOracleScriptID() uint64 {
+	if m != nil {
+		return m.OracleScriptID
 	}
-	return true
+	return 0
 }
-func (this *AverageTxFeePerDay) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *OracleScriptStats) GetRequestsCount() uint64 {
+	if m != nil {
+		return m.RequestsCount
 	}
+	return 0
+}
 
-	that1, ok := that.(*AverageTxFeePerDay)
-	if !ok {
-		that2, ok := that.(AverageTxFeePerDay)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *OracleScriptStats) GetSuccessCount() uint64 {
+	if m != nil {
+		return m.SuccessCount
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *OracleScriptStats) GetFailureCount() uint64 {
+	if m != nil {
+		return m.FailureCount
 	}
-	if !this.Date.Equal(that1.Date) {
-		return false
+	return 0
+}
+
+func (m *OracleScriptStats) GetExpiredCount() uint64 {
+	if m != nil {
+		return m.ExpiredCount
 	}
-	if len(this.Fee) != len(that1.Fee) {
-		return false
+	return 0
+}
+
+func (m *OracleScriptStats) GetResolveBlocks() uint64 {
+	if m != nil {
+		return m.ResolveBlocks
 	}
-	for i := range this.Fee {
-		if !this.Fee[i].Equal(&that1.Fee[i]) {
-			return false
+	return 0
+}
+
+// OracleRequests represents the oracle requests made and resolved over a time bucket.
+type OracleRequests struct {
+	Start         time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	RequestsCount uint64    `protobuf:"varint,2,opt,name=requests_count,json=requestsCount,proto3" json:"requests_count,omitempty"`
+	SuccessCount  uint64    `protobuf:"varint,3,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount  uint64    `protobuf:"varint,4,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	ExpiredCount  uint64    `protobuf:"varint,5,opt,name=expired_count,json=expiredCount,proto3" json:"expired_count,omitempty"`
+	// avg_resolve_blocks is the average number of blocks from request to resolve of the successful and failed
+	// requests.
+	AvgResolveBlocks github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=avg_resolve_blocks,json=avgResolveBlocks,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"avg_resolve_blocks"`
+	OracleScripts    []OracleScriptStats                    `protobuf:"bytes,7,rep,name=oracle_scripts,json=oracleScripts,proto3" json:"oracle_scripts"`
+}
+
+func (m *OracleRequests) Reset()         { *m = OracleRequests{} }
+func (m *OracleRequests) String() string { return proto.CompactTextString(m) }
+func (*OracleRequests) ProtoMessage()    {}
+func (*OracleRequests) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83397851ec684947, []int{8}
+}
+func (m *OracleRequests) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleRequests) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleRequests.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *TxVolumePerDay) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *OracleRequests) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleRequests.Merge(m, src)
+}
+func (m *OracleRequests) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleRequests) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleRequests.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleRequests proto.InternalMessageInfo
+
+func (m *OracleRequests) GetStart() time.Time {
+	if m != nil {
+		return m.Start
 	}
+	return time.Time{}
+}
 
-	that1, ok := that.(*TxVolumePerDay)
-	if !ok {
-		that2, ok := that.(TxVolumePerDay)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *OracleRequests) GetRequestsCount() uint64 {
+	if m != nil {
+		return m.RequestsCount
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *OracleRequests) GetSuccessCount() uint64 {
+	if m != nil {
+		return m.SuccessCount
 	}
-	if !this.Date.Equal(that1.Date) {
-		return false
+	return 0
+}
+
+func (m *OracleRequests) GetFailureCount() uint64 {
+	if m != nil {
+		return m.FailureCount
 	}
-	if this.Volume != that1.Volume {
-		return false
+	return 0
+}
+
+func (m *OracleRequests) GetExpiredCount() uint64 {
+	if m != nil {
+		return m.ExpiredCount
 	}
-	return true
+	return 0
 }
-func (this *ValidatorBlockStats) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *OracleRequests) GetOracleScripts() []OracleScriptStats {
+	if m != nil {
+		return m.OracleScripts
 	}
+	return nil
+}
 
-	that1, ok := that.(*ValidatorBlockStats)
-	if !ok {
-		that2, ok := that.(ValidatorBlockStats)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// DataSourceStats represents the number of raw requests made to a data source.
+type DataSourceStats struct {
+	DataSourceID  uint64 `protobuf:"varint,1,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
+	RequestsCount uint64 `protobuf:"varint,2,opt,name=requests_count,json=requestsCount,proto3" json:"requests_count,omitempty"`
+}
+
+func (m *DataSourceStats) Reset()         { *m = DataSourceStats{} }
+func (m *DataSourceStats) String() string { return proto.CompactTextString(m) }
+func (*DataSourceStats) ProtoMessage()    {}
+func (*DataSourceStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83397851ec684947, []int{9}
+}
+func (m *DataSourceStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataSourceStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataSourceStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ValidatorAddress != that1.ValidatorAddress {
-		return false
-	}
-	if this.BlocksCount != that1.BlocksCount {
-		return false
-	}
-	if !this.StakePercentage.Equal(that1.StakePercentage) {
-		return false
-	}
-	return true
 }
-func (this *ValidatorBlock) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *DataSourceStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSourceStats.Merge(m, src)
+}
+func (m *DataSourceStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataSourceStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSourceStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSourceStats proto.InternalMessageInfo
+
+func (m *DataSourceStats) GetDataSourceID() uint64 {
+	if m != nil {
+		return m.DataSourceID
 	}
+	return 0
+}
 
-	that1, ok := that.(*ValidatorBlock)
-	if !ok {
-		that2, ok := that.(ValidatorBlock)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Height != that1.Height {
-		return false
-	}
-	if !this.Time.Equal(that1.Time) {
-		return false
-	}
-	if this.TxsCount != that1.TxsCount {
-		return false
-	}
-	if len(this.Reward) != len(that1.Reward) {
-		return false
-	}
-	for i := range this.Reward {
-		if !this.Reward[i].Equal(&that1.Reward[i]) {
-			return false
-		}
-	}
-	return true
-}
-func (m *AverageBlockSizePerDay) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DataSourceStats) GetRequestsCount() uint64 {
+	if m != nil {
+		return m.RequestsCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AverageBlockSizePerDay) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ReportParticipation represents the reports of a validator compared to the requests it was asked for.
+type ReportParticipation struct {
+	ValidatorAddress string                                 `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	RequestedCount   uint64                                 `protobuf:"varint,2,opt,name=requested_count,json=requestedCount,proto3" json:"requested_count,omitempty"`
+	ReportsCount     uint64                                 `protobuf:"varint,3,opt,name=reports_count,json=reportsCount,proto3" json:"reports_count,omitempty"`
+	Participation    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=participation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"participation"`
 }
 
-func (m *AverageBlockSizePerDay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Bytes != 0 {
-		i = encodeVarintTelemetry(dAtA, i, uint64(m.Bytes))
-		i--
-		dAtA[i] = 0x10
-	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Date, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Date):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintTelemetry(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *ReportParticipation) Reset()         { *m = ReportParticipation{} }
+func (m *ReportParticipation) String() string { return proto.CompactTextString(m) }
+func (*ReportParticipation) ProtoMessage()    {}
+func (*ReportParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83397851ec684947, []int{10}
 }
-
-func (m *AverageBlockTimePerDay) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReportParticipation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReportParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReportParticipation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *AverageBlockTimePerDay) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReportParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportParticipation.Merge(m, src)
+}
+func (m *ReportParticipation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReportParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportParticipation.DiscardUnknown(m)
 }
 
-func (m *AverageBlockTimePerDay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Seconds != 0 {
-		i = encodeVarintTelemetry(dAtA, i, uint64(m.Seconds))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_ReportParticipation proto.InternalMessageInfo
+
+func (m *ReportParticipation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Date, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Date):])
-	if err2 != nil {
-		return 0, err2
+	return ""
+}
+
+func (m *ReportParticipation) GetRequestedCount() uint64 {
+	if m != nil {
+		return m.RequestedCount
 	}
-	i -= n2
-	i = encodeVarintTelemetry(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *AverageTxFeePerDay) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReportParticipation) GetReportsCount() uint64 {
+	if m != nil {
+		return m.ReportsCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AverageTxFeePerDay) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DataProviderRewards represents the rewards paid out to data providers over a time bucket.
+type DataProviderRewards struct {
+	Start        time.Time                                `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	Amount       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	PayoutsCount uint64                                   `protobuf:"varint,3,opt,name=payouts_count,json=payoutsCount,proto3" json:"payouts_count,omitempty"`
 }
 
-func (m *AverageTxFeePerDay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fee) > 0 {
-		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTelemetry(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *DataProviderRewards) Reset()         { *m = DataProviderRewards{} }
+func (m *DataProviderRewards) String() string { return proto.CompactTextString(m) }
+func (*DataProviderRewards) ProtoMessage()    {}
+func (*DataProviderRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83397851ec684947, []int{11}
+}
+func (m *DataProviderRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataProviderRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataProviderRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Date, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Date):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintTelemetry(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *TxVolumePerDay) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *DataProviderRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataProviderRewards.Merge(m, src)
 }
-
-func (m *TxVolumePerDay) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DataProviderRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataProviderRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataProviderRewards.DiscardUnknown(m)
 }
 
-func (m *TxVolumePerDay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Volume != 0 {
-		i = encodeVarintTelemetry(dAtA, i, uint64(m.Volume))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_DataProviderRewards proto.InternalMessageInfo
+
+func (m *DataProviderRewards) GetStart() time.Time {
+	if m != nil {
+		return m.Start
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Date, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Date):])
-	if err4 != nil {
-		return 0, err4
+	return time.Time{}
+}
+
+func (m *DataProviderRewards) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	i -= n4
-	i = encodeVarintTelemetry(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ValidatorBlockStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DataProviderRewards) GetPayoutsCount() uint64 {
+	if m != nil {
+		return m.PayoutsCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ValidatorBlockStats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ActiveAccounts represents the number of distinct accounts that signed transactions over a time bucket.
+type ActiveAccounts struct {
+	Start         time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	AccountsCount uint64    `protobuf:"varint,2,opt,name=accounts_count,json=accountsCount,proto3" json:"accounts_count,omitempty"`
 }
 
-func (m *ValidatorBlockStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.StakePercentage.Size()
-		i -= size
-		if _, err := m.StakePercentage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *ActiveAccounts) Reset()         { *m = ActiveAccounts{} }
+func (m *ActiveAccounts) String() string { return proto.CompactTextString(m) }
+func (*ActiveAccounts) ProtoMessage()    {}
+func (*ActiveAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83397851ec684947, []int{12}
+}
+func (m *ActiveAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActiveAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActiveAccounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTelemetry(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.BlocksCount != 0 {
-		i = encodeVarintTelemetry(dAtA, i, uint64(m.BlocksCount))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTelemetry(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ActiveAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActiveAccounts.Merge(m, src)
+}
+func (m *ActiveAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActiveAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActiveAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActiveAccounts proto.InternalMessageInfo
+
+func (m *ActiveAccounts) GetStart() time.Time {
+	if m != nil {
+		return m.Start
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *ValidatorBlock) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ActiveAccounts) GetAccountsCount() uint64 {
+	if m != nil {
+		return m.AccountsCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ValidatorBlock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AccountGrowth represents the accounts created over a time bucket and the total number of accounts at its end.
+type AccountGrowth struct {
+	Start         time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	NewAccounts   uint64    `protobuf:"varint,2,opt,name=new_accounts,json=newAccounts,proto3" json:"new_accounts,omitempty"`
+	TotalAccounts uint64    `protobuf:"varint,3,opt,name=total_accounts,json=totalAccounts,proto3" json:"total_accounts,omitempty"`
 }
 
-func (m *ValidatorBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reward) > 0 {
-		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTelemetry(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *AccountGrowth) Reset()         { *m = AccountGrowth{} }
+func (m *AccountGrowth) String() string { return proto.CompactTextString(m) }
+func (*AccountGrowth) ProtoMessage()    {}
+func (*AccountGrowth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83397851ec684947, []int{13}
+}
+func (m *AccountGrowth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountGrowth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountGrowth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.TxsCount != 0 {
-		i = encodeVarintTelemetry(dAtA, i, uint64(m.TxsCount))
-		i--
-		dAtA[i] = 0x18
-	}
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err5 != nil {
-		return 0, err5
-	}
-	i -= n5
-	i = encodeVarintTelemetry(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x12
-	if m.Height != 0 {
-		i = encodeVarintTelemetry(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *AccountGrowth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountGrowth.Merge(m, src)
+}
+func (m *AccountGrowth) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountGrowth) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountGrowth.DiscardUnknown(m)
 }
 
-func encodeVarintTelemetry(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTelemetry(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_AccountGrowth proto.InternalMessageInfo
+
+func (m *AccountGrowth) GetStart() time.Time {
+	if m != nil {
+		return m.Start
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return time.Time{}
 }
-func (m *AverageBlockSizePerDay) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *AccountGrowth) GetNewAccounts() uint64 {
+	if m != nil {
+		return m.NewAccounts
 	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Date)
-	n += 1 + l + sovTelemetry(uint64(l))
-	if m.Bytes != 0 {
-		n += 1 + sovTelemetry(uint64(m.Bytes))
+	return 0
+}
+
+func (m *AccountGrowth) GetTotalAccounts() uint64 {
+	if m != nil {
+		return m.TotalAccounts
 	}
-	return n
+	return 0
 }
 
-func (m *AverageBlockTimePerDay) Size() (n int) {
-	if m == nil {
-		return 0
+// AccountTxs represents the number of transactions signed by an account.
+type AccountTxs struct {
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	TxsCount uint64 `protobuf:"varint,2,opt,name=txs_count,json=txsCount,proto3" json:"txs_count,omitempty"`
+}
+
+func (m *AccountTxs) Reset()         { *m = AccountTxs{} }
+func (m *AccountTxs) String() string { return proto.CompactTextString(m) }
+func (*AccountTxs) ProtoMessage()    {}
+func (*AccountTxs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83397851ec684947, []int{14}
+}
+func (m *AccountTxs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountTxs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountTxs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Date)
-	n += 1 + l + sovTelemetry(uint64(l))
-	if m.Seconds != 0 {
-		n += 1 + sovTelemetry(uint64(m.Seconds))
+}
+func (m *AccountTxs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTxs.Merge(m, src)
+}
+func (m *AccountTxs) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountTxs) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTxs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTxs proto.InternalMessageInfo
+
+func (m *AccountTxs) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return n
+	return ""
 }
 
-func (m *AverageTxFeePerDay) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AccountTxs) GetTxsCount() uint64 {
+	if m != nil {
+		return m.TxsCount
 	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Date)
-	n += 1 + l + sovTelemetry(uint64(l))
-	if len(m.Fee) > 0 {
-		for _, e := range m.Fee {
-			l = e.Size()
-			n += 1 + l + sovTelemetry(uint64(l))
+	return 0
+}
+
+// DenomSnapshot represents the holdings of a denomination at the height of a periodic supply snapshot. The balances
+// of the user accounts are stored under their own keys.
+type DenomSnapshot struct {
+	Denom         string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Height        int64                                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time          time.Time                              `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	TotalSupply   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_supply,json=totalSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_supply"`
+	TreasuryPool  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=treasury_pool,json=treasuryPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"treasury_pool"`
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"community_pool"`
+	// module_accounts is the amount held by module accounts, treasury and community pools included.
+	ModuleAccounts github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=module_accounts,json=moduleAccounts,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"module_accounts"`
+}
+
+func (m *DenomSnapshot) Reset()         { *m = DenomSnapshot{} }
+func (m *DenomSnapshot) String() string { return proto.CompactTextString(m) }
+func (*DenomSnapshot) ProtoMessage()    {}
+func (*DenomSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83397851ec684947, []int{15}
+}
+func (m *DenomSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *DenomSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomSnapshot.Merge(m, src)
+}
+func (m *DenomSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomSnapshot.DiscardUnknown(m)
 }
 
-func (m *TxVolumePerDay) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Date)
-	n += 1 + l + sovTelemetry(uint64(l))
-	if m.Volume != 0 {
-		n += 1 + sovTelemetry(uint64(m.Volume))
+var xxx_messageInfo_DenomSnapshot proto.InternalMessageInfo
+
+func (m *DenomSnapshot) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *ValidatorBlockStats) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DenomSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTelemetry(uint64(l))
+	return 0
+}
+
+func (m *DenomSnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	if m.BlocksCount != 0 {
-		n += 1 + sovTelemetry(uint64(m.BlocksCount))
+	return time.Time{}
+}
+
+// HoldersThreshold represents the number of user accounts holding at least an amount.
+type HoldersThreshold struct {
+	Amount       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	HoldersCount uint64                                 `protobuf:"varint,2,opt,name=holders_count,json=holdersCount,proto3" json:"holders_count,omitempty"`
+}
+
+func (m *HoldersThreshold) Reset()         { *m = HoldersThreshold{} }
+func (m *HoldersThreshold) String() string { return proto.CompactTextString(m) }
+func (*HoldersThreshold) ProtoMessage()    {}
+func (*HoldersThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83397851ec684947, []int{16}
+}
+func (m *HoldersThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HoldersThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HoldersThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.StakePercentage.Size()
-	n += 1 + l + sovTelemetry(uint64(l))
-	return n
+}
+func (m *HoldersThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HoldersThreshold.Merge(m, src)
+}
+func (m *HoldersThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *HoldersThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_HoldersThreshold.DiscardUnknown(m)
 }
 
-func (m *ValidatorBlock) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_HoldersThreshold proto.InternalMessageInfo
+
+func (m *HoldersThreshold) GetHoldersCount() uint64 {
+	if m != nil {
+		return m.HoldersCount
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovTelemetry(uint64(m.Height))
+	return 0
+}
+
+// PercentileCutoff represents the balance at or below which the given percentage of user accounts fall.
+type PercentileCutoff struct {
+	Percentile uint32                                 `protobuf:"varint,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	Amount     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *PercentileCutoff) Reset()         { *m = PercentileCutoff{} }
+func (m *PercentileCutoff) String() string { return proto.CompactTextString(m) }
+func (*PercentileCutoff) ProtoMessage()    {}
+func (*PercentileCutoff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83397851ec684947, []int{17}
+}
+func (m *PercentileCutoff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PercentileCutoff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PercentileCutoff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
-	n += 1 + l + sovTelemetry(uint64(l))
-	if m.TxsCount != 0 {
-		n += 1 + sovTelemetry(uint64(m.TxsCount))
+}
+func (m *PercentileCutoff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PercentileCutoff.Merge(m, src)
+}
+func (m *PercentileCutoff) XXX_Size() int {
+	return m.Size()
+}
+func (m *PercentileCutoff) XXX_DiscardUnknown() {
+	xxx_messageInfo_PercentileCutoff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PercentileCutoff proto.InternalMessageInfo
+
+func (m *PercentileCutoff) GetPercentile() uint32 {
+	if m != nil {
+		return m.Percentile
 	}
-	if len(m.Reward) > 0 {
-		for _, e := range m.Reward {
-			l = e.Size()
-			n += 1 + l + sovTelemetry(uint64(l))
+	return 0
+}
+
+// SupplyDistribution represents how the supply of a denomination is distributed among holders.
+type SupplyDistribution struct {
+	Denom       string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Height      int64                                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time        time.Time                              `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	TotalSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_supply,json=totalSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_supply"`
+	// circulating is the total supply minus the treasury and community pools.
+	Circulating         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=circulating,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"circulating"`
+	TreasuryPool        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=treasury_pool,json=treasuryPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"treasury_pool"`
+	CommunityPool       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"community_pool"`
+	ModuleAccountsShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=module_accounts_share,json=moduleAccountsShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"module_accounts_share"`
+	UsersShare          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=users_share,json=usersShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"users_share"`
+	HoldersCount        uint64                                 `protobuf:"varint,10,opt,name=holders_count,json=holdersCount,proto3" json:"holders_count,omitempty"`
+	// gini is the Gini coefficient of the user balances.
+	Gini        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=gini,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"gini"`
+	Thresholds  []HoldersThreshold                     `protobuf:"bytes,12,rep,name=thresholds,proto3" json:"thresholds"`
+	Percentiles []PercentileCutoff                     `protobuf:"bytes,13,rep,name=percentiles,proto3" json:"percentiles"`
+}
+
+func (m *SupplyDistribution) Reset()         { *m = SupplyDistribution{} }
+func (m *SupplyDistribution) String() string { return proto.CompactTextString(m) }
+func (*SupplyDistribution) ProtoMessage()    {}
+func (*SupplyDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83397851ec684947, []int{18}
+}
+func (m *SupplyDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func sovTelemetry(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *SupplyDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyDistribution.Merge(m, src)
 }
-func sozTelemetry(x uint64) (n int) {
-	return sovTelemetry(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *SupplyDistribution) XXX_Size() int {
+	return m.Size()
 }
-func (m *AverageBlockSizePerDay) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+func (m *SupplyDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyDistribution proto.InternalMessageInfo
+
+func (m *SupplyDistribution) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *SupplyDistribution) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SupplyDistribution) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *SupplyDistribution) GetHoldersCount() uint64 {
+	if m != nil {
+		return m.HoldersCount
+	}
+	return 0
+}
+
+func (m *SupplyDistribution) GetThresholds() []HoldersThreshold {
+	if m != nil {
+		return m.Thresholds
+	}
+	return nil
+}
+
+func (m *SupplyDistribution) GetPercentiles() []PercentileCutoff {
+	if m != nil {
+		return m.Percentiles
+	}
+	return nil
+}
+
+// ValidatorUptime represents the blocks a validator signed and missed over a time bucket.
+type ValidatorUptime struct {
+	Start        time.Time                              `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	SignedBlocks uint64                                 `protobuf:"varint,2,opt,name=signed_blocks,json=signedBlocks,proto3" json:"signed_blocks,omitempty"`
+	MissedBlocks uint64                                 `protobuf:"varint,3,opt,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty"`
+	Uptime       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=uptime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"uptime"`
+	// oracle_active is whether the validator was active in the oracle at the end of the bucket.
+	OracleActive bool `protobuf:"varint,5,opt,name=oracle_active,json=oracleActive,proto3" json:"oracle_active,omitempty"`
+}
+
+func (m *ValidatorUptime) Reset()         { *m = ValidatorUptime{} }
+func (m *ValidatorUptime) String() string { return proto.CompactTextString(m) }
+func (*ValidatorUptime) ProtoMessage()    {}
+func (*ValidatorUptime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83397851ec684947, []int{19}
+}
+func (m *ValidatorUptime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorUptime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorUptime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorUptime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorUptime.Merge(m, src)
+}
+func (m *ValidatorUptime) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorUptime) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorUptime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorUptime proto.InternalMessageInfo
+
+func (m *ValidatorUptime) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func (m *ValidatorUptime) GetSignedBlocks() uint64 {
+	if m != nil {
+		return m.SignedBlocks
+	}
+	return 0
+}
+
+func (m *ValidatorUptime) GetMissedBlocks() uint64 {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return 0
+}
+
+func (m *ValidatorUptime) GetOracleActive() bool {
+	if m != nil {
+		return m.OracleActive
+	}
+	return false
+}
+
+// OracleStatusChange represents a validator being activated or deactivated in the oracle.
+type OracleStatusChange struct {
+	Time     time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	IsActive bool      `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+}
+
+func (m *OracleStatusChange) Reset()         { *m = OracleStatusChange{} }
+func (m *OracleStatusChange) String() string { return proto.CompactTextString(m) }
+func (*OracleStatusChange) ProtoMessage()    {}
+func (*OracleStatusChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83397851ec684947, []int{20}
+}
+func (m *OracleStatusChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleStatusChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleStatusChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleStatusChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleStatusChange.Merge(m, src)
+}
+func (m *OracleStatusChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleStatusChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleStatusChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleStatusChange proto.InternalMessageInfo
+
+func (m *OracleStatusChange) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *OracleStatusChange) GetIsActive() bool {
+	if m != nil {
+		return m.IsActive
+	}
+	return false
+}
+
+// ValidatorUptimeStats represents the blocks a validator signed and missed over a date range.
+type ValidatorUptimeStats struct {
+	ValidatorAddress string                                 `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	SignedBlocks     uint64                                 `protobuf:"varint,2,opt,name=signed_blocks,json=signedBlocks,proto3" json:"signed_blocks,omitempty"`
+	MissedBlocks     uint64                                 `protobuf:"varint,3,opt,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty"`
+	Uptime           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=uptime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"uptime"`
+	// oracle_active is whether the validator is currently active in the oracle.
+	OracleActive bool `protobuf:"varint,5,opt,name=oracle_active,json=oracleActive,proto3" json:"oracle_active,omitempty"`
+}
+
+func (m *ValidatorUptimeStats) Reset()         { *m = ValidatorUptimeStats{} }
+func (m *ValidatorUptimeStats) String() string { return proto.CompactTextString(m) }
+func (*ValidatorUptimeStats) ProtoMessage()    {}
+func (*ValidatorUptimeStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83397851ec684947, []int{21}
+}
+func (m *ValidatorUptimeStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorUptimeStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorUptimeStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorUptimeStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorUptimeStats.Merge(m, src)
+}
+func (m *ValidatorUptimeStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorUptimeStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorUptimeStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorUptimeStats proto.InternalMessageInfo
+
+func (m *ValidatorUptimeStats) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorUptimeStats) GetSignedBlocks() uint64 {
+	if m != nil {
+		return m.SignedBlocks
+	}
+	return 0
+}
+
+func (m *ValidatorUptimeStats) GetMissedBlocks() uint64 {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return 0
+}
+
+func (m *ValidatorUptimeStats) GetOracleActive() bool {
+	if m != nil {
+		return m.OracleActive
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("telemetry.Granularity", Granularity_name, Granularity_value)
+	proto.RegisterType((*AverageBlockSize)(nil), "telemetry.AverageBlockSize")
+	proto.RegisterType((*AverageBlockTime)(nil), "telemetry.AverageBlockTime")
+	proto.RegisterType((*AverageTxFee)(nil), "telemetry.AverageTxFee")
+	proto.RegisterType((*TxVolume)(nil), "telemetry.TxVolume")
+	proto.RegisterType((*ValidatorBlockStats)(nil), "telemetry.ValidatorBlockStats")
+	proto.RegisterType((*ValidatorBlock)(nil), "telemetry.ValidatorBlock")
+	proto.RegisterType((*BlockStats)(nil), "telemetry.BlockStats")
+	proto.RegisterType((*OracleScriptStats)(nil), "telemetry.OracleScriptStats")
+	proto.RegisterType((*OracleRequests)(nil), "telemetry.OracleRequests")
+	proto.RegisterType((*DataSourceStats)(nil), "telemetry.DataSourceStats")
+	proto.RegisterType((*ReportParticipation)(nil), "telemetry.ReportParticipation")
+	proto.RegisterType((*DataProviderRewards)(nil), "telemetry.DataProviderRewards")
+	proto.RegisterType((*ActiveAccounts)(nil), "telemetry.ActiveAccounts")
+	proto.RegisterType((*AccountGrowth)(nil), "telemetry.AccountGrowth")
+	proto.RegisterType((*AccountTxs)(nil), "telemetry.AccountTxs")
+	proto.RegisterType((*DenomSnapshot)(nil), "telemetry.DenomSnapshot")
+	proto.RegisterType((*HoldersThreshold)(nil), "telemetry.HoldersThreshold")
+	proto.RegisterType((*PercentileCutoff)(nil), "telemetry.PercentileCutoff")
+	proto.RegisterType((*SupplyDistribution)(nil), "telemetry.SupplyDistribution")
+	proto.RegisterType((*ValidatorUptime)(nil), "telemetry.ValidatorUptime")
+	proto.RegisterType((*OracleStatusChange)(nil), "telemetry.OracleStatusChange")
+	proto.RegisterType((*ValidatorUptimeStats)(nil), "telemetry.ValidatorUptimeStats")
+}
+
+func init() { proto.RegisterFile("telemetry/telemetry.proto", fileDescriptor_83397851ec684947) }
+
+var fileDescriptor_83397851ec684947 = []byte{
+	// 1599 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x8f, 0x1b, 0x49,
+	0x15, 0x9e, 0xb6, 0x1d, 0x8f, 0xe7, 0xf9, 0xc7, 0x38, 0x3d, 0x59, 0xd4, 0x99, 0x20, 0x7b, 0xc8,
+	0x6a, 0x21, 0x5a, 0x88, 0xcd, 0x2e, 0x2b, 0x84, 0x56, 0x5c, 0xec, 0x71, 0x26, 0x31, 0x84, 0x64,
+	0x68, 0x7b, 0x76, 0x15, 0x04, 0xb2, 0xca, 0xdd, 0x35, 0x76, 0x91, 0x76, 0x57, 0x6f, 0x55, 0xb5,
+	0x63, 0x73, 0xda, 0x0b, 0x12, 0xca, 0x69, 0x91, 0x38, 0x20, 0xa1, 0x48, 0x48, 0xdc, 0xb8, 0xf0,
+	0x5f, 0xa0, 0xbd, 0xb1, 0x17, 0x24, 0xc4, 0x21, 0x0b, 0x13, 0x21, 0x21, 0xc4, 0x3f, 0xc0, 0x0d,
+	0x75, 0x55, 0xb5, 0xdd, 0x6d, 0x73, 0xc8, 0xd8, 0x59, 0x89, 0x9c, 0x32, 0xf5, 0xf5, 0x7b, 0xdf,
+	0x7b, 0xf5, 0xd5, 0x7b, 0x55, 0x2f, 0x86, 0xeb, 0x02, 0x7b, 0x78, 0x82, 0x05, 0x9b, 0x37, 0x17,
+	0x7f, 0x35, 0x02, 0x46, 0x05, 0x35, 0xf7, 0x16, 0xc0, 0xe1, 0xb5, 0x11, 0x1d, 0x51, 0x89, 0x36,
+	0xa3, 0xbf, 0x94, 0xc1, 0x61, 0x7d, 0x44, 0xe9, 0xc8, 0xc3, 0x4d, 0xb9, 0x1a, 0x86, 0xe7, 0x4d,
+	0x41, 0x26, 0x98, 0x0b, 0x34, 0x09, 0xb4, 0x41, 0x6d, 0xd5, 0xc0, 0x0d, 0x19, 0x12, 0x84, 0xfa,
+	0xfa, 0xfb, 0xf5, 0xd5, 0xef, 0xc8, 0x9f, 0xc7, 0xae, 0x0e, 0xe5, 0x13, 0xca, 0x9b, 0x43, 0xc4,
+	0x71, 0x73, 0xfa, 0xce, 0x10, 0x0b, 0xf4, 0x4e, 0xd3, 0xa1, 0x44, 0xbb, 0xde, 0xfc, 0x29, 0x54,
+	0x5b, 0x53, 0xcc, 0xd0, 0x08, 0xb7, 0x3d, 0xea, 0x3c, 0xee, 0x91, 0x9f, 0x61, 0xf3, 0x7d, 0xb8,
+	0xc2, 0x05, 0x62, 0xc2, 0x32, 0x8e, 0x8c, 0x5b, 0xc5, 0x77, 0x0f, 0x1b, 0x8a, 0xbe, 0x11, 0xd3,
+	0x37, 0xfa, 0x71, 0x7e, 0xed, 0xc2, 0xa7, 0xcf, 0xeb, 0x3b, 0x9f, 0x7c, 0x5e, 0x37, 0x6c, 0xe5,
+	0x62, 0x5e, 0x83, 0x2b, 0xc3, 0xb9, 0xc0, 0xdc, 0xca, 0x1c, 0x19, 0xb7, 0x72, 0xb6, 0x5a, 0xbc,
+	0x9f, 0xfb, 0xe7, 0x6f, 0xeb, 0xc6, 0x4d, 0x3f, 0x1d, 0x2b, 0x62, 0xd9, 0x2a, 0x96, 0x05, 0xbb,
+	0x1c, 0x3b, 0xd4, 0x77, 0xe3, 0x68, 0xf1, 0x52, 0xc7, 0xfb, 0x83, 0x01, 0x25, 0x1d, 0xb0, 0x3f,
+	0x3b, 0xc1, 0xdb, 0x05, 0xfb, 0x09, 0x64, 0xcf, 0x31, 0xb6, 0x32, 0x47, 0xd9, 0x5b, 0xc5, 0x77,
+	0xaf, 0x37, 0x94, 0xac, 0x8d, 0x48, 0xd6, 0x86, 0x96, 0xb5, 0x71, 0x4c, 0x89, 0xdf, 0xfe, 0x66,
+	0xe4, 0xf8, 0xfb, 0xcf, 0xeb, 0xb7, 0x46, 0x44, 0x8c, 0xc3, 0x61, 0xc3, 0xa1, 0x93, 0xa6, 0x3e,
+	0x03, 0xf5, 0xcf, 0x6d, 0xee, 0x3e, 0x6e, 0x8a, 0x79, 0x80, 0xb9, 0x74, 0xe0, 0x76, 0xc4, 0xab,
+	0x33, 0x76, 0xa1, 0xd0, 0x9f, 0x7d, 0x40, 0xbd, 0x70, 0x4b, 0x65, 0xbe, 0x04, 0xf9, 0xa9, 0x64,
+	0xd1, 0xc2, 0xe8, 0x95, 0x8e, 0xf2, 0x47, 0x03, 0x0e, 0x3e, 0x40, 0x1e, 0x71, 0x91, 0xa0, 0x4c,
+	0x1d, 0xbb, 0x40, 0x82, 0x9b, 0x5f, 0x87, 0xab, 0xd3, 0x18, 0x1e, 0x20, 0xd7, 0x65, 0x98, 0x73,
+	0x19, 0x7d, 0xcf, 0xae, 0x2e, 0x3e, 0xb4, 0x14, 0x6e, 0x7e, 0x05, 0x4a, 0xc3, 0xc8, 0x95, 0x0f,
+	0x1c, 0x1a, 0xfa, 0x42, 0x07, 0x2a, 0x2a, 0xec, 0x38, 0x82, 0xcc, 0x47, 0x50, 0xe5, 0x02, 0x3d,
+	0xc6, 0x83, 0x00, 0x33, 0x07, 0xfb, 0x02, 0x8d, 0xb0, 0x95, 0x8d, 0xe8, 0xda, 0x8d, 0x28, 0xe1,
+	0xbf, 0x3e, 0xaf, 0x7f, 0xf5, 0x25, 0x44, 0xea, 0x60, 0xc7, 0xde, 0x97, 0x3c, 0xa7, 0x0b, 0x1a,
+	0xbd, 0x91, 0x7f, 0x19, 0x50, 0x49, 0x6f, 0x24, 0xda, 0xf9, 0x18, 0x93, 0xd1, 0x58, 0xc9, 0x96,
+	0xb3, 0xf5, 0xca, 0xfc, 0x0e, 0xe4, 0xa2, 0xae, 0xb2, 0x32, 0x97, 0x10, 0x53, 0x7a, 0x98, 0x37,
+	0x60, 0x4f, 0xcc, 0xe2, 0x5d, 0x66, 0x25, 0x69, 0x41, 0xcc, 0xf4, 0x16, 0x1d, 0xc8, 0x33, 0xfc,
+	0x04, 0x31, 0xd7, 0xca, 0xbd, 0xfa, 0xc2, 0xd0, 0xd4, 0x7a, 0xb3, 0xff, 0xc8, 0x00, 0x24, 0x0e,
+	0x6b, 0x9b, 0xf2, 0x78, 0x89, 0xb3, 0x5b, 0x9a, 0xa8, 0x76, 0xce, 0x26, 0x4d, 0xda, 0x11, 0x64,
+	0xde, 0x87, 0x7d, 0x6d, 0x42, 0x7c, 0x81, 0xd9, 0x14, 0x79, 0x56, 0x4e, 0xe6, 0x72, 0x7d, 0x2d,
+	0x97, 0x8e, 0xbe, 0xaf, 0x54, 0x2a, 0xbf, 0x8e, 0x52, 0xa9, 0x28, 0xdf, 0xae, 0x76, 0x4d, 0xcb,
+	0x7c, 0x65, 0x45, 0x66, 0x17, 0x76, 0xa3, 0x8f, 0x51, 0x03, 0xe6, 0xbf, 0x00, 0x9d, 0xc5, 0x8c,
+	0x9f, 0x2c, 0x7a, 0xf0, 0x57, 0x19, 0xb8, 0xfa, 0x90, 0x21, 0xc7, 0xc3, 0x3d, 0x87, 0x91, 0x40,
+	0x28, 0xb9, 0xbf, 0x0b, 0x55, 0x2a, 0xc1, 0x01, 0x97, 0xe8, 0x80, 0xb8, 0xaa, 0xc2, 0xda, 0xe6,
+	0xc5, 0xf3, 0x7a, 0x25, 0xe9, 0xd0, 0xed, 0xd8, 0x15, 0x9a, 0x5c, 0xbb, 0xe6, 0x5b, 0x50, 0x61,
+	0xf8, 0xa3, 0x10, 0x73, 0x91, 0x96, 0xbc, 0x1c, 0xa3, 0x6a, 0x9b, 0x6f, 0x42, 0x99, 0x87, 0x8e,
+	0x83, 0x79, 0xba, 0xdc, 0x4a, 0x1a, 0x5c, 0x18, 0x9d, 0x23, 0xe2, 0x85, 0x0c, 0x6b, 0xa3, 0x9c,
+	0x32, 0xd2, 0xe0, 0xc2, 0x08, 0xcf, 0x02, 0xc2, 0xb0, 0x9b, 0x52, 0xb4, 0xa4, 0x41, 0x65, 0x24,
+	0xb3, 0xe2, 0xd4, 0x9b, 0xe2, 0x81, 0x3a, 0x0c, 0x2b, 0x1f, 0x67, 0x25, 0x51, 0x59, 0x6d, 0xf1,
+	0x65, 0xfa, 0xcb, 0x2c, 0xe8, 0x5d, 0xda, 0x3a, 0xe7, 0xad, 0x4a, 0xf0, 0xff, 0x53, 0x91, 0x1f,
+	0x83, 0x89, 0xa6, 0xa3, 0xc1, 0xff, 0x50, 0xe5, 0xf2, 0x77, 0x56, 0x15, 0x4d, 0x47, 0x76, 0x52,
+	0x48, 0xb3, 0x0b, 0x95, 0x54, 0x0d, 0x71, 0x6b, 0x57, 0x16, 0xf3, 0x97, 0x1b, 0xcb, 0x91, 0x61,
+	0xad, 0xf2, 0xda, 0xb9, 0x28, 0xae, 0x5d, 0x4e, 0x56, 0x54, 0x7c, 0x26, 0x53, 0xd8, 0xef, 0x20,
+	0x81, 0x7a, 0x34, 0x64, 0x0e, 0x56, 0x75, 0xfa, 0x6d, 0xa8, 0xb8, 0x48, 0xa0, 0x01, 0x97, 0xd8,
+	0xb2, 0x4a, 0xab, 0x17, 0xcf, 0xeb, 0xa5, 0xa5, 0x71, 0xb7, 0x63, 0x97, 0xdc, 0xe5, 0xea, 0x65,
+	0x2b, 0x54, 0xc7, 0xfd, 0xb7, 0x01, 0x07, 0x36, 0x0e, 0x28, 0x13, 0xa7, 0x88, 0x09, 0xe2, 0x90,
+	0x40, 0x76, 0xf7, 0xe5, 0x1e, 0x90, 0xaf, 0xc1, 0xbe, 0xe6, 0xc6, 0x6e, 0x2a, 0x64, 0x65, 0x01,
+	0x2f, 0x4e, 0x8e, 0xc9, 0x60, 0x2b, 0x35, 0xa0, 0x41, 0x65, 0xd4, 0x87, 0x72, 0x90, 0xcc, 0xc5,
+	0xca, 0x6d, 0x74, 0x68, 0x69, 0x12, 0xbd, 0xdd, 0xbf, 0x1b, 0x70, 0x10, 0x49, 0x77, 0xca, 0xe8,
+	0x94, 0xb8, 0x98, 0xd9, 0xf2, 0x5a, 0xde, 0xae, 0xfe, 0x1d, 0xc8, 0xa3, 0x89, 0xde, 0xf4, 0xab,
+	0xbf, 0xd0, 0xd0, 0x24, 0x56, 0x2e, 0x40, 0x73, 0x1a, 0xae, 0x2a, 0xa7, 0xc1, 0xe4, 0x91, 0xce,
+	0xa1, 0xd2, 0x72, 0x04, 0x99, 0xe2, 0x96, 0x23, 0x4d, 0xb7, 0xee, 0x6e, 0xa4, 0x79, 0xd2, 0xd5,
+	0x14, 0xa3, 0xc9, 0xd0, 0xbf, 0x31, 0xa0, 0xac, 0xa3, 0xde, 0x65, 0xf4, 0x89, 0x18, 0x6f, 0xfb,
+	0xb6, 0xf9, 0xf8, 0xc9, 0x20, 0x0e, 0x14, 0xbf, 0x6d, 0x3e, 0x7e, 0xb2, 0xd8, 0xd9, 0x5b, 0x50,
+	0x11, 0x54, 0x20, 0x6f, 0x69, 0xa4, 0x74, 0x29, 0x4b, 0x34, 0x36, 0xd3, 0xd9, 0x75, 0x01, 0x34,
+	0xd2, 0x9f, 0xf1, 0x68, 0xe4, 0x4c, 0xd7, 0x75, 0xbc, 0x4c, 0xbf, 0x5f, 0x99, 0xf4, 0xfb, 0xa5,
+	0xa9, 0xfe, 0x9c, 0x85, 0x72, 0x07, 0xfb, 0x74, 0xd2, 0xf3, 0x51, 0xc0, 0xc7, 0x54, 0x4e, 0xcb,
+	0x6e, 0x04, 0x68, 0x32, 0xb5, 0x48, 0xcc, 0x30, 0x11, 0x4f, 0x76, 0x6d, 0x86, 0xc9, 0x5e, 0x7a,
+	0x86, 0xf9, 0x21, 0x94, 0xd4, 0x8e, 0x79, 0x18, 0x04, 0xde, 0x7c, 0x83, 0xe6, 0xe8, 0xfa, 0xc2,
+	0x2e, 0x4a, 0x8e, 0x9e, 0xa4, 0x30, 0x7b, 0x50, 0x16, 0x0c, 0x23, 0x1e, 0xb2, 0xf9, 0x20, 0xa0,
+	0xd4, 0xb3, 0xae, 0x6c, 0xc4, 0x59, 0x8a, 0x49, 0x4e, 0x29, 0xf5, 0xcc, 0x33, 0xa8, 0x38, 0x74,
+	0x32, 0x09, 0x7d, 0x22, 0x34, 0x6b, 0x7e, 0x23, 0xd6, 0xf2, 0x82, 0x45, 0xd2, 0x7e, 0x08, 0xfb,
+	0x13, 0xea, 0x86, 0x1e, 0x5e, 0x9e, 0xf8, 0xee, 0x46, 0xbc, 0x15, 0x45, 0x13, 0x97, 0xc8, 0xf7,
+	0x72, 0x85, 0x42, 0x75, 0xef, 0xe6, 0xcf, 0x0d, 0xa8, 0xde, 0xa3, 0x9e, 0x8b, 0x19, 0xef, 0x8f,
+	0x19, 0xe6, 0x63, 0xea, 0xb9, 0xe6, 0xc9, 0xa2, 0xc1, 0x8d, 0x8d, 0x42, 0x25, 0x7a, 0x78, 0xac,
+	0xb8, 0x53, 0xb5, 0x55, 0xd2, 0x60, 0xb2, 0xbe, 0x3e, 0x36, 0xa0, 0xaa, 0x67, 0x64, 0xe2, 0xe1,
+	0xe3, 0x50, 0xd0, 0xf3, 0x73, 0xb3, 0x06, 0x10, 0x2c, 0x30, 0x99, 0x4b, 0xd9, 0x4e, 0x20, 0x89,
+	0x3c, 0x33, 0xdb, 0xe4, 0xa9, 0x53, 0xf8, 0x4f, 0x1e, 0x4c, 0x55, 0x20, 0x1d, 0xc2, 0x05, 0x23,
+	0xc3, 0x50, 0x3e, 0x0c, 0xaf, 0x71, 0x9d, 0x9f, 0x42, 0xd1, 0x21, 0xcc, 0x09, 0x3d, 0x24, 0x88,
+	0x3f, 0xda, 0xb0, 0xca, 0x93, 0x14, 0xeb, 0x9d, 0x93, 0xff, 0x42, 0x3a, 0x67, 0xf7, 0x55, 0x74,
+	0xce, 0x10, 0xde, 0x58, 0xe9, 0x9c, 0x01, 0x1f, 0x23, 0x86, 0xad, 0xc2, 0x46, 0xcf, 0xeb, 0x41,
+	0xba, 0x7f, 0x7a, 0x11, 0x95, 0xf9, 0x10, 0x8a, 0x21, 0xc7, 0x2c, 0x66, 0xde, 0xdb, 0x88, 0x19,
+	0x24, 0x85, 0x22, 0x5c, 0x6b, 0x19, 0x58, 0x6f, 0x19, 0xb3, 0x0d, 0xb9, 0x11, 0xf1, 0x89, 0x55,
+	0xdc, 0x28, 0x9c, 0xf4, 0x35, 0x5b, 0x00, 0x22, 0x6e, 0x78, 0x6e, 0x95, 0xe4, 0x43, 0x7e, 0x23,
+	0x31, 0xcc, 0xad, 0x5e, 0x0a, 0x7a, 0x96, 0x4b, 0x38, 0x99, 0xc7, 0x50, 0x5c, 0x36, 0x23, 0xb7,
+	0xca, 0x6b, 0x1c, 0xab, 0x0d, 0xad, 0x39, 0x92, 0x5e, 0xba, 0xf7, 0x9e, 0x66, 0x60, 0x7f, 0xf1,
+	0xbf, 0xe1, 0xb3, 0x40, 0x6c, 0xfb, 0xf3, 0x4a, 0x34, 0x7b, 0x93, 0x91, 0x8f, 0xdd, 0x78, 0x0e,
+	0xd6, 0x37, 0x8f, 0x02, 0xf5, 0x4c, 0xfb, 0x26, 0x94, 0x27, 0x84, 0xf3, 0xa5, 0x91, 0x1e, 0x31,
+	0x14, 0xa8, 0x8d, 0x4e, 0x20, 0x1f, 0xca, 0x7c, 0x36, 0x9c, 0xca, 0xb4, 0x77, 0x14, 0x4c, 0x0f,
+	0xd0, 0x48, 0xce, 0x2a, 0xb2, 0x1b, 0x0b, 0x76, 0x49, 0x81, 0x6a, 0x7e, 0xd1, 0x62, 0x7c, 0x04,
+	0xa6, 0x1e, 0xa5, 0x05, 0x12, 0x21, 0x3f, 0x1e, 0x23, 0x7f, 0x84, 0x17, 0x37, 0x8b, 0xb1, 0xc9,
+	0xaf, 0x00, 0x84, 0xc7, 0x61, 0x33, 0x32, 0x6c, 0x81, 0xf0, 0x54, 0xc8, 0x8f, 0x33, 0x70, 0x6d,
+	0x45, 0xff, 0x0d, 0x7e, 0x57, 0x79, 0x9d, 0x55, 0x7f, 0xfb, 0x4f, 0x06, 0x14, 0xef, 0x32, 0xe4,
+	0x87, 0x1e, 0x62, 0x44, 0xcc, 0xcd, 0xf7, 0xe0, 0xc6, 0x5d, 0xbb, 0xf5, 0xe0, 0xec, 0x7e, 0xcb,
+	0xee, 0xf6, 0x1f, 0x0d, 0x3a, 0xad, 0x47, 0x83, 0xb3, 0x07, 0xbd, 0xd3, 0x3b, 0xc7, 0xdd, 0x93,
+	0xee, 0x9d, 0x4e, 0x75, 0xe7, 0xf0, 0xe0, 0xe9, 0xb3, 0xa3, 0xfd, 0x15, 0x13, 0xf3, 0x6d, 0xa8,
+	0x26, 0xa1, 0x7b, 0x0f, 0xcf, 0xec, 0xaa, 0x71, 0x78, 0xed, 0xe9, 0xb3, 0xa3, 0x35, 0x7c, 0xd5,
+	0xf6, 0xc3, 0x3b, 0x77, 0xbe, 0x5f, 0xcd, 0xac, 0xdb, 0x46, 0xb8, 0xf9, 0x0d, 0xb8, 0x9a, 0xc4,
+	0x7e, 0xf0, 0xf0, 0x41, 0xff, 0x5e, 0x35, 0x7b, 0xf8, 0xc6, 0xd3, 0x67, 0x47, 0xeb, 0x1f, 0x0e,
+	0x73, 0xbf, 0xf8, 0x5d, 0x6d, 0xa7, 0xfd, 0xe0, 0xd3, 0x8b, 0x9a, 0xf1, 0xd9, 0x45, 0xcd, 0xf8,
+	0xdb, 0x45, 0xcd, 0xf8, 0xe4, 0x45, 0x6d, 0xe7, 0xb3, 0x17, 0xb5, 0x9d, 0xbf, 0xbc, 0xa8, 0xed,
+	0xfc, 0xe8, 0xbd, 0x84, 0x8c, 0x77, 0x31, 0xed, 0xb4, 0x6f, 0xdf, 0x27, 0x13, 0x22, 0xb0, 0xdb,
+	0xa4, 0x2e, 0xf1, 0x6f, 0x3b, 0x94, 0xe1, 0xe6, 0xac, 0x99, 0xf8, 0x59, 0x38, 0x12, 0x76, 0x98,
+	0x97, 0xb5, 0xf6, 0xad, 0xff, 0x0e, 0x00, 0x90, 0xce, 0x37, 0x5a, 0x30, 0x16, 0x00, 0x00,
+}
+
+func (this *AverageBlockSize) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AverageBlockSize)
+	if !ok {
+		that2, ok := that.(AverageBlockSize)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Start.Equal(that1.Start) {
+		return false
+	}
+	if this.Bytes != that1.Bytes {
+		return false
+	}
+	return true
+}
+func (this *AverageBlockTime) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AverageBlockTime)
+	if !ok {
+		that2, ok := that.(AverageBlockTime)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Start.Equal(that1.Start) {
+		return false
+	}
+	if this.Seconds != that1.Seconds {
+		return false
+	}
+	return true
+}
+func (this *AverageTxFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AverageTxFee)
+	if !ok {
+		that2, ok := that.(AverageTxFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Start.Equal(that1.Start) {
+		return false
+	}
+	if len(this.Fee) != len(that1.Fee) {
+		return false
+	}
+	for i := range this.Fee {
+		if !this.Fee[i].Equal(&that1.Fee[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *TxVolume) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxVolume)
+	if !ok {
+		that2, ok := that.(TxVolume)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Start.Equal(that1.Start) {
+		return false
+	}
+	if this.Volume != that1.Volume {
+		return false
+	}
+	return true
+}
+func (this *ValidatorBlockStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorBlockStats)
+	if !ok {
+		that2, ok := that.(ValidatorBlockStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.BlocksCount != that1.BlocksCount {
+		return false
+	}
+	if !this.StakePercentage.Equal(that1.StakePercentage) {
+		return false
+	}
+	return true
+}
+func (this *ValidatorBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorBlock)
+	if !ok {
+		that2, ok := that.(ValidatorBlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if this.TxsCount != that1.TxsCount {
+		return false
+	}
+	if len(this.Reward) != len(that1.Reward) {
+		return false
+	}
+	for i := range this.Reward {
+		if !this.Reward[i].Equal(&that1.Reward[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *BlockStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockStats)
+	if !ok {
+		that2, ok := that.(BlockStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Start.Equal(that1.Start) {
+		return false
+	}
+	if this.BlocksCount != that1.BlocksCount {
+		return false
+	}
+	if this.BlocksBytes != that1.BlocksBytes {
+		return false
+	}
+	if this.BlocksInterval != that1.BlocksInterval {
+		return false
+	}
+	if this.TxsCount != that1.TxsCount {
+		return false
+	}
+	if len(this.TxsFee) != len(that1.TxsFee) {
+		return false
+	}
+	for i := range this.TxsFee {
+		if !this.TxsFee[i].Equal(&that1.TxsFee[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *OracleScriptStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleScriptStats)
+	if !ok {
+		that2, ok := that.(OracleScriptStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OracleScriptID != that1.OracleScriptID {
+		return false
+	}
+	if this.RequestsCount != that1.RequestsCount {
+		return false
+	}
+	if this.SuccessCount != that1.SuccessCount {
+		return false
+	}
+	if this.FailureCount != that1.FailureCount {
+		return false
+	}
+	if this.ExpiredCount != that1.ExpiredCount {
+		return false
+	}
+	if this.ResolveBlocks != that1.ResolveBlocks {
+		return false
+	}
+	return true
+}
+func (this *OracleRequests) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleRequests)
+	if !ok {
+		that2, ok := that.(OracleRequests)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Start.Equal(that1.Start) {
+		return false
+	}
+	if this.RequestsCount != that1.RequestsCount {
+		return false
+	}
+	if this.SuccessCount != that1.SuccessCount {
+		return false
+	}
+	if this.FailureCount != that1.FailureCount {
+		return false
+	}
+	if this.ExpiredCount != that1.ExpiredCount {
+		return false
+	}
+	if !this.AvgResolveBlocks.Equal(that1.AvgResolveBlocks) {
+		return false
+	}
+	if len(this.OracleScripts) != len(that1.OracleScripts) {
+		return false
+	}
+	for i := range this.OracleScripts {
+		if !this.OracleScripts[i].Equal(&that1.OracleScripts[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DataSourceStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataSourceStats)
+	if !ok {
+		that2, ok := that.(DataSourceStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DataSourceID != that1.DataSourceID {
+		return false
+	}
+	if this.RequestsCount != that1.RequestsCount {
+		return false
+	}
+	return true
+}
+func (this *ReportParticipation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReportParticipation)
+	if !ok {
+		that2, ok := that.(ReportParticipation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.RequestedCount != that1.RequestedCount {
+		return false
+	}
+	if this.ReportsCount != that1.ReportsCount {
+		return false
+	}
+	if !this.Participation.Equal(that1.Participation) {
+		return false
+	}
+	return true
+}
+func (this *DataProviderRewards) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataProviderRewards)
+	if !ok {
+		that2, ok := that.(DataProviderRewards)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Start.Equal(that1.Start) {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.PayoutsCount != that1.PayoutsCount {
+		return false
+	}
+	return true
+}
+func (this *ActiveAccounts) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ActiveAccounts)
+	if !ok {
+		that2, ok := that.(ActiveAccounts)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Start.Equal(that1.Start) {
+		return false
+	}
+	if this.AccountsCount != that1.AccountsCount {
+		return false
+	}
+	return true
+}
+func (this *AccountGrowth) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountGrowth)
+	if !ok {
+		that2, ok := that.(AccountGrowth)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Start.Equal(that1.Start) {
+		return false
+	}
+	if this.NewAccounts != that1.NewAccounts {
+		return false
+	}
+	if this.TotalAccounts != that1.TotalAccounts {
+		return false
+	}
+	return true
+}
+func (this *AccountTxs) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountTxs)
+	if !ok {
+		that2, ok := that.(AccountTxs)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.TxsCount != that1.TxsCount {
+		return false
+	}
+	return true
+}
+func (this *HoldersThreshold) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HoldersThreshold)
+	if !ok {
+		that2, ok := that.(HoldersThreshold)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.HoldersCount != that1.HoldersCount {
+		return false
+	}
+	return true
+}
+func (this *PercentileCutoff) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PercentileCutoff)
+	if !ok {
+		that2, ok := that.(PercentileCutoff)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Percentile != that1.Percentile {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (this *SupplyDistribution) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SupplyDistribution)
+	if !ok {
+		that2, ok := that.(SupplyDistribution)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.TotalSupply.Equal(that1.TotalSupply) {
+		return false
+	}
+	if !this.Circulating.Equal(that1.Circulating) {
+		return false
+	}
+	if !this.TreasuryPool.Equal(that1.TreasuryPool) {
+		return false
+	}
+	if !this.CommunityPool.Equal(that1.CommunityPool) {
+		return false
+	}
+	if !this.ModuleAccountsShare.Equal(that1.ModuleAccountsShare) {
+		return false
+	}
+	if !this.UsersShare.Equal(that1.UsersShare) {
+		return false
+	}
+	if this.HoldersCount != that1.HoldersCount {
+		return false
+	}
+	if !this.Gini.Equal(that1.Gini) {
+		return false
+	}
+	if len(this.Thresholds) != len(that1.Thresholds) {
+		return false
+	}
+	for i := range this.Thresholds {
+		if !this.Thresholds[i].Equal(&that1.Thresholds[i]) {
+			return false
+		}
+	}
+	if len(this.Percentiles) != len(that1.Percentiles) {
+		return false
+	}
+	for i := range this.Percentiles {
+		if !this.Percentiles[i].Equal(&that1.Percentiles[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ValidatorUptime) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorUptime)
+	if !ok {
+		that2, ok := that.(ValidatorUptime)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Start.Equal(that1.Start) {
+		return false
+	}
+	if this.SignedBlocks != that1.SignedBlocks {
+		return false
+	}
+	if this.MissedBlocks != that1.MissedBlocks {
+		return false
+	}
+	if !this.Uptime.Equal(that1.Uptime) {
+		return false
+	}
+	if this.OracleActive != that1.OracleActive {
+		return false
+	}
+	return true
+}
+func (this *OracleStatusChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleStatusChange)
+	if !ok {
+		that2, ok := that.(OracleStatusChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if this.IsActive != that1.IsActive {
+		return false
+	}
+	return true
+}
+func (this *ValidatorUptimeStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorUptimeStats)
+	if !ok {
+		that2, ok := that.(ValidatorUptimeStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.SignedBlocks != that1.SignedBlocks {
+		return false
+	}
+	if this.MissedBlocks != that1.MissedBlocks {
+		return false
+	}
+	if !this.Uptime.Equal(that1.Uptime) {
+		return false
+	}
+	if this.OracleActive != that1.OracleActive {
+		return false
+	}
+	return true
+}
+func (m *AverageBlockSize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AverageBlockSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AverageBlockSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bytes != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTelemetry(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AverageBlockTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AverageBlockTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AverageBlockTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seconds != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.Seconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTelemetry(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AverageTxFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AverageTxFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AverageTxFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTelemetry(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTelemetry(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TxVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Volume != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x10
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTelemetry(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorBlockStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBlockStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorBlockStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StakePercentage.Size()
+		i -= size
+		if _, err := m.StakePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTelemetry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BlocksCount != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.BlocksCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTelemetry(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reward) > 0 {
+		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTelemetry(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TxsCount != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.TxsCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTelemetry(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxsFee) > 0 {
+		for iNdEx := len(m.TxsFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxsFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTelemetry(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.TxsCount != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.TxsCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.BlocksInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.BlocksInterval):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTelemetry(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if m.BlocksBytes != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.BlocksBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlocksCount != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.BlocksCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTelemetry(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleScriptStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleScriptStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleScriptStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResolveBlocks != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.ResolveBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpiredCount != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.ExpiredCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FailureCount != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.FailureCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SuccessCount != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.SuccessCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RequestsCount != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.RequestsCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OracleScriptID != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.OracleScriptID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleRequests) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleRequests) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleRequests) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OracleScripts) > 0 {
+		for iNdEx := len(m.OracleScripts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleScripts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTelemetry(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.AvgResolveBlocks.Size()
+		i -= size
+		if _, err := m.AvgResolveBlocks.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTelemetry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.ExpiredCount != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.ExpiredCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FailureCount != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.FailureCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SuccessCount != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.SuccessCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RequestsCount != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.RequestsCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintTelemetry(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DataSourceStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataSourceStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataSourceStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequestsCount != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.RequestsCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DataSourceID != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.DataSourceID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReportParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportParticipation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReportParticipation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Participation.Size()
+		i -= size
+		if _, err := m.Participation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTelemetry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ReportsCount != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.ReportsCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RequestedCount != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.RequestedCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTelemetry(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataProviderRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataProviderRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataProviderRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PayoutsCount != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.PayoutsCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTelemetry(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTelemetry(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ActiveAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActiveAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActiveAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AccountsCount != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.AccountsCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintTelemetry(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountGrowth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountGrowth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountGrowth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalAccounts != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.TotalAccounts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NewAccounts != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.NewAccounts))
+		i--
+		dAtA[i] = 0x10
+	}
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTelemetry(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountTxs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountTxs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountTxs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxsCount != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.TxsCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTelemetry(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ModuleAccounts.Size()
+		i -= size
+		if _, err := m.ModuleAccounts.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTelemetry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTelemetry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TreasuryPool.Size()
+		i -= size
+		if _, err := m.TreasuryPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTelemetry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTelemetry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTelemetry(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTelemetry(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HoldersThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HoldersThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HoldersThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HoldersCount != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.HoldersCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTelemetry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PercentileCutoff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PercentileCutoff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PercentileCutoff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTelemetry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Percentile != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.Percentile))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Percentiles) > 0 {
+		for iNdEx := len(m.Percentiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Percentiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTelemetry(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Thresholds) > 0 {
+		for iNdEx := len(m.Thresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Thresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTelemetry(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size := m.Gini.Size()
+		i -= size
+		if _, err := m.Gini.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTelemetry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.HoldersCount != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.HoldersCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.UsersShare.Size()
+		i -= size
+		if _, err := m.UsersShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTelemetry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ModuleAccountsShare.Size()
+		i -= size
+		if _, err := m.ModuleAccountsShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTelemetry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTelemetry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TreasuryPool.Size()
+		i -= size
+		if _, err := m.TreasuryPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTelemetry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Circulating.Size()
+		i -= size
+		if _, err := m.Circulating.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTelemetry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTelemetry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintTelemetry(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTelemetry(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorUptime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorUptime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorUptime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OracleActive {
+		i--
+		if m.OracleActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Uptime.Size()
+		i -= size
+		if _, err := m.Uptime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTelemetry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MissedBlocks != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.MissedBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignedBlocks != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.SignedBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintTelemetry(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleStatusChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleStatusChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleStatusChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintTelemetry(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorUptimeStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorUptimeStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorUptimeStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OracleActive {
+		i--
+		if m.OracleActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Uptime.Size()
+		i -= size
+		if _, err := m.Uptime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTelemetry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MissedBlocks != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.MissedBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignedBlocks != 0 {
+		i = encodeVarintTelemetry(dAtA, i, uint64(m.SignedBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTelemetry(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTelemetry(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTelemetry(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AverageBlockSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovTelemetry(uint64(l))
+	if m.Bytes != 0 {
+		n += 1 + sovTelemetry(uint64(m.Bytes))
+	}
+	return n
+}
+
+func (m *AverageBlockTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovTelemetry(uint64(l))
+	if m.Seconds != 0 {
+		n += 1 + sovTelemetry(uint64(m.Seconds))
+	}
+	return n
+}
+
+func (m *AverageTxFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovTelemetry(uint64(l))
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovTelemetry(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovTelemetry(uint64(l))
+	if m.Volume != 0 {
+		n += 1 + sovTelemetry(uint64(m.Volume))
+	}
+	return n
+}
+
+func (m *ValidatorBlockStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTelemetry(uint64(l))
+	}
+	if m.BlocksCount != 0 {
+		n += 1 + sovTelemetry(uint64(m.BlocksCount))
+	}
+	l = m.StakePercentage.Size()
+	n += 1 + l + sovTelemetry(uint64(l))
+	return n
+}
+
+func (m *ValidatorBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTelemetry(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTelemetry(uint64(l))
+	if m.TxsCount != 0 {
+		n += 1 + sovTelemetry(uint64(m.TxsCount))
+	}
+	if len(m.Reward) > 0 {
+		for _, e := range m.Reward {
+			l = e.Size()
+			n += 1 + l + sovTelemetry(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovTelemetry(uint64(l))
+	if m.BlocksCount != 0 {
+		n += 1 + sovTelemetry(uint64(m.BlocksCount))
+	}
+	if m.BlocksBytes != 0 {
+		n += 1 + sovTelemetry(uint64(m.BlocksBytes))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.BlocksInterval)
+	n += 1 + l + sovTelemetry(uint64(l))
+	if m.TxsCount != 0 {
+		n += 1 + sovTelemetry(uint64(m.TxsCount))
+	}
+	if len(m.TxsFee) > 0 {
+		for _, e := range m.TxsFee {
+			l = e.Size()
+			n += 1 + l + sovTelemetry(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OracleScriptStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleScriptID != 0 {
+		n += 1 + sovTelemetry(uint64(m.OracleScriptID))
+	}
+	if m.RequestsCount != 0 {
+		n += 1 + sovTelemetry(uint64(m.RequestsCount))
+	}
+	if m.SuccessCount != 0 {
+		n += 1 + sovTelemetry(uint64(m.SuccessCount))
+	}
+	if m.FailureCount != 0 {
+		n += 1 + sovTelemetry(uint64(m.FailureCount))
+	}
+	if m.ExpiredCount != 0 {
+		n += 1 + sovTelemetry(uint64(m.ExpiredCount))
+	}
+	if m.ResolveBlocks != 0 {
+		n += 1 + sovTelemetry(uint64(m.ResolveBlocks))
+	}
+	return n
+}
+
+func (m *OracleRequests) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovTelemetry(uint64(l))
+	if m.RequestsCount != 0 {
+		n += 1 + sovTelemetry(uint64(m.RequestsCount))
+	}
+	if m.SuccessCount != 0 {
+		n += 1 + sovTelemetry(uint64(m.SuccessCount))
+	}
+	if m.FailureCount != 0 {
+		n += 1 + sovTelemetry(uint64(m.FailureCount))
+	}
+	if m.ExpiredCount != 0 {
+		n += 1 + sovTelemetry(uint64(m.ExpiredCount))
+	}
+	l = m.AvgResolveBlocks.Size()
+	n += 1 + l + sovTelemetry(uint64(l))
+	if len(m.OracleScripts) > 0 {
+		for _, e := range m.OracleScripts {
+			l = e.Size()
+			n += 1 + l + sovTelemetry(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DataSourceStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataSourceID != 0 {
+		n += 1 + sovTelemetry(uint64(m.DataSourceID))
+	}
+	if m.RequestsCount != 0 {
+		n += 1 + sovTelemetry(uint64(m.RequestsCount))
+	}
+	return n
+}
+
+func (m *ReportParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTelemetry(uint64(l))
+	}
+	if m.RequestedCount != 0 {
+		n += 1 + sovTelemetry(uint64(m.RequestedCount))
+	}
+	if m.ReportsCount != 0 {
+		n += 1 + sovTelemetry(uint64(m.ReportsCount))
+	}
+	l = m.Participation.Size()
+	n += 1 + l + sovTelemetry(uint64(l))
+	return n
+}
+
+func (m *DataProviderRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovTelemetry(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTelemetry(uint64(l))
+		}
+	}
+	if m.PayoutsCount != 0 {
+		n += 1 + sovTelemetry(uint64(m.PayoutsCount))
+	}
+	return n
+}
+
+func (m *ActiveAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovTelemetry(uint64(l))
+	if m.AccountsCount != 0 {
+		n += 1 + sovTelemetry(uint64(m.AccountsCount))
+	}
+	return n
+}
+
+func (m *AccountGrowth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovTelemetry(uint64(l))
+	if m.NewAccounts != 0 {
+		n += 1 + sovTelemetry(uint64(m.NewAccounts))
+	}
+	if m.TotalAccounts != 0 {
+		n += 1 + sovTelemetry(uint64(m.TotalAccounts))
+	}
+	return n
+}
+
+func (m *AccountTxs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTelemetry(uint64(l))
+	}
+	if m.TxsCount != 0 {
+		n += 1 + sovTelemetry(uint64(m.TxsCount))
+	}
+	return n
+}
+
+func (m *DenomSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTelemetry(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTelemetry(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTelemetry(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovTelemetry(uint64(l))
+	l = m.TreasuryPool.Size()
+	n += 1 + l + sovTelemetry(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovTelemetry(uint64(l))
+	l = m.ModuleAccounts.Size()
+	n += 1 + l + sovTelemetry(uint64(l))
+	return n
+}
+
+func (m *HoldersThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTelemetry(uint64(l))
+	if m.HoldersCount != 0 {
+		n += 1 + sovTelemetry(uint64(m.HoldersCount))
+	}
+	return n
+}
+
+func (m *PercentileCutoff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Percentile != 0 {
+		n += 1 + sovTelemetry(uint64(m.Percentile))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTelemetry(uint64(l))
+	return n
+}
+
+func (m *SupplyDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTelemetry(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTelemetry(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTelemetry(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovTelemetry(uint64(l))
+	l = m.Circulating.Size()
+	n += 1 + l + sovTelemetry(uint64(l))
+	l = m.TreasuryPool.Size()
+	n += 1 + l + sovTelemetry(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovTelemetry(uint64(l))
+	l = m.ModuleAccountsShare.Size()
+	n += 1 + l + sovTelemetry(uint64(l))
+	l = m.UsersShare.Size()
+	n += 1 + l + sovTelemetry(uint64(l))
+	if m.HoldersCount != 0 {
+		n += 1 + sovTelemetry(uint64(m.HoldersCount))
+	}
+	l = m.Gini.Size()
+	n += 1 + l + sovTelemetry(uint64(l))
+	if len(m.Thresholds) > 0 {
+		for _, e := range m.Thresholds {
+			l = e.Size()
+			n += 1 + l + sovTelemetry(uint64(l))
+		}
+	}
+	if len(m.Percentiles) > 0 {
+		for _, e := range m.Percentiles {
+			l = e.Size()
+			n += 1 + l + sovTelemetry(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorUptime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovTelemetry(uint64(l))
+	if m.SignedBlocks != 0 {
+		n += 1 + sovTelemetry(uint64(m.SignedBlocks))
+	}
+	if m.MissedBlocks != 0 {
+		n += 1 + sovTelemetry(uint64(m.MissedBlocks))
+	}
+	l = m.Uptime.Size()
+	n += 1 + l + sovTelemetry(uint64(l))
+	if m.OracleActive {
+		n += 2
+	}
+	return n
+}
+
+func (m *OracleStatusChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTelemetry(uint64(l))
+	if m.IsActive {
+		n += 2
+	}
+	return n
+}
+
+func (m *ValidatorUptimeStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTelemetry(uint64(l))
+	}
+	if m.SignedBlocks != 0 {
+		n += 1 + sovTelemetry(uint64(m.SignedBlocks))
+	}
+	if m.MissedBlocks != 0 {
+		n += 1 + sovTelemetry(uint64(m.MissedBlocks))
+	}
+	l = m.Uptime.Size()
+	n += 1 + l + sovTelemetry(uint64(l))
+	if m.OracleActive {
+		n += 2
+	}
+	return n
+}
+
+func sovTelemetry(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTelemetry(x uint64) (n int) {
+	return sovTelemetry(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AverageBlockSize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTelemetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AverageBlockSize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AverageBlockSize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTelemetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AverageBlockTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTelemetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AverageBlockTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AverageBlockTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seconds", wireType)
+			}
+			m.Seconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTelemetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AverageTxFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTelemetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AverageTxFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AverageTxFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTelemetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTelemetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTelemetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorBlockStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTelemetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorBlockStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorBlockStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksCount", wireType)
+			}
+			m.BlocksCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTelemetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTelemetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxsCount", wireType)
+			}
+			m.TxsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reward = append(m.Reward, types.Coin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTelemetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTelemetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksCount", wireType)
+			}
+			m.BlocksCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksBytes", wireType)
+			}
+			m.BlocksBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.BlocksInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxsCount", wireType)
+			}
+			m.TxsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxsFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxsFee = append(m.TxsFee, types.Coin{})
+			if err := m.TxsFee[len(m.TxsFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTelemetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleScriptStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTelemetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleScriptStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleScriptStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScriptID", wireType)
+			}
+			m.OracleScriptID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleScriptID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestsCount", wireType)
+			}
+			m.RequestsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCount", wireType)
+			}
+			m.SuccessCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuccessCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCount", wireType)
+			}
+			m.FailureCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredCount", wireType)
+			}
+			m.ExpiredCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiredCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveBlocks", wireType)
+			}
+			m.ResolveBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolveBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTelemetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleRequests) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTelemetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleRequests: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleRequests: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestsCount", wireType)
+			}
+			m.RequestsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCount", wireType)
+			}
+			m.SuccessCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuccessCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCount", wireType)
+			}
+			m.FailureCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredCount", wireType)
+			}
+			m.ExpiredCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiredCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgResolveBlocks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AvgResolveBlocks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleScripts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleScripts = append(m.OracleScripts, OracleScriptStats{})
+			if err := m.OracleScripts[len(m.OracleScripts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTelemetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataSourceStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTelemetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataSourceStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataSourceStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSourceID", wireType)
+			}
+			m.DataSourceID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSourceID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestsCount", wireType)
+			}
+			m.RequestsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTelemetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportParticipation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTelemetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportParticipation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedCount", wireType)
+			}
+			m.RequestedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportsCount", wireType)
+			}
+			m.ReportsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Participation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTelemetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataProviderRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTelemetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataProviderRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataProviderRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutsCount", wireType)
+			}
+			m.PayoutsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayoutsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTelemetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTelemetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountsCount", wireType)
+			}
+			m.AccountsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTelemetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountGrowth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTelemetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountGrowth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountGrowth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAccounts", wireType)
+			}
+			m.NewAccounts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewAccounts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAccounts", wireType)
+			}
+			m.TotalAccounts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalAccounts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTelemetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountTxs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTelemetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountTxs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountTxs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxsCount", wireType)
+			}
+			m.TxsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTelemetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTelemetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TreasuryPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ModuleAccounts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTelemetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HoldersThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTelemetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HoldersThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HoldersThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldersCount", wireType)
+			}
+			m.HoldersCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HoldersCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTelemetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PercentileCutoff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTelemetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PercentileCutoff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PercentileCutoff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentile", wireType)
+			}
+			m.Percentile = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percentile |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTelemetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowTelemetry
 			}
@@ -985,19 +6435,70 @@ func (m *AverageBlockSizePerDay) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AverageBlockSizePerDay: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AverageBlockSizePerDay: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1024,15 +6525,15 @@ func (m *AverageBlockSizePerDay) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Date, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
 			}
-			m.Bytes = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTelemetry
@@ -1042,64 +6543,252 @@ func (m *AverageBlockSizePerDay) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Bytes |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTelemetry(dAtA[iNdEx:])
-			if err != nil {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Circulating", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTelemetry
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AverageBlockTimePerDay) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTelemetry
+			if err := m.Circulating.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TreasuryPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccountsShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ModuleAccountsShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsersShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UsersShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldersCount", wireType)
+			}
+			m.HoldersCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HoldersCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gini", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTelemetry
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Gini.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AverageBlockTimePerDay: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AverageBlockTimePerDay: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Thresholds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1126,15 +6815,16 @@ func (m *AverageBlockTimePerDay) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Date, dAtA[iNdEx:postIndex]); err != nil {
+			m.Thresholds = append(m.Thresholds, HoldersThreshold{})
+			if err := m.Thresholds[len(m.Thresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seconds", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
 			}
-			m.Seconds = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTelemetry
@@ -1144,11 +6834,26 @@ func (m *AverageBlockTimePerDay) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Seconds |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTelemetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Percentiles = append(m.Percentiles, PercentileCutoff{})
+			if err := m.Percentiles[len(m.Percentiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTelemetry(dAtA[iNdEx:])
@@ -1170,7 +6875,7 @@ func (m *AverageBlockTimePerDay) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AverageTxFeePerDay) Unmarshal(dAtA []byte) error {
+func (m *ValidatorUptime) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1193,15 +6898,15 @@ func (m *AverageTxFeePerDay) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AverageTxFeePerDay: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorUptime: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AverageTxFeePerDay: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorUptime: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1228,15 +6933,53 @@ func (m *AverageTxFeePerDay) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Date, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocks", wireType)
+			}
+			m.SignedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			m.MissedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTelemetry
@@ -1246,26 +6989,46 @@ func (m *AverageTxFeePerDay) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTelemetry
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTelemetry
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = append(m.Fee, types.Coin{})
-			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Uptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTelemetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OracleActive = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTelemetry(dAtA[iNdEx:])
@@ -1287,7 +7050,7 @@ func (m *AverageTxFeePerDay) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxVolumePerDay) Unmarshal(dAtA []byte) error {
+func (m *OracleStatusChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1310,15 +7073,15 @@ func (m *TxVolumePerDay) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxVolumePerDay: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleStatusChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxVolumePerDay: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleStatusChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1345,15 +7108,15 @@ func (m *TxVolumePerDay) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Date, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
 			}
-			m.Volume = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTelemetry
@@ -1363,11 +7126,12 @@ func (m *TxVolumePerDay) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Volume |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsActive = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTelemetry(dAtA[iNdEx:])
@@ -1389,7 +7153,7 @@ func (m *TxVolumePerDay) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorBlockStats) Unmarshal(dAtA []byte) error {
+func (m *ValidatorUptimeStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1412,10 +7176,10 @@ func (m *ValidatorBlockStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorBlockStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorUptimeStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorBlockStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorUptimeStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1452,9 +7216,9 @@ func (m *ValidatorBlockStats) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocks", wireType)
 			}
-			m.BlocksCount = 0
+			m.SignedBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTelemetry
@@ -1464,100 +7228,16 @@ func (m *ValidatorBlockStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlocksCount |= uint64(b&0x7F) << shift
+				m.SignedBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakePercentage", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTelemetry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTelemetry
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTelemetry
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.StakePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTelemetry(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTelemetry
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorBlock) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTelemetry
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorBlock: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorBlock: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
 			}
-			m.Height = 0
+			m.MissedBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTelemetry
@@ -1567,16 +7247,16 @@ func (m *ValidatorBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.MissedBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTelemetry
@@ -1586,49 +7266,31 @@ func (m *ValidatorBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTelemetry
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTelemetry
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Uptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxsCount", wireType)
-			}
-			m.TxsCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTelemetry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxsCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleActive", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTelemetry
@@ -1638,26 +7300,12 @@ func (m *ValidatorBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTelemetry
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTelemetry
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reward = append(m.Reward, types.Coin{})
-			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.OracleActive = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTelemetry(dAtA[iNdEx:])
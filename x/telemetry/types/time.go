@@ -1,8 +1,82 @@
 package types
 
-import "time"
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DateFormat is the format of the dates accepted by the telemetry queries.
+const DateFormat = "2006-01-02"
 
 func TimeToUTCDate(t time.Time) time.Time {
 	year, month, day := t.UTC().Date()
 	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
-}
\ No newline at end of file
+}
+
+// ParseTime parses a date such as 2006-01-02 or a time in RFC3339 format such as 2006-01-02T15:04:05Z.
+func ParseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(DateFormat, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// granularities maps the names accepted by ParseGranularity to granularities.
+var granularities = map[string]Granularity{
+	"hour":  GRANULARITY_HOUR,
+	"day":   GRANULARITY_DAY,
+	"week":  GRANULARITY_WEEK,
+	"month": GRANULARITY_MONTH,
+}
+
+// ParseGranularity returns the granularity of the given name such as "hour", or the default granularity if the
+// name is empty.
+func ParseGranularity(name string) (Granularity, error) {
+	if name == "" {
+		return GRANULARITY_DAY, nil
+	}
+	granularity, ok := granularities[strings.ToLower(name)]
+	if !ok {
+		return GRANULARITY_DAY, fmt.Errorf("unknown granularity %q, expected hour, day, week or month", name)
+	}
+	return granularity, nil
+}
+
+// Validate checks that the granularity is known.
+func (g Granularity) Validate() error {
+	if _, ok := Granularity_name[int32(g)]; !ok {
+		return ErrInvalidGranularity
+	}
+	return nil
+}
+
+// Truncate returns the beginning of the time bucket containing t.
+func (g Granularity) Truncate(t time.Time) time.Time {
+	switch g {
+	case GRANULARITY_HOUR:
+		return t.UTC().Truncate(time.Hour)
+	case GRANULARITY_WEEK:
+		date := TimeToUTCDate(t)
+		return date.AddDate(0, 0, -(int(date.Weekday())+6)%7)
+	case GRANULARITY_MONTH:
+		year, month, _ := t.UTC().Date()
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return TimeToUTCDate(t)
+	}
+}
+
+// Next returns the beginning of the time bucket following the one starting at start.
+func (g Granularity) Next(start time.Time) time.Time {
+	switch g {
+	case GRANULARITY_HOUR:
+		return start.Add(time.Hour)
+	case GRANULARITY_WEEK:
+		return start.AddDate(0, 0, 7)
+	case GRANULARITY_MONTH:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
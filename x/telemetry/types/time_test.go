@@ -0,0 +1,44 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGranularityTruncate(t *testing.T) {
+	// Wednesday, December 8, 2021 in UTC.
+	tm := time.Date(2021, time.December, 8, 13, 45, 10, 0, time.FixedZone("UTC+2", 2*60*60))
+	require.Equal(t, time.Date(2021, time.December, 8, 11, 0, 0, 0, time.UTC), GRANULARITY_HOUR.Truncate(tm))
+	require.Equal(t, time.Date(2021, time.December, 8, 0, 0, 0, 0, time.UTC), GRANULARITY_DAY.Truncate(tm))
+	require.Equal(t, time.Date(2021, time.December, 6, 0, 0, 0, 0, time.UTC), GRANULARITY_WEEK.Truncate(tm))
+	require.Equal(t, time.Date(2021, time.December, 1, 0, 0, 0, 0, time.UTC), GRANULARITY_MONTH.Truncate(tm))
+
+	sunday := time.Date(2021, time.December, 12, 23, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2021, time.December, 6, 0, 0, 0, 0, time.UTC), GRANULARITY_WEEK.Truncate(sunday))
+	require.Equal(t, time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+		GRANULARITY_MONTH.Next(GRANULARITY_MONTH.Truncate(sunday)))
+}
+
+func TestParseGranularity(t *testing.T) {
+	granularity, err := ParseGranularity("")
+	require.NoError(t, err)
+	require.Equal(t, GRANULARITY_DAY, granularity)
+	granularity, err = ParseGranularity("Hour")
+	require.NoError(t, err)
+	require.Equal(t, GRANULARITY_HOUR, granularity)
+	_, err = ParseGranularity("year")
+	require.Error(t, err)
+}
+
+func TestParseTime(t *testing.T) {
+	tm, err := ParseTime("2021-12-08")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2021, time.December, 8, 0, 0, 0, 0, time.UTC), tm)
+	tm, err = ParseTime("2021-12-08T10:00:00Z")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2021, time.December, 8, 10, 0, 0, 0, time.UTC), tm)
+	_, err = ParseTime("08/12/2021")
+	require.Error(t, err)
+}
@@ -1,17 +1,20 @@
 package yoda
 
 import (
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 
 	"github.com/GeoDB-Limited/odin-core/pkg/filecache"
 	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
 	"github.com/GeoDB-Limited/odin-core/yoda/executor"
+	"github.com/GeoDB-Limited/odin-core/yoda/signer"
 )
 
 type FeeEstimationData struct {
@@ -26,17 +29,24 @@ type ReportMsgWithKey struct {
 	msg               *oracletypes.MsgReportData
 	execVersion       []string
 	keyIndex          int64
+	requestHeight     int64
 	feeEstimationData FeeEstimationData
 }
 
 type Context struct {
-	client           rpcclient.Client
+	endpoints        *endpointPool
 	validator        sdk.ValAddress
 	gasPrices        string
+	gasAdjustment    float64
+	maxGas           uint64
+	feeGranter       sdk.AccAddress
+	signer           signer.Signer
 	keys             []keyring.Info
 	executor         executor.Executor
 	fileCache        filecache.Cache
 	broadcastTimeout time.Duration
+	healthInterval   time.Duration
+	staleTimeout     time.Duration
 	maxTry           uint64
 	rpcPollInterval  time.Duration
 	maxReport        uint64
@@ -44,18 +54,38 @@ type Context struct {
 	pendingMsgs        chan ReportMsgWithKey
 	freeKeys           chan int64
 	keyRoundRobinIndex int64 // Must use in conjunction with sync/atomic
+	availableKeyCount  int64 // Must use in conjunction with sync/atomic
+	lastEventHeight    int64 // Must use in conjunction with sync/atomic
+	lastAliveTime      int64 // Unix nanoseconds the event subscription was last known to work
 
 	dataSourceCache *sync.Map
 	pendingRequests map[oracletypes.RequestID]bool
+	execCache       *execCache
 
 	metricsEnabled bool
 	handlingGauge  int64
 	pendingGauge   int64
 	errorCount     int64
 	submittedCount int64
+	execCacheHits  int64
+	execCacheMiss  int64
+	trimmedCount   int64
+	droppedMtx     sync.Mutex
+	droppedCounts  map[string]int64 // Number of dropped reports by reason
+	txFailureMtx   sync.Mutex
+	txFailures     map[txFailure]int64 // Number of failed report transactions by ABCI code
+	balanceMtx     sync.Mutex
+	keyBalances    map[string]sdk.Coins // Latest balances of reporter keys by address
+	execLatency    *prometheus.HistogramVec
+	inclusionDelay prometheus.Histogram
 	home           string
 }
 
+// rpcClient returns the RPC client of the healthiest endpoint.
+func (c *Context) rpcClient() rpcclient.Client {
+	return c.endpoints.client()
+}
+
 func (c *Context) nextKeyIndex() int64 {
 	keyIndex := atomic.AddInt64(&c.keyRoundRobinIndex, 1) % int64(len(c.keys))
 	return keyIndex
@@ -84,3 +114,57 @@ func (c *Context) updateSubmittedCount(amount int64) {
 		atomic.AddInt64(&c.submittedCount, amount)
 	}
 }
+
+func (c *Context) updateExecCacheHits(amount int64) {
+	if c.metricsEnabled {
+		atomic.AddInt64(&c.execCacheHits, amount)
+	}
+}
+
+func (c *Context) updateExecCacheMiss(amount int64) {
+	if c.metricsEnabled {
+		atomic.AddInt64(&c.execCacheMiss, amount)
+	}
+}
+
+func (c *Context) updateTrimmedCount(amount int64) {
+	if c.metricsEnabled {
+		atomic.AddInt64(&c.trimmedCount, amount)
+	}
+}
+
+func (c *Context) updateDroppedCount(reason string, amount int64) {
+	if c.metricsEnabled {
+		c.droppedMtx.Lock()
+		defer c.droppedMtx.Unlock()
+		c.droppedCounts[reason] += amount
+	}
+}
+
+func (c *Context) updateTxFailureCount(codespace string, code uint32) {
+	if c.metricsEnabled {
+		c.txFailureMtx.Lock()
+		defer c.txFailureMtx.Unlock()
+		c.txFailures[txFailure{codespace, code}]++
+	}
+}
+
+func (c *Context) updateKeyBalance(address string, balance sdk.Coins) {
+	if c.metricsEnabled {
+		c.balanceMtx.Lock()
+		defer c.balanceMtx.Unlock()
+		c.keyBalances[address] = balance
+	}
+}
+
+func (c *Context) observeExecLatency(id oracletypes.DataSourceID, duration time.Duration) {
+	if c.metricsEnabled {
+		c.execLatency.WithLabelValues(strconv.FormatUint(uint64(id), 10)).Observe(duration.Seconds())
+	}
+}
+
+func (c *Context) observeInclusionDelay(blocks int64) {
+	if c.metricsEnabled {
+		c.inclusionDelay.Observe(float64(blocks))
+	}
+}
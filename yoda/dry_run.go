@@ -0,0 +1,179 @@
+package yoda
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kyokomi/emoji"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/GeoDB-Limited/odin-core/pkg/filecache"
+	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
+	"github.com/GeoDB-Limited/odin-core/yoda/executor"
+)
+
+const (
+	flagDataSource = "data-source"
+	flagCalldata   = "calldata"
+	flagRequestID  = "request-id"
+	flagExternalID = "external-id"
+	flagKey        = "key"
+)
+
+func execCmd(c *Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "exec",
+		Aliases: []string{"e"},
+		Short:   "Execute a data source the same way as when handling a request, without reporting",
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dataSource, err := cmd.Flags().GetString(flagDataSource)
+			if err != nil {
+				return err
+			}
+			if dataSource == "" {
+				return errors.New("Data source must not be empty")
+			}
+			calldata, err := cmd.Flags().GetString(flagCalldata)
+			if err != nil {
+				return err
+			}
+			requestID, err := cmd.Flags().GetUint64(flagRequestID)
+			if err != nil {
+				return err
+			}
+			externalID, err := cmd.Flags().GetUint64(flagExternalID)
+			if err != nil {
+				return err
+			}
+			keyName, err := cmd.Flags().GetString(flagKey)
+			if err != nil {
+				return err
+			}
+
+			if cfg.Validator != "" {
+				c.validator, err = sdk.ValAddressFromBech32(cfg.Validator)
+				if err != nil {
+					return err
+				}
+			}
+			allowLevel, err := log.AllowLevel(cfg.LogLevel)
+			if err != nil {
+				return err
+			}
+			l := NewLogger(allowLevel)
+			c.executor, err = executor.NewExecutor(cfg.Executor)
+			if err != nil {
+				return err
+			}
+			c.endpoints, err = newEndpointPool(parseNodeURIs(cfg.NodeURI), cfg.MaxBlockLag, 5*time.Second)
+			if err != nil {
+				return err
+			}
+			c.fileCache = filecache.New(filepath.Join(c.home, "files"))
+			c.dataSourceCache = new(sync.Map)
+			c.maxTry = cfg.MaxTry
+			c.rpcPollInterval, err = time.ParseDuration(cfg.RPCPollInterval)
+			if err != nil {
+				return err
+			}
+
+			exec, err := loadDataSource(c, l, dataSource)
+			if err != nil {
+				return err
+			}
+
+			closeSigner, err := setupSigner(c)
+			if err != nil {
+				return err
+			}
+			defer closeSigner()
+			key, err := dryRunKey(c, keyName)
+			if err != nil {
+				return err
+			}
+			vmsg := oracletypes.NewRequestVerification(
+				cfg.ChainID, c.validator, oracletypes.RequestID(requestID), oracletypes.ExternalID(externalID),
+			)
+			sig, pubkey, err := c.signer.Sign(key, vmsg.GetSignBytes())
+			if err != nil {
+				return err
+			}
+
+			start := time.Now()
+			result, err := c.executor.Exec(exec, calldata, newExecEnv(vmsg, pubkey, sig))
+			if err != nil {
+				return err
+			}
+			elapsed := time.Since(start)
+
+			rawReport := oracletypes.NewRawReport(oracletypes.ExternalID(externalID), result.Code, result.Output)
+			emoji.Printf(":page_facing_up: Output: %q\n", result.Output)
+			emoji.Printf(":checkered_flag: Exit code: %d\n", result.Code)
+			emoji.Printf(":label: Version: %s\n", result.Version)
+			emoji.Printf(":stopwatch: Elapsed: %s\n", elapsed)
+
+			maxDataSize, err := queryMaxDataSize(c, l)
+			if err != nil {
+				emoji.Printf(":warning: Raw report data size: %d bytes (cannot get max data size: %s)\n", len(rawReport.Data), err.Error())
+				return nil
+			}
+			status := ":white_check_mark:"
+			if uint64(len(rawReport.Data)) > maxDataSize {
+				status = ":x:"
+			}
+			emoji.Printf("%s Raw report data size: %d/%d bytes\n", status, len(rawReport.Data), maxDataSize)
+			return nil
+		},
+	}
+	cmd.Flags().String(flagDataSource, "", "data source ID on chain or path to a local executable file")
+	cmd.Flags().String(flagCalldata, "", "calldata passed to the data source")
+	cmd.Flags().Uint64(flagRequestID, 0, "request ID passed to the data source")
+	cmd.Flags().Uint64(flagExternalID, 0, "external ID passed to the data source")
+	cmd.Flags().String(flagKey, "", "name of the key used to sign the request verification, first key if empty")
+	return cmd
+}
+
+// loadDataSource returns the executable of the given data source. An unsigned integer is treated as a
+// data source ID and is fetched through the file cache or the chain, anything else is a path to a local file.
+func loadDataSource(c *Context, l *Logger, dataSource string) ([]byte, error) {
+	id, err := strconv.ParseUint(dataSource, 10, 64)
+	if err != nil {
+		return ioutil.ReadFile(dataSource)
+	}
+	hash, err := GetDataSourceHash(c, l, oracletypes.DataSourceID(id))
+	if err != nil {
+		return nil, err
+	}
+	if hash == "" {
+		return nil, fmt.Errorf("Data source %d not found", id)
+	}
+	return GetExecutable(c, l, hash)
+}
+
+// dryRunKey returns the given key name if the signer has it, or the name of the first key of the signer.
+func dryRunKey(c *Context, name string) (string, error) {
+	keys, err := c.signer.List()
+	if err != nil {
+		return "", err
+	}
+	if len(keys) == 0 {
+		return "", errors.New("No key available")
+	}
+	if name == "" {
+		return keys[0].GetName(), nil
+	}
+	for _, key := range keys {
+		if key.GetName() == name {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("Key %s not found", name)
+}
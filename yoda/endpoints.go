@@ -0,0 +1,265 @@
+package yoda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	httpclient "github.com/tendermint/tendermint/rpc/client/http"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	jsonrpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
+
+	app "github.com/GeoDB-Limited/odin-core/app"
+)
+
+// rpcEndpoint is a single OdinChain RPC node that yoda can query, subscribe and broadcast to.
+type rpcEndpoint struct {
+	uri    string
+	client *httpclient.HTTP
+
+	// Health-check state, guarded by endpointPool.mtx.
+	height  int64
+	healthy bool
+
+	// Must use in conjunction with sync/atomic.
+	broadcastCount      int64
+	broadcastErrorCount int64
+	healthCheckFailures int64
+}
+
+// endpointPool keeps track of the health of all configured RPC endpoints. An endpoint is healthy when it
+// responds to status queries, is not catching up and its block height lags behind the highest seen height
+// by at most maxLag blocks.
+type endpointPool struct {
+	endpoints  []*rpcEndpoint
+	maxLag     int64
+	timeout    time.Duration
+	subscribed *rpcEndpoint // The endpoint currently used for the event subscription.
+	mtx        sync.RWMutex
+}
+
+// parseNodeURIs splits the comma-separated list of RPC node URIs.
+func parseNodeURIs(nodes string) []string {
+	var uris []string
+	for _, uri := range strings.Split(nodes, ",") {
+		uri = strings.TrimSpace(uri)
+		if uri != "" {
+			uris = append(uris, uri)
+		}
+	}
+	return uris
+}
+
+// newEndpointPool creates RPC clients for all given URIs, whose calls time out after the given timeout. All endpoints
+// are considered healthy until checked.
+func newEndpointPool(uris []string, maxLag int64, timeout time.Duration) (*endpointPool, error) {
+	if len(uris) == 0 {
+		return nil, errors.New("No RPC node URI provided")
+	}
+	pool := &endpointPool{maxLag: maxLag, timeout: timeout}
+	for _, uri := range uris {
+		httpClient, err := jsonrpcclient.DefaultHTTPClient(uri)
+		if err != nil {
+			return nil, err
+		}
+		httpClient.Timeout = timeout
+		c, err := httpclient.NewWithClient(uri, "/websocket", httpClient)
+		if err != nil {
+			return nil, err
+		}
+		pool.endpoints = append(pool.endpoints, &rpcEndpoint{uri: uri, client: c, healthy: true})
+	}
+	return pool, nil
+}
+
+// checkHealth queries status of all endpoints concurrently and updates their health.
+func (p *endpointPool) checkHealth(l *Logger) {
+	heights := make([]int64, len(p.endpoints))
+	errs := make([]error, len(p.endpoints))
+	var wg sync.WaitGroup
+	for idx, ep := range p.endpoints {
+		wg.Add(1)
+		go func(idx int, ep *rpcEndpoint) {
+			defer wg.Done()
+			ctx, cxl := context.WithTimeout(context.Background(), p.timeout)
+			defer cxl()
+			status, err := ep.client.Status(ctx)
+			if err != nil {
+				errs[idx] = err
+				return
+			}
+			if status.SyncInfo.CatchingUp {
+				errs[idx] = errors.New("node is catching up")
+				return
+			}
+			heights[idx] = status.SyncInfo.LatestBlockHeight
+		}(idx, ep)
+	}
+	wg.Wait()
+
+	maxHeight := int64(0)
+	for idx := range p.endpoints {
+		if errs[idx] == nil && heights[idx] > maxHeight {
+			maxHeight = heights[idx]
+		}
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for idx, ep := range p.endpoints {
+		wasHealthy := ep.healthy
+		if errs[idx] != nil {
+			atomic.AddInt64(&ep.healthCheckFailures, 1)
+			ep.healthy = false
+			if wasHealthy {
+				l.Info(":warning: RPC endpoint %s is unhealthy: %s", ep.uri, errs[idx].Error())
+			}
+			continue
+		}
+		ep.height = heights[idx]
+		ep.healthy = maxHeight-ep.height <= p.maxLag
+		if wasHealthy && !ep.healthy {
+			l.Info(":warning: RPC endpoint %s is lagging at height %d (max height %d)", ep.uri, ep.height, maxHeight)
+		} else if !wasHealthy && ep.healthy {
+			l.Info(":green_heart: RPC endpoint %s is healthy again at height %d", ep.uri, ep.height)
+		}
+	}
+}
+
+// healthCheckLoop checks health of all endpoints every interval. It never returns.
+func (p *endpointPool) healthCheckLoop(l *Logger, interval time.Duration) {
+	for range time.Tick(interval) {
+		p.checkHealth(l)
+	}
+}
+
+// healthyEndpoints returns all healthy endpoints, highest block height first.
+func (p *endpointPool) healthyEndpoints() []*rpcEndpoint {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	var eps []*rpcEndpoint
+	for _, ep := range p.endpoints {
+		if ep.healthy {
+			eps = append(eps, ep)
+		}
+	}
+	sort.SliceStable(eps, func(i, j int) bool { return eps[i].height > eps[j].height })
+	return eps
+}
+
+// isHealthy returns whether the given endpoint passed the latest health check.
+func (p *endpointPool) isHealthy(ep *rpcEndpoint) bool {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	return ep.healthy
+}
+
+// best returns the healthiest endpoint, or the first configured one if none is healthy.
+func (p *endpointPool) best() *rpcEndpoint {
+	if eps := p.healthyEndpoints(); len(eps) > 0 {
+		return eps[0]
+	}
+	return p.endpoints[0]
+}
+
+// subscribe starts the websocket client of the healthiest endpoint and subscribes to the given queries on it,
+// returning one event channel per query.
+func (p *endpointPool) subscribe(l *Logger, queries ...string) (*rpcEndpoint, []<-chan ctypes.ResultEvent, error) {
+	ep := p.best()
+	l.Info(":rocket: Starting WebSocket subscriber on %s", ep.uri)
+	if !ep.client.IsRunning() {
+		if err := ep.client.Start(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	ctx, cxl := context.WithTimeout(context.Background(), p.timeout)
+	defer cxl()
+
+	eventChans := make([]<-chan ctypes.ResultEvent, len(queries))
+	for idx, query := range queries {
+		l.Info(":ear: Subscribing to events with query: %s...", query)
+		eventChan, err := ep.client.Subscribe(ctx, "", query, EventChannelCapacity)
+		if err != nil {
+			return nil, nil, err
+		}
+		eventChans[idx] = eventChan
+	}
+
+	p.mtx.Lock()
+	p.subscribed = ep
+	p.mtx.Unlock()
+	return ep, eventChans, nil
+}
+
+// unsubscribe removes all subscriptions from the given endpoint. Its websocket client is kept running so that
+// the endpoint can be subscribed to again later.
+func (p *endpointPool) unsubscribe(l *Logger, ep *rpcEndpoint) {
+	ctx, cxl := context.WithTimeout(context.Background(), p.timeout)
+	defer cxl()
+	if err := ep.client.UnsubscribeAll(ctx, ""); err != nil {
+		l.Debug(":warning: Failed to unsubscribe from %s: %s", ep.uri, err.Error())
+	}
+
+	p.mtx.Lock()
+	if p.subscribed == ep {
+		p.subscribed = nil
+	}
+	p.mtx.Unlock()
+}
+
+// client returns the RPC client of the healthiest endpoint for queries.
+func (p *endpointPool) client() rpcclient.Client {
+	return p.best().client
+}
+
+// broadcast sends the transaction to all healthy endpoints (or all endpoints if none is healthy) in parallel.
+// It returns the transaction hash if at least one endpoint accepted the transaction.
+func (p *endpointPool) broadcast(l *Logger, txBytes []byte) (string, error) {
+	eps := p.healthyEndpoints()
+	if len(eps) == 0 {
+		eps = p.endpoints
+	}
+
+	type broadcastResult struct {
+		hash string
+		err  error
+	}
+	results := make(chan broadcastResult, len(eps))
+	for _, ep := range eps {
+		go func(ep *rpcEndpoint) {
+			clientCtx := client.Context{
+				Client:            ep.client,
+				TxConfig:          app.MakeEncodingConfig().TxConfig,
+				BroadcastMode:     "async",
+				InterfaceRegistry: app.MakeEncodingConfig().InterfaceRegistry,
+			}
+			atomic.AddInt64(&ep.broadcastCount, 1)
+			res, err := clientCtx.BroadcastTx(txBytes)
+			if err != nil {
+				atomic.AddInt64(&ep.broadcastErrorCount, 1)
+				l.Debug(":warning: Failed to broadcast to %s: %s", ep.uri, err.Error())
+				results <- broadcastResult{err: fmt.Errorf("%s: %w", ep.uri, err)}
+				return
+			}
+			results <- broadcastResult{hash: res.TxHash}
+		}(ep)
+	}
+
+	var errs []string
+	for range eps {
+		res := <-results
+		if res.err == nil {
+			return res.hash, nil
+		}
+		errs = append(errs, res.err.Error())
+	}
+	return "", fmt.Errorf("Failed to broadcast to all endpoints: %s", strings.Join(errs, ", "))
+}
@@ -0,0 +1,75 @@
+package yoda
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func createStatusServer(height int64, catchingUp bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		var rpcReq struct {
+			ID json.RawMessage `json:"id"`
+		}
+		json.NewDecoder(req.Body).Decode(&rpcReq)
+		res.WriteHeader(200)
+		fmt.Fprintf(
+			res,
+			`{"jsonrpc":"2.0","id":%s,"result":{"sync_info":{"latest_block_height":"%d","catching_up":%t}}}`,
+			rpcReq.ID, height, catchingUp,
+		)
+	}))
+}
+
+func TestParseNodeURIs(t *testing.T) {
+	require.Equal(t, []string{"tcp://a:26657", "tcp://b:26657"}, parseNodeURIs(" tcp://a:26657,,tcp://b:26657 "))
+	require.Nil(t, parseNodeURIs(""))
+}
+
+func TestEndpointPoolHealthCheck(t *testing.T) {
+	lagging := createStatusServer(90, false)
+	defer lagging.Close()
+	best := createStatusServer(100, false)
+	defer best.Close()
+	syncing := createStatusServer(200, true)
+	defer syncing.Close()
+	dead := createStatusServer(0, false)
+	dead.Close()
+
+	pool, err := newEndpointPool([]string{lagging.URL, best.URL, syncing.URL, dead.URL}, 5, time.Second)
+	require.NoError(t, err)
+	// Before the first check every endpoint is considered healthy.
+	require.Len(t, pool.healthyEndpoints(), 4)
+
+	pool.checkHealth(NewLogger(log.AllowNone()))
+	healthy := pool.healthyEndpoints()
+	require.Len(t, healthy, 1)
+	require.Equal(t, best.URL, healthy[0].uri)
+	require.Equal(t, int64(100), healthy[0].height)
+	require.Equal(t, best.URL, pool.best().uri)
+	require.False(t, pool.isHealthy(pool.endpoints[0]))
+	require.Equal(t, int64(1), pool.endpoints[3].healthCheckFailures)
+}
+
+func TestEndpointPoolNoHealthy(t *testing.T) {
+	dead := createStatusServer(0, false)
+	dead.Close()
+
+	pool, err := newEndpointPool([]string{dead.URL}, 5, time.Second)
+	require.NoError(t, err)
+	pool.checkHealth(NewLogger(log.AllowNone()))
+	require.Empty(t, pool.healthyEndpoints())
+	// Fall back to the first endpoint.
+	require.Equal(t, dead.URL, pool.best().uri)
+}
+
+func TestNewEndpointPoolEmpty(t *testing.T) {
+	_, err := newEndpointPool(nil, 5, time.Second)
+	require.Error(t, err)
+}
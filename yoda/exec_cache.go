@@ -0,0 +1,112 @@
+package yoda
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
+	"github.com/GeoDB-Limited/odin-core/yoda/executor"
+)
+
+// execCacheKey identifies data source executions that are expected to produce the same result.
+type execCacheKey struct {
+	dataSourceHash string
+	calldata       string
+}
+
+// execCacheEntry holds the result of one execution. The done channel is closed once result and err are set.
+type execCacheEntry struct {
+	done   chan struct{}
+	result executor.ExecResult
+	err    error
+	expiry time.Time
+}
+
+// execCache is a short-TTL cache of data source execution results that also coalesces concurrent identical
+// executions into one. Failed executions are shared with the callers waiting on them but are never cached.
+type execCache struct {
+	ttl     time.Duration
+	skip    map[oracletypes.DataSourceID]bool // Data sources that must always be executed (e.g. non-idempotent ones).
+	mtx     sync.Mutex
+	entries map[execCacheKey]*execCacheEntry
+}
+
+// newExecCache creates a new execCache instance. Returns nil if the given TTL is not positive.
+func newExecCache(ttl time.Duration, skip map[oracletypes.DataSourceID]bool) *execCache {
+	if ttl <= 0 {
+		return nil
+	}
+	return &execCache{
+		ttl:     ttl,
+		skip:    skip,
+		entries: make(map[execCacheKey]*execCacheEntry),
+	}
+}
+
+// enabled returns whether results of the given data source can be shared between requests.
+func (c *execCache) enabled(id oracletypes.DataSourceID) bool {
+	return c != nil && !c.skip[id]
+}
+
+// exec returns the cached result for the given key, waiting for an in-flight execution if there is one,
+// or runs fn and stores its result. The returned flag tells whether fn was NOT run by this call.
+func (c *execCache) exec(key execCacheKey, fn func() (executor.ExecResult, error)) (executor.ExecResult, bool, error) {
+	c.mtx.Lock()
+	now := time.Now()
+	if entry, ok := c.entries[key]; ok && !c.isExpired(entry, now) {
+		c.mtx.Unlock()
+		<-entry.done
+		return entry.result, true, entry.err
+	}
+	c.removeExpired(now)
+	entry := &execCacheEntry{done: make(chan struct{})}
+	c.entries[key] = entry
+	c.mtx.Unlock()
+
+	entry.result, entry.err = fn()
+
+	c.mtx.Lock()
+	if entry.err != nil {
+		delete(c.entries, key)
+	} else {
+		entry.expiry = time.Now().Add(c.ttl)
+	}
+	c.mtx.Unlock()
+	close(entry.done)
+
+	return entry.result, false, entry.err
+}
+
+// isExpired checks whether the entry is finished and outlived its TTL. Must be called with mtx held.
+func (c *execCache) isExpired(entry *execCacheEntry, now time.Time) bool {
+	return !entry.expiry.IsZero() && now.After(entry.expiry)
+}
+
+// removeExpired drops all expired entries from the cache. Must be called with mtx held.
+func (c *execCache) removeExpired(now time.Time) {
+	for key, entry := range c.entries {
+		if c.isExpired(entry, now) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// parseDataSourceIDs parses a comma-separated list of data source IDs into a set.
+func parseDataSourceIDs(list string) (map[oracletypes.DataSourceID]bool, error) {
+	ids := make(map[oracletypes.DataSourceID]bool)
+	for _, raw := range strings.Split(list, ",") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid data source id: %s", raw)
+		}
+		ids[oracletypes.DataSourceID(id)] = true
+	}
+	return ids, nil
+}
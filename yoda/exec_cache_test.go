@@ -0,0 +1,112 @@
+package yoda
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
+	"github.com/GeoDB-Limited/odin-core/yoda/executor"
+)
+
+func TestExecCacheDisabled(t *testing.T) {
+	cache := newExecCache(0, nil)
+	require.Nil(t, cache)
+	require.False(t, cache.enabled(1))
+}
+
+func TestExecCacheSkip(t *testing.T) {
+	cache := newExecCache(time.Minute, map[oracletypes.DataSourceID]bool{2: true})
+	require.True(t, cache.enabled(1))
+	require.False(t, cache.enabled(2))
+}
+
+func TestExecCacheHitAndExpire(t *testing.T) {
+	cache := newExecCache(50*time.Millisecond, nil)
+	called := 0
+	fn := func() (executor.ExecResult, error) {
+		called++
+		return executor.ExecResult{Output: []byte("beeb"), Code: 0}, nil
+	}
+	key := execCacheKey{"hash", "calldata"}
+
+	res, cached, err := cache.exec(key, fn)
+	require.NoError(t, err)
+	require.False(t, cached)
+	require.Equal(t, []byte("beeb"), res.Output)
+	// Second call within TTL should not run the script again.
+	res, cached, err = cache.exec(key, fn)
+	require.NoError(t, err)
+	require.True(t, cached)
+	require.Equal(t, []byte("beeb"), res.Output)
+	require.Equal(t, 1, called)
+	// Different calldata is a different key.
+	_, cached, _ = cache.exec(execCacheKey{"hash", "other"}, fn)
+	require.False(t, cached)
+	require.Equal(t, 2, called)
+	// After TTL the script is executed again.
+	time.Sleep(100 * time.Millisecond)
+	_, cached, _ = cache.exec(key, fn)
+	require.False(t, cached)
+	require.Equal(t, 3, called)
+}
+
+func TestExecCacheErrorNotCached(t *testing.T) {
+	cache := newExecCache(time.Minute, nil)
+	called := 0
+	fn := func() (executor.ExecResult, error) {
+		called++
+		return executor.ExecResult{}, errors.New("boom")
+	}
+	key := execCacheKey{"hash", "calldata"}
+	_, _, err := cache.exec(key, fn)
+	require.Error(t, err)
+	_, cached, err := cache.exec(key, fn)
+	require.Error(t, err)
+	require.False(t, cached)
+	require.Equal(t, 2, called)
+}
+
+func TestExecCacheCoalesce(t *testing.T) {
+	cache := newExecCache(time.Minute, nil)
+	var called int64
+	release := make(chan struct{})
+	fn := func() (executor.ExecResult, error) {
+		atomic.AddInt64(&called, 1)
+		<-release
+		return executor.ExecResult{Output: []byte("beeb")}, nil
+	}
+	key := execCacheKey{"hash", "calldata"}
+
+	var wg sync.WaitGroup
+	var hits int64
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, cached, err := cache.exec(key, fn)
+			require.NoError(t, err)
+			require.Equal(t, []byte("beeb"), res.Output)
+			if cached {
+				atomic.AddInt64(&hits, 1)
+			}
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	require.Equal(t, int64(1), atomic.LoadInt64(&called))
+	require.Equal(t, int64(9), atomic.LoadInt64(&hits))
+}
+
+func TestParseDataSourceIDs(t *testing.T) {
+	ids, err := parseDataSourceIDs("1, 3,,7")
+	require.NoError(t, err)
+	require.Equal(t, map[oracletypes.DataSourceID]bool{1: true, 3: true, 7: true}, ids)
+	_, err = parseDataSourceIDs("1,abc")
+	require.Error(t, err)
+}
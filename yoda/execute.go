@@ -11,7 +11,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/version"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
@@ -26,20 +28,48 @@ var (
 )
 
 func signAndBroadcast(
-	c *Context, key keyring.Info, msgs []sdk.Msg, gasLimit uint64, memo string,
+	c *Context, l *Logger, key keyring.Info, msgs []sdk.Msg, gasLimit uint64, memo string,
 ) (string, error) {
 	clientCtx := client.Context{
-		Client:            c.client,
+		Client:            c.rpcClient(),
 		TxConfig:          app.MakeEncodingConfig().TxConfig,
-		BroadcastMode:     "async",
 		InterfaceRegistry: app.MakeEncodingConfig().InterfaceRegistry,
 	}
+	txf, err := newTxFactory(c, clientCtx, key, gasLimit, memo)
+	if err != nil {
+		return "", err
+	}
+
+	txb, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return "", err
+	}
+	if !c.feeGranter.Empty() {
+		txb.SetFeeGranter(c.feeGranter)
+	}
+
+	err = signTx(c, clientCtx.TxConfig, txf, key, txb)
+	if err != nil {
+		return "", err
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txb.GetTx())
+	if err != nil {
+		return "", err
+	}
+
+	// broadcast to Tendermint nodes
+	return c.endpoints.broadcast(l, txBytes)
+}
+
+// newTxFactory creates a transaction factory for the given key using its current account number and sequence.
+func newTxFactory(c *Context, clientCtx client.Context, key keyring.Info, gasLimit uint64, memo string) (tx.Factory, error) {
 	acc, err := queryAccount(clientCtx, key)
 	if err != nil {
-		return "", fmt.Errorf("unable to get account: %w", err)
+		return tx.Factory{}, fmt.Errorf("unable to get account: %w", err)
 	}
 
-	txf := tx.Factory{}.
+	return tx.Factory{}.
 		WithAccountNumber(acc.GetAccountNumber()).
 		WithSequence(acc.GetSequence()).
 		WithTxConfig(app.MakeEncodingConfig().TxConfig).
@@ -47,34 +77,37 @@ func signAndBroadcast(
 		WithChainID(cfg.ChainID).
 		WithMemo(memo).
 		WithGasPrices(c.gasPrices).
-		WithKeybase(kb).
-		WithAccountRetriever(clientCtx.AccountRetriever)
+		WithAccountRetriever(clientCtx.AccountRetriever), nil
+}
 
-	txb, err := tx.BuildUnsignedTx(txf, msgs...)
-	if err != nil {
-		return "", err
+// signTx signs the transaction with the given key through the context's signer. It follows tx.Sign, which only
+// supports signing with a local keyring.
+func signTx(c *Context, txConfig client.TxConfig, txf tx.Factory, key keyring.Info, txb client.TxBuilder) error {
+	signMode := txConfig.SignModeHandler().DefaultMode()
+	signerData := authsigning.SignerData{
+		ChainID:       txf.ChainID(),
+		AccountNumber: txf.AccountNumber(),
+		Sequence:      txf.Sequence(),
 	}
 
-	err = tx.Sign(txf, key.GetName(), txb, true)
-	if err != nil {
-		return "", err
+	// Signer infos must be set before generating SIGN_MODE_DIRECT sign bytes.
+	sigData := signing.SingleSignatureData{SignMode: signMode}
+	sig := signing.SignatureV2{PubKey: key.GetPubKey(), Data: &sigData, Sequence: txf.Sequence()}
+	if err := txb.SetSignatures(sig); err != nil {
+		return err
 	}
 
-	txBytes, err := clientCtx.TxConfig.TxEncoder()(txb.GetTx())
+	bytesToSign, err := txConfig.SignModeHandler().GetSignBytes(signMode, signerData, txb.GetTx())
 	if err != nil {
-		return "", err
+		return err
 	}
-
-	// broadcast to a Tendermint node
-	res, err := clientCtx.BroadcastTx(txBytes)
+	sigBytes, _, err := c.signer.Sign(key.GetName(), bytesToSign)
 	if err != nil {
-		return "", err
+		return err
 	}
-	// out, err := txBldr.WithKeybase(keybase).BuildAndSign(key.GetName(), ckeys.DefaultKeyPass, msgs)
-	// if err != nil {
-	// 	return "", fmt.Errorf("Failed to build tx with error: %s", err.Error())
-	// }
-	return res.TxHash, nil
+
+	sigData.Signature = sigBytes
+	return txb.SetSignatures(sig)
 }
 
 func queryAccount(clientCtx client.Context, key keyring.Info) (client.Account, error) {
@@ -94,6 +127,11 @@ func SubmitReport(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWith
 	}()
 	defer c.updatePendingGauge(int64(-len(reports)))
 
+	reports = checkReports(c, l, keyIndex, reports)
+	if len(reports) == 0 {
+		return
+	}
+
 	// Summarize execute version
 	versionMap := make(map[string]bool)
 	msgs := make([]sdk.Msg, len(reports))
@@ -120,21 +158,20 @@ func SubmitReport(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWith
 	}
 	memo := fmt.Sprintf("yoda:%s/exec:%s", version.Version, strings.Join(versions, ","))
 	key := c.keys[keyIndex]
-	// cliCtx := sdkCtx.CLIContext{Client: c.client, TrustNode: true, Codec: cdc}
 	clientCtx := client.Context{
-		Client:            c.client,
+		Client:            c.rpcClient(),
 		TxConfig:          app.MakeEncodingConfig().TxConfig,
 		InterfaceRegistry: app.MakeEncodingConfig().InterfaceRegistry,
 	}
 
-	gasLimit := estimateGas(c, l, msgs, feeEstimations)
+	gasLimit := estimateGas(c, l, key, msgs, feeEstimations, memo)
 	// We want to resend transaction only if tx returns Out of gas error.
 	for sendAttempt := uint64(1); sendAttempt <= c.maxTry; sendAttempt++ {
 		var txHash string
 		l.Info(":e-mail: Sending report transaction attempt: (%d/%d)", sendAttempt, c.maxTry)
 		for broadcastTry := uint64(1); broadcastTry <= c.maxTry; broadcastTry++ {
 			l.Info(":writing_hand: Try to sign and broadcast report transaction(%d/%d)", broadcastTry, c.maxTry)
-			hash, err := signAndBroadcast(c, key, msgs, gasLimit, memo)
+			hash, err := signAndBroadcast(c, l, key, msgs, gasLimit, memo)
 			if err != nil {
 				// Use info level because this error can happen and retry process can solve this error.
 				l.Info(":warning: %s", err.Error())
@@ -162,16 +199,23 @@ func SubmitReport(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWith
 			if txRes.Code == 0 {
 				l.Info(":smiling_face_with_sunglasses: Successfully broadcast tx with hash: %s", txHash)
 				c.updateSubmittedCount(int64(len(reports)))
+				for _, report := range reports {
+					if report.requestHeight > 0 {
+						c.observeInclusionDelay(txRes.Height - report.requestHeight)
+					}
+				}
 				return
 			}
 			if txRes.Codespace == sdkerrors.RootCodespace &&
 				txRes.Code == sdkerrors.ErrOutOfGas.ABCICode() {
+				c.updateTxFailureCount(txRes.Codespace, txRes.Code)
 				// Increase gas limit and try to broadcast again
-				gasLimit = gasLimit * 110 / 100
+				gasLimit = c.capGas(gasLimit * 110 / 100)
 				l.Info(":fuel_pump: Tx(%s) is out of gas and will be rebroadcasted with %d gas", txHash, gasLimit)
 				txFound = true
 				break FindTx
 			} else {
+				c.updateTxFailureCount(txRes.Codespace, txRes.Code)
 				l.Error(":exploding_head: Tx returned nonzero code %d with log %s, tx hash: %s", c, txRes.Code, txRes.RawLog, txRes.TxHash)
 				return
 			}
@@ -232,17 +276,37 @@ func GetRequest(c *Context, l *Logger, id oracletypes.RequestID) (oracletypes.Re
 		return oracletypes.Request{}, err
 	}
 
+	if len(res.Response.Value) == 0 {
+		return oracletypes.Request{}, sdkerrors.Wrapf(oracletypes.ErrRequestNotFound, "id: %d", id)
+	}
+
 	var r oracletypes.Request
 	cdc.MustUnmarshal(res.Response.Value, &r)
 
 	return r, nil
 }
 
+// queryMaxDataSize fetches the maximum raw report data size from oracle params.
+func queryMaxDataSize(c *Context, l *Logger) (uint64, error) {
+	res, err := abciQuery(c, l, "/oracle.v1.Query/Params", cdc.MustMarshal(&oracletypes.QueryParamsRequest{}))
+	if err != nil {
+		return 0, err
+	}
+	if res.Response.Code != 0 {
+		return 0, fmt.Errorf("query returned nonzero code %d: %s", res.Response.Code, res.Response.Log)
+	}
+	var params oracletypes.QueryParamsResponse
+	if err := cdc.Unmarshal(res.Response.Value, &params); err != nil {
+		return 0, err
+	}
+	return params.Params.MaxDataSize, nil
+}
+
 // abciQuery will try to query data from BandChain node maxTry time before give up and return error
 func abciQuery(c *Context, l *Logger, path string, data []byte) (*ctypes.ResultABCIQuery, error) {
 	var lastErr error
 	for try := 0; try < int(c.maxTry); try++ {
-		res, err := c.client.ABCIQuery(context.Background(), path, data)
+		res, err := c.rpcClient().ABCIQuery(context.Background(), path, data)
 		if err != nil {
 			l.Debug(":skull: Failed to query on %s request with error: %s", path, err.Error())
 			lastErr = err
@@ -1,8 +1,12 @@
 package yoda
 
 import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	app "github.com/GeoDB-Limited/odin-core/app"
 	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
 )
 
@@ -119,7 +123,8 @@ func estimateAuthAnteHandlerGas(c *Context, msgs []sdk.Msg) uint64 {
 	return gas
 }
 
-func estimateGas(c *Context, l *Logger, msgs []sdk.Msg, feeEstimations []FeeEstimationData) uint64 {
+// estimateStaticGas estimates gas of the report transaction from the hard-coded store access costs above.
+func estimateStaticGas(c *Context, msgs []sdk.Msg, feeEstimations []FeeEstimationData) uint64 {
 	gas := estimateAuthAnteHandlerGas(c, msgs)
 
 	for i, msg := range msgs {
@@ -130,7 +135,68 @@ func estimateGas(c *Context, l *Logger, msgs []sdk.Msg, feeEstimations []FeeEsti
 		gas += estimateReportHandlerGas(msg, feeEstimations[i])
 	}
 
-	l.Debug(":fuel_pump: Estimated gas is %d", gas)
+	return gas
+}
+
+// gasSimulator simulates the report transaction on the node and returns the gas it used.
+type gasSimulator func() (uint64, error)
+
+// simulateGas simulates the report transaction on the node and returns the gas it used.
+func simulateGas(c *Context, key keyring.Info, msgs []sdk.Msg, memo string) (uint64, error) {
+	clientCtx := client.Context{
+		Client:            c.rpcClient(),
+		TxConfig:          app.MakeEncodingConfig().TxConfig,
+		InterfaceRegistry: app.MakeEncodingConfig().InterfaceRegistry,
+	}
+	txf, err := newTxFactory(c, clientCtx, key, 0, memo)
+	if err != nil {
+		return 0, err
+	}
 
+	simRes, _, err := tx.CalculateGas(clientCtx, txf, msgs...)
+	if err != nil {
+		return 0, err
+	}
+	return simRes.GasInfo.GasUsed, nil
+}
+
+// capGas returns the given gas limit, lowered to the maximum gas limit if one is set.
+func (c *Context) capGas(gas uint64) uint64 {
+	if c.maxGas > 0 && gas > c.maxGas {
+		return c.maxGas
+	}
 	return gas
 }
+
+// estimateGas returns the gas limit for the report transaction. It uses transaction simulation when gas adjustment
+// is set and falls back to the static estimation if the simulation fails.
+func estimateGas(
+	c *Context, l *Logger, key keyring.Info, msgs []sdk.Msg, feeEstimations []FeeEstimationData, memo string,
+) uint64 {
+	staticGas := estimateStaticGas(c, msgs, feeEstimations)
+	return chooseGas(c, l, staticGas, func() (uint64, error) {
+		return simulateGas(c, key, msgs, memo)
+	})
+}
+
+// chooseGas returns the simulated gas multiplied by the gas adjustment, or the static estimation if gas adjustment
+// is not set or the simulation fails, capped at the maximum gas limit.
+func chooseGas(c *Context, l *Logger, staticGas uint64, simulate gasSimulator) uint64 {
+	if c.gasAdjustment <= 0 {
+		l.Debug(":fuel_pump: Estimated gas is %d", staticGas)
+		return c.capGas(staticGas)
+	}
+
+	gasUsed, err := simulate()
+	if err != nil {
+		l.Info(":warning: Failed to simulate report transaction, using estimated gas %d: %s", staticGas, err.Error())
+		return c.capGas(staticGas)
+	}
+
+	simulatedGas := uint64(c.gasAdjustment * float64(gasUsed))
+	l.Info(
+		":fuel_pump: Simulated gas is %d, estimated gas is %d, difference is %d",
+		simulatedGas, staticGas, int64(simulatedGas)-int64(staticGas),
+	)
+	return c.capGas(simulatedGas)
+}
@@ -0,0 +1,43 @@
+package yoda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func simulated(gas uint64, err error) gasSimulator {
+	return func() (uint64, error) {
+		return gas, err
+	}
+}
+
+func TestChooseGasAdjustment(t *testing.T) {
+	l := NewLogger(log.AllowNone())
+
+	require.Equal(t, uint64(130000), chooseGas(&Context{gasAdjustment: 1.3}, l, 50000, simulated(100000, nil)))
+	require.Equal(t, uint64(100000), chooseGas(&Context{gasAdjustment: 1}, l, 50000, simulated(100000, nil)))
+	// Without gas adjustment the transaction is not simulated at all.
+	require.Equal(t, uint64(50000), chooseGas(&Context{}, l, 50000, func() (uint64, error) {
+		t.Fatal("simulated without gas adjustment")
+		return 0, nil
+	}))
+}
+
+func TestChooseGasSimulationFailure(t *testing.T) {
+	l := NewLogger(log.AllowNone())
+
+	require.Equal(t, uint64(50000), chooseGas(&Context{gasAdjustment: 1.3}, l, 50000, simulated(0, errors.New("rpc error"))))
+}
+
+func TestChooseGasMaxGas(t *testing.T) {
+	l := NewLogger(log.AllowNone())
+
+	require.Equal(t, uint64(120000), chooseGas(&Context{gasAdjustment: 1.3, maxGas: 120000}, l, 50000, simulated(100000, nil)))
+	require.Equal(t, uint64(40000), chooseGas(&Context{gasAdjustment: 1.3, maxGas: 40000}, l, 50000, simulated(0, errors.New("rpc error"))))
+	require.Equal(t, uint64(40000), chooseGas(&Context{maxGas: 40000}, l, 50000, nil))
+	require.Equal(t, uint64(40000), (&Context{maxGas: 40000}).capGas(40000*110/100))
+	require.Equal(t, uint64(44000), (&Context{}).capGas(40000*110/100))
+}
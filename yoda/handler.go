@@ -3,13 +3,16 @@ package yoda
 import (
 	"encoding/hex"
 	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 
 	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
+	"github.com/GeoDB-Limited/odin-core/yoda/executor"
 )
 
 type processingResult struct {
@@ -40,11 +43,11 @@ func handleTransaction(c *Context, l *Logger, tx abci.TxResult) {
 	}
 
 	for _, log := range logs {
-		go handleRequestLog(c, l, log)
+		go handleRequestLog(c, l, log, tx.Height)
 	}
 }
 
-func handleRequestLog(c *Context, l *Logger, log sdk.ABCIMessageLog) {
+func handleRequestLog(c *Context, l *Logger, log sdk.ABCIMessageLog, height int64) {
 	idStr, err := GetEventValue(log, oracletypes.EventTypeRequest, oracletypes.AttributeKeyID)
 	if err != nil {
 		l.Debug(":cold_sweat: Failed to parse request id with error: %s", err.Error())
@@ -120,9 +123,10 @@ func handleRequestLog(c *Context, l *Logger, log sdk.ABCIMessageLog) {
 	}
 
 	c.pendingMsgs <- ReportMsgWithKey{
-		msg:         oracletypes.NewMsgReportData(oracletypes.RequestID(id), reports, c.validator, key.GetAddress()),
-		execVersion: execVersions,
-		keyIndex:    keyIndex,
+		msg:           oracletypes.NewMsgReportData(oracletypes.RequestID(id), reports, c.validator, key.GetAddress()),
+		execVersion:   execVersions,
+		keyIndex:      keyIndex,
+		requestHeight: height,
 		feeEstimationData: FeeEstimationData{
 			askCount:    askCount,
 			minCount:    minCount,
@@ -169,9 +173,10 @@ func handlePendingRequest(c *Context, l *Logger, id oracletypes.RequestID) {
 	reports, execVersions := handleRawRequests(c, l, id, rawRequests, key)
 
 	c.pendingMsgs <- ReportMsgWithKey{
-		msg:         oracletypes.NewMsgReportData(oracletypes.RequestID(id), reports, c.validator, key.GetAddress()),
-		execVersion: execVersions,
-		keyIndex:    keyIndex,
+		msg:           oracletypes.NewMsgReportData(oracletypes.RequestID(id), reports, c.validator, key.GetAddress()),
+		execVersion:   execVersions,
+		keyIndex:      keyIndex,
+		requestHeight: req.RequestHeight,
 		feeEstimationData: FeeEstimationData{
 			askCount:    int64(len(req.RequestedValidators)),
 			minCount:    int64(req.MinCount),
@@ -222,7 +227,7 @@ func handleRawRequest(c *Context, l *Logger, req rawRequest, key keyring.Info, i
 	}
 
 	vmsg := oracletypes.NewRequestVerification(cfg.ChainID, c.validator, id, req.externalID)
-	sig, pubkey, err := kb.Sign(key.GetName(), vmsg.GetSignBytes())
+	sig, pubkey, err := c.signer.Sign(key.GetName(), vmsg.GetSignBytes())
 	if err != nil {
 		l.Error(":skull: Failed to sign verify message: %s", c, err.Error())
 		processingResultCh <- processingResult{
@@ -232,14 +237,26 @@ func handleRawRequest(c *Context, l *Logger, req rawRequest, key keyring.Info, i
 		return
 	}
 
-	result, err := c.executor.Exec(exec, req.calldata, map[string]interface{}{
-		"BAND_CHAIN_ID":    vmsg.ChainID,
-		"BAND_VALIDATOR":   vmsg.Validator,
-		"BAND_REQUEST_ID":  strconv.Itoa(int(vmsg.RequestID)),
-		"BAND_EXTERNAL_ID": strconv.Itoa(int(vmsg.ExternalID)),
-		"BAND_REPORTER":    hex.EncodeToString(pubkey.Bytes()),
-		"BAND_SIGNATURE":   sig,
-	})
+	execFn := func() (executor.ExecResult, error) {
+		defer func(start time.Time) {
+			c.observeExecLatency(req.dataSourceID, time.Since(start))
+		}(time.Now())
+		return c.executor.Exec(exec, req.calldata, newExecEnv(vmsg, pubkey, sig))
+	}
+
+	var result executor.ExecResult
+	if c.execCache.enabled(req.dataSourceID) {
+		var cached bool
+		result, cached, err = c.execCache.exec(execCacheKey{req.dataSourceHash, req.calldata}, execFn)
+		if cached {
+			l.Debug(":recycle: Reusing data source result for identical calldata")
+			c.updateExecCacheHits(1)
+		} else {
+			c.updateExecCacheMiss(1)
+		}
+	} else {
+		result, err = execFn()
+	}
 
 	if err != nil {
 		l.Error(":skull: Failed to execute data source script: %s", c, err.Error())
@@ -259,3 +276,15 @@ func handleRawRequest(c *Context, l *Logger, req rawRequest, key keyring.Info, i
 		}
 	}
 }
+
+// newExecEnv returns the environment variables passed to data source scripts for the given signed verification message.
+func newExecEnv(vmsg oracletypes.RequestVerification, pubkey cryptotypes.PubKey, sig []byte) map[string]interface{} {
+	return map[string]interface{}{
+		"BAND_CHAIN_ID":    vmsg.ChainID,
+		"BAND_VALIDATOR":   vmsg.Validator,
+		"BAND_REQUEST_ID":  strconv.Itoa(int(vmsg.RequestID)),
+		"BAND_EXTERNAL_ID": strconv.Itoa(int(vmsg.ExternalID)),
+		"BAND_REPORTER":    hex.EncodeToString(pubkey.Bytes()),
+		"BAND_SIGNATURE":   sig,
+	}
+}
@@ -0,0 +1,59 @@
+package yoda
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// markAlive records that the event subscription is known to work at the current time. It must only be called when
+// an event arrives through the subscription, so that a stalled subscription is noticed.
+func (c *Context) markAlive() {
+	atomic.StoreInt64(&c.lastAliveTime, time.Now().UnixNano())
+}
+
+// checkLive returns an error if the event subscription has not been known to work for longer than the stale
+// timeout. Yoda cannot receive new requests in that state and should be restarted.
+func (c *Context) checkLive() error {
+	lastAlive := time.Unix(0, atomic.LoadInt64(&c.lastAliveTime))
+	if since := time.Since(lastAlive); since > c.staleTimeout {
+		return fmt.Errorf("event subscription stale for %s", since.Round(time.Second))
+	}
+	return nil
+}
+
+// checkReady returns an error if yoda is not live, has no healthy RPC endpoint or all of its keys are busy.
+func (c *Context) checkReady() error {
+	if err := c.checkLive(); err != nil {
+		return err
+	}
+	if len(c.endpoints.healthyEndpoints()) == 0 {
+		return errors.New("no healthy RPC endpoint")
+	}
+	if atomic.LoadInt64(&c.availableKeyCount) <= 0 {
+		return errors.New("all keys are busy")
+	}
+	return nil
+}
+
+func healthHandler(check func() error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if err := check(); err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, "ok")
+	})
+}
+
+// healthzHandler serves the liveness probe, failing when the event subscription is stale.
+func healthzHandler(c *Context) http.Handler {
+	return healthHandler(c.checkLive)
+}
+
+// readyzHandler serves the readiness probe, failing when yoda cannot submit reports right now.
+func readyzHandler(c *Context) http.Handler {
+	return healthHandler(c.checkReady)
+}
@@ -0,0 +1,39 @@
+package yoda
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func probe(h http.Handler) int {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	return rec.Code
+}
+
+func TestHealthProbes(t *testing.T) {
+	pool, err := newEndpointPool([]string{"tcp://localhost:26657"}, 3, time.Second)
+	require.NoError(t, err)
+	c := &Context{endpoints: pool, staleTimeout: time.Minute, availableKeyCount: 1}
+
+	// The subscription has never worked.
+	require.Equal(t, http.StatusServiceUnavailable, probe(healthzHandler(c)))
+	require.Equal(t, http.StatusServiceUnavailable, probe(readyzHandler(c)))
+
+	c.markAlive()
+	require.Equal(t, http.StatusOK, probe(healthzHandler(c)))
+	require.Equal(t, http.StatusOK, probe(readyzHandler(c)))
+
+	atomic.StoreInt64(&c.availableKeyCount, 0)
+	require.Equal(t, http.StatusOK, probe(healthzHandler(c)))
+	require.Equal(t, http.StatusServiceUnavailable, probe(readyzHandler(c)))
+
+	atomic.StoreInt64(&c.availableKeyCount, 1)
+	atomic.StoreInt64(&c.lastAliveTime, time.Now().Add(-2*time.Minute).UnixNano())
+	require.Equal(t, http.StatusServiceUnavailable, probe(healthzHandler(c)))
+}
@@ -2,20 +2,28 @@ package yoda
 
 import (
 	"bufio"
-	"fmt"
 	"context"
-	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	"github.com/kyokomi/emoji"
-
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	bip39 "github.com/cosmos/go-bip39"
+	"github.com/kyokomi/emoji"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	app "github.com/GeoDB-Limited/odin-core/app"
+	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
 )
 
 const (
@@ -24,6 +32,9 @@ const (
 	flagCoinType = "coin-type"
 	flagRecover  = "recover"
 	flagAddress  = "address"
+
+	flagSpendLimit = "spend-limit"
+	flagExpiration = "expiration"
 )
 
 func keysCmd(c *Context) *cobra.Command {
@@ -37,6 +48,7 @@ func keysCmd(c *Context) *cobra.Command {
 		keysDeleteCmd(c),
 		keysListCmd(c),
 		keysShowCmd(c),
+		keysGrantCmd(c),
 	)
 	return cmd
 }
@@ -198,3 +210,164 @@ func keysShowCmd(c *Context) *cobra.Command {
 	}
 	return cmd
 }
+
+func keysGrantCmd(c *Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "grant [name...]",
+		Aliases: []string{"g"},
+		Short:   "Add keys as reporters and grant them fee allowance from the sender in one transaction",
+		Long: strings.TrimSpace(`Add keys as reporters of the sender's validator and let the sender pay their report fees.
+The sender is normally the validator's operator account, taken from --from in the keyring set by --keyring-dir.
+The fee allowance only covers report transactions. If no key name is given, all keys that are not yet reporters
+are used. Keys that are already reporters or already have an allowance are skipped accordingly.`),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			encodingConfig := app.MakeEncodingConfig()
+			initClientCtx := client.Context{}.
+				WithCodec(encodingConfig.Marshaler).
+				WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
+				WithTxConfig(encodingConfig.TxConfig).
+				WithLegacyAmino(encodingConfig.Amino).
+				WithInput(os.Stdin).
+				WithAccountRetriever(authtypes.AccountRetriever{}).
+				WithBroadcastMode(flags.BroadcastBlock)
+			return client.SetCmdClientContextHandler(initClientCtx, cmd)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			granter := clientCtx.GetFromAddress()
+			validator := sdk.ValAddress(granter)
+
+			allowance, err := reportFeeAllowance(cmd)
+			if err != nil {
+				return err
+			}
+
+			closeSigner, err := setupSigner(c)
+			if err != nil {
+				return err
+			}
+			defer closeSigner()
+			keys, err := signerKeys(c, args)
+			if err != nil {
+				return err
+			}
+
+			oracleClient := oracletypes.NewQueryClient(clientCtx)
+			feegrantClient := feegrant.NewQueryClient(clientCtx)
+			var msgs []sdk.Msg
+			for _, key := range keys {
+				r, err := oracleClient.IsReporter(
+					context.Background(),
+					&oracletypes.QueryIsReporterRequest{ValidatorAddress: validator.String(), ReporterAddress: key.GetAddress().String()},
+				)
+				if err != nil {
+					return err
+				}
+				if !r.IsReporter {
+					msgs = append(msgs, oracletypes.NewMsgAddReporter(validator, key.GetAddress()))
+				} else if len(args) == 0 {
+					// Only newly added keys are picked up when no key is named explicitly.
+					continue
+				}
+
+				granted, err := hasFeeAllowance(feegrantClient, granter, key.GetAddress())
+				if err != nil {
+					return err
+				}
+				if granted {
+					emoji.Printf(":fast_forward:%s already has fee allowance from %s\n", key.GetName(), granter.String())
+					continue
+				}
+				msg, err := feegrant.NewMsgGrantAllowance(allowance, granter, key.GetAddress())
+				if err != nil {
+					return err
+				}
+				msgs = append(msgs, msg)
+			}
+
+			if len(msgs) == 0 {
+				fmt.Println("Nothing to grant")
+				return nil
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+	cmd.Flags().String(flagSpendLimit, "", "Total amount of coins the reporters may spend on fees, unlimited if empty")
+	cmd.Flags().String(flagExpiration, "", "Expiration time of the fee allowance in RFC3339 format, never expires if empty")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// reportFeeAllowance creates a fee allowance that can only be used to pay for report transactions.
+func reportFeeAllowance(cmd *cobra.Command) (feegrant.FeeAllowanceI, error) {
+	basic := feegrant.BasicAllowance{}
+
+	spendLimit, err := cmd.Flags().GetString(flagSpendLimit)
+	if err != nil {
+		return nil, err
+	}
+	if spendLimit != "" {
+		limit, err := sdk.ParseCoinsNormalized(spendLimit)
+		if err != nil {
+			return nil, err
+		}
+		basic.SpendLimit = limit
+	}
+
+	expiration, err := cmd.Flags().GetString(flagExpiration)
+	if err != nil {
+		return nil, err
+	}
+	if expiration != "" {
+		exp, err := time.Parse(time.RFC3339, expiration)
+		if err != nil {
+			return nil, err
+		}
+		basic.Expiration = &exp
+	}
+
+	return feegrant.NewAllowedMsgAllowance(&basic, []string{sdk.MsgTypeURL(&oracletypes.MsgReportData{})})
+}
+
+// hasFeeAllowance returns whether the granter already pays fees of the grantee. A missing grant is reported by the
+// node as an error, which is told apart from failures of the query itself.
+func hasFeeAllowance(client feegrant.QueryClient, granter, grantee sdk.AccAddress) (bool, error) {
+	res, err := client.Allowance(
+		context.Background(),
+		&feegrant.QueryAllowanceRequest{Granter: granter.String(), Grantee: grantee.String()},
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "fee-grant not found") {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to query fee allowance of %s: %w", grantee.String(), err)
+	}
+	return res.Allowance != nil, nil
+}
+
+// signerKeys returns the named keys of the signer, or all of its keys if no name is given.
+func signerKeys(c *Context, names []string) ([]keyring.Info, error) {
+	all, err := c.signer.List()
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return all, nil
+	}
+	byName := make(map[string]keyring.Info, len(all))
+	for _, key := range all {
+		byName[key.GetName()] = key
+	}
+	keys := make([]keyring.Info, 0, len(names))
+	for _, name := range names {
+		key, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("Key %s not found", name)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
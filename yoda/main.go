@@ -17,28 +17,54 @@ import (
 )
 
 const (
-	flagValidator        = "validator"
-	flagLogLevel         = "log-level"
-	flagExecutor         = "executor"
-	flagBroadcastTimeout = "broadcast-timeout"
-	flagRPCPollInterval  = "rpc-poll-interval"
-	flagMaxTry           = "max-try"
-	flagMaxReport        = "max-report"
+	flagValidator           = "validator"
+	flagLogLevel            = "log-level"
+	flagExecutor            = "executor"
+	flagBroadcastTimeout    = "broadcast-timeout"
+	flagRPCPollInterval     = "rpc-poll-interval"
+	flagMaxTry              = "max-try"
+	flagMaxReport           = "max-report"
+	flagExecCacheTTL        = "exec-cache-ttl"
+	flagExecCacheSkip       = "exec-cache-skip"
+	flagGasAdjustment       = "gas-adjustment"
+	flagMaxGas              = "max-gas"
+	flagMaxBlockLag         = "max-block-lag"
+	flagHealthCheckInterval = "health-check-interval"
+	flagFeeGranter          = "fee-granter"
+	flagStaleTimeout        = "stale-timeout"
+	flagRPCTimeout          = "rpc-timeout"
+	flagSigner              = "signer"
+	flagSignerCA            = "signer-ca"
+	flagSignerCert          = "signer-cert"
+	flagSignerKey           = "signer-key"
 )
 
 // Config data structure for yoda daemon.
 type Config struct {
-	ChainID           string `mapstructure:"chain-id"`            // ChainID of the target chain
-	NodeURI           string `mapstructure:"node"`                // Remote RPC URI of OdinChain node to connect to
-	Validator         string `mapstructure:"validator"`           // The validator address that I'm responsible for
-	GasPrices         string `mapstructure:"gas-prices"`          // Gas prices of the transaction
-	LogLevel          string `mapstructure:"log-level"`           // Log level of the logger
-	Executor          string `mapstructure:"executor"`            // Executor name and URL (example: "Executor name:URL")
-	BroadcastTimeout  string `mapstructure:"broadcast-timeout"`   // The time that Yoda will wait for tx commit
-	RPCPollInterval   string `mapstructure:"rpc-poll-interval"`   // The duration of rpc poll interval
-	MaxTry            uint64 `mapstructure:"max-try"`             // The maximum number of tries to submit a report transaction
-	MaxReport         uint64 `mapstructure:"max-report"`          // The maximum number of reports in one transaction
-	MetricsListenAddr string `mapstructure:"metrics-listen-addr"` // Address to listen on for prometheus metrics
+	ChainID             string  `mapstructure:"chain-id"`              // ChainID of the target chain
+	NodeURI             string  `mapstructure:"node"`                  // Comma-separated remote RPC URIs of OdinChain nodes to connect to
+	MaxBlockLag         int64   `mapstructure:"max-block-lag"`         // The maximum number of blocks a node may lag behind to be healthy
+	HealthCheckInterval string  `mapstructure:"health-check-interval"` // The duration of RPC node health check interval
+	StaleTimeout        string  `mapstructure:"stale-timeout"`         // The duration without a working subscription before /healthz fails
+	RPCTimeout          string  `mapstructure:"rpc-timeout"`           // The duration after which calls to an RPC node time out
+	Validator           string  `mapstructure:"validator"`             // The validator address that I'm responsible for
+	GasPrices           string  `mapstructure:"gas-prices"`            // Gas prices of the transaction
+	GasAdjustment       float64 `mapstructure:"gas-adjustment"`        // Multiplier of simulated gas, 0 to use static estimation only
+	MaxGas              uint64  `mapstructure:"max-gas"`               // The maximum gas limit of a report transaction, 0 for no limit
+	FeeGranter          string  `mapstructure:"fee-granter"`           // The account that pays report fees through a fee grant
+	LogLevel            string  `mapstructure:"log-level"`             // Log level of the logger
+	Executor            string  `mapstructure:"executor"`              // Executor name and URL (example: "Executor name:URL")
+	BroadcastTimeout    string  `mapstructure:"broadcast-timeout"`     // The time that Yoda will wait for tx commit
+	RPCPollInterval     string  `mapstructure:"rpc-poll-interval"`     // The duration of rpc poll interval
+	MaxTry              uint64  `mapstructure:"max-try"`               // The maximum number of tries to submit a report transaction
+	MaxReport           uint64  `mapstructure:"max-report"`            // The maximum number of reports in one transaction
+	MetricsListenAddr   string  `mapstructure:"metrics-listen-addr"`   // Address to listen on for prometheus metrics
+	ExecCacheTTL        string  `mapstructure:"exec-cache-ttl"`        // The duration data source results are reused for identical calls
+	ExecCacheSkip       string  `mapstructure:"exec-cache-skip"`       // Comma-separated data source IDs that are never cached
+	Signer              string  `mapstructure:"signer"`                // Address of the remote signer, empty to sign with the local keyring
+	SignerCA            string  `mapstructure:"signer-ca"`             // CA certificate file that signed the remote signer certificate
+	SignerCert          string  `mapstructure:"signer-cert"`           // Client certificate file used to authenticate to the remote signer
+	SignerKey           string  `mapstructure:"signer-key"`            // Client private key file used to authenticate to the remote signer
 }
 
 // Global instances.
@@ -80,6 +106,7 @@ func Main() {
 		configCmd(),
 		keysCmd(ctx),
 		runCmd(ctx),
+		execCmd(ctx),
 		version.NewVersionCommand(),
 	)
 	rootCmd.PersistentPreRunE = func(_ *cobra.Command, _ []string) error {
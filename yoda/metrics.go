@@ -1,19 +1,59 @@
 package yoda
 
 import (
+	"fmt"
+	"math/big"
 	"net/http"
+	"strconv"
 	"sync/atomic"
+	"time"
 
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
+// txFailure identifies the ABCI error a report transaction failed with.
+type txFailure struct {
+	codespace string
+	code      uint32
+}
+
 type yodaCollector struct {
 	context                   *Context
 	reportsHandlingGaugeDesc  *prometheus.Desc
 	reportsPendingGaugeDesc   *prometheus.Desc
 	reportsErrorCountDesc     *prometheus.Desc
 	reportsSubmittedCountDesc *prometheus.Desc
+	execCacheHitCountDesc     *prometheus.Desc
+	execCacheMissCountDesc    *prometheus.Desc
+	rawReportsTrimmedDesc     *prometheus.Desc
+	reportsDroppedDesc        *prometheus.Desc
+	endpointHeightDesc        *prometheus.Desc
+	endpointHealthyDesc       *prometheus.Desc
+	endpointSubscribedDesc    *prometheus.Desc
+	endpointBroadcastDesc     *prometheus.Desc
+	endpointBroadcastErrDesc  *prometheus.Desc
+	endpointCheckFailDesc     *prometheus.Desc
+	txFailureCountDesc        *prometheus.Desc
+	keyBalanceDesc            *prometheus.Desc
+	keysAvailableDesc         *prometheus.Desc
+	lastEventHeightDesc       *prometheus.Desc
+}
+
+// newHistograms creates the data source execution latency and report inclusion delay histograms.
+func newHistograms() (*prometheus.HistogramVec, prometheus.Histogram) {
+	execLatency := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "yoda_data_source_execution_seconds",
+		Help:    "Time taken to execute data source scripts",
+		Buckets: prometheus.ExponentialBuckets(0.1, 2, 10),
+	}, []string{"data_source_id"})
+	inclusionDelay := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "yoda_report_inclusion_blocks",
+		Help:    "Number of blocks between a request and the inclusion of its report",
+		Buckets: []float64{1, 2, 3, 4, 5, 7, 10, 15, 20, 30, 50},
+	})
+	return execLatency, inclusionDelay
 }
 
 func NewYodaCollector(c *Context) prometheus.Collector {
@@ -35,6 +75,62 @@ func NewYodaCollector(c *Context) prometheus.Collector {
 			"yoda_reports_submitted_total",
 			"Number of reports submitted since last yoda restart",
 			nil, nil),
+		execCacheHitCountDesc: prometheus.NewDesc(
+			"yoda_exec_cache_hit_total",
+			"Number of data source executions served from cache since last yoda restart",
+			nil, nil),
+		execCacheMissCountDesc: prometheus.NewDesc(
+			"yoda_exec_cache_miss_total",
+			"Number of cacheable data source executions that ran the script since last yoda restart",
+			nil, nil),
+		rawReportsTrimmedDesc: prometheus.NewDesc(
+			"yoda_raw_reports_trimmed_total",
+			"Number of raw reports replaced because their data exceeded max data size since last yoda restart",
+			nil, nil),
+		reportsDroppedDesc: prometheus.NewDesc(
+			"yoda_reports_dropped_total",
+			"Number of reports dropped before broadcast since last yoda restart",
+			[]string{"reason"}, nil),
+		endpointHeightDesc: prometheus.NewDesc(
+			"yoda_endpoint_height",
+			"Latest block height reported by the RPC endpoint",
+			[]string{"endpoint"}, nil),
+		endpointHealthyDesc: prometheus.NewDesc(
+			"yoda_endpoint_healthy",
+			"Whether the RPC endpoint passed the latest health check",
+			[]string{"endpoint"}, nil),
+		endpointSubscribedDesc: prometheus.NewDesc(
+			"yoda_endpoint_subscribed",
+			"Whether the RPC endpoint is used for the event subscription",
+			[]string{"endpoint"}, nil),
+		endpointBroadcastDesc: prometheus.NewDesc(
+			"yoda_endpoint_broadcast_total",
+			"Number of report transactions broadcast to the RPC endpoint since last yoda restart",
+			[]string{"endpoint"}, nil),
+		endpointBroadcastErrDesc: prometheus.NewDesc(
+			"yoda_endpoint_broadcast_error_total",
+			"Number of failed broadcasts to the RPC endpoint since last yoda restart",
+			[]string{"endpoint"}, nil),
+		endpointCheckFailDesc: prometheus.NewDesc(
+			"yoda_endpoint_health_check_failure_total",
+			"Number of failed health checks of the RPC endpoint since last yoda restart",
+			[]string{"endpoint"}, nil),
+		txFailureCountDesc: prometheus.NewDesc(
+			"yoda_tx_failure_total",
+			"Number of report transactions that returned a nonzero code since last yoda restart",
+			[]string{"codespace", "code"}, nil),
+		keyBalanceDesc: prometheus.NewDesc(
+			"yoda_key_balance",
+			"Latest balance of the reporter key",
+			[]string{"reporter", "denom"}, nil),
+		keysAvailableDesc: prometheus.NewDesc(
+			"yoda_keys_available_count",
+			"Number of reporter keys not currently sending a report transaction",
+			nil, nil),
+		lastEventHeightDesc: prometheus.NewDesc(
+			"yoda_subscription_last_event_height",
+			"Block height of the latest event received from the event subscription",
+			nil, nil),
 	}
 }
 
@@ -43,6 +139,22 @@ func (collector yodaCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- collector.reportsPendingGaugeDesc
 	ch <- collector.reportsErrorCountDesc
 	ch <- collector.reportsSubmittedCountDesc
+	ch <- collector.execCacheHitCountDesc
+	ch <- collector.execCacheMissCountDesc
+	ch <- collector.rawReportsTrimmedDesc
+	ch <- collector.reportsDroppedDesc
+	ch <- collector.endpointHeightDesc
+	ch <- collector.endpointHealthyDesc
+	ch <- collector.endpointSubscribedDesc
+	ch <- collector.endpointBroadcastDesc
+	ch <- collector.endpointBroadcastErrDesc
+	ch <- collector.endpointCheckFailDesc
+	ch <- collector.txFailureCountDesc
+	ch <- collector.keyBalanceDesc
+	ch <- collector.keysAvailableDesc
+	ch <- collector.lastEventHeightDesc
+	collector.context.execLatency.Describe(ch)
+	collector.context.inclusionDelay.Describe(ch)
 }
 
 func (collector yodaCollector) Collect(ch chan<- prometheus.Metric) {
@@ -54,11 +166,101 @@ func (collector yodaCollector) Collect(ch chan<- prometheus.Metric) {
 		float64(atomic.LoadInt64(&collector.context.errorCount)))
 	ch <- prometheus.MustNewConstMetric(collector.reportsSubmittedCountDesc, prometheus.CounterValue,
 		float64(atomic.LoadInt64(&collector.context.submittedCount)))
+	ch <- prometheus.MustNewConstMetric(collector.execCacheHitCountDesc, prometheus.CounterValue,
+		float64(atomic.LoadInt64(&collector.context.execCacheHits)))
+	ch <- prometheus.MustNewConstMetric(collector.execCacheMissCountDesc, prometheus.CounterValue,
+		float64(atomic.LoadInt64(&collector.context.execCacheMiss)))
+	ch <- prometheus.MustNewConstMetric(collector.rawReportsTrimmedDesc, prometheus.CounterValue,
+		float64(atomic.LoadInt64(&collector.context.trimmedCount)))
+	collector.context.droppedMtx.Lock()
+	for reason, count := range collector.context.droppedCounts {
+		ch <- prometheus.MustNewConstMetric(collector.reportsDroppedDesc, prometheus.CounterValue,
+			float64(count), reason)
+	}
+	collector.context.droppedMtx.Unlock()
+	pool := collector.context.endpoints
+	for _, ep := range pool.endpoints {
+		pool.mtx.RLock()
+		height, healthy, subscribed := ep.height, ep.healthy, pool.subscribed == ep
+		pool.mtx.RUnlock()
+		ch <- prometheus.MustNewConstMetric(collector.endpointHeightDesc, prometheus.GaugeValue,
+			float64(height), ep.uri)
+		ch <- prometheus.MustNewConstMetric(collector.endpointHealthyDesc, prometheus.GaugeValue,
+			boolToFloat(healthy), ep.uri)
+		ch <- prometheus.MustNewConstMetric(collector.endpointSubscribedDesc, prometheus.GaugeValue,
+			boolToFloat(subscribed), ep.uri)
+		ch <- prometheus.MustNewConstMetric(collector.endpointBroadcastDesc, prometheus.CounterValue,
+			float64(atomic.LoadInt64(&ep.broadcastCount)), ep.uri)
+		ch <- prometheus.MustNewConstMetric(collector.endpointBroadcastErrDesc, prometheus.CounterValue,
+			float64(atomic.LoadInt64(&ep.broadcastErrorCount)), ep.uri)
+		ch <- prometheus.MustNewConstMetric(collector.endpointCheckFailDesc, prometheus.CounterValue,
+			float64(atomic.LoadInt64(&ep.healthCheckFailures)), ep.uri)
+	}
+	collector.context.txFailureMtx.Lock()
+	for failure, count := range collector.context.txFailures {
+		ch <- prometheus.MustNewConstMetric(collector.txFailureCountDesc, prometheus.CounterValue,
+			float64(count), failure.codespace, strconv.FormatUint(uint64(failure.code), 10))
+	}
+	collector.context.txFailureMtx.Unlock()
+	collector.context.balanceMtx.Lock()
+	for reporter, balance := range collector.context.keyBalances {
+		for _, coin := range balance {
+			amount, _ := new(big.Float).SetInt(coin.Amount.BigInt()).Float64()
+			ch <- prometheus.MustNewConstMetric(collector.keyBalanceDesc, prometheus.GaugeValue,
+				amount, reporter, coin.Denom)
+		}
+	}
+	collector.context.balanceMtx.Unlock()
+	ch <- prometheus.MustNewConstMetric(collector.keysAvailableDesc, prometheus.GaugeValue,
+		float64(atomic.LoadInt64(&collector.context.availableKeyCount)))
+	ch <- prometheus.MustNewConstMetric(collector.lastEventHeightDesc, prometheus.GaugeValue,
+		float64(atomic.LoadInt64(&collector.context.lastEventHeight)))
+	collector.context.execLatency.Collect(ch)
+	collector.context.inclusionDelay.Collect(ch)
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
 }
 
 func metricsListen(listenAddr string, c *Context) {
 	collector := NewYodaCollector(c)
 	prometheus.MustRegister(collector)
 	http.Handle("/metrics", promhttp.Handler())
+	http.Handle("/healthz", healthzHandler(c))
+	http.Handle("/readyz", readyzHandler(c))
 	panic(http.ListenAndServe(listenAddr, nil))
 }
+
+// updateKeyBalances queries the balances of all reporter keys for the key balance gauges.
+func updateKeyBalances(c *Context, l *Logger) {
+	for _, key := range c.keys {
+		address := key.GetAddress().String()
+		res, err := abciQuery(c, l, "/cosmos.bank.v1beta1.Query/AllBalances",
+			cdc.MustMarshal(&banktypes.QueryAllBalancesRequest{Address: address}))
+		if err == nil && res.Response.Code != 0 {
+			err = fmt.Errorf("query returned nonzero code %d: %s", res.Response.Code, res.Response.Log)
+		}
+		if err != nil {
+			l.Debug(":warning: Failed to query balance of %s with error: %s", address, err.Error())
+			continue
+		}
+		var balances banktypes.QueryAllBalancesResponse
+		if err := cdc.Unmarshal(res.Response.Value, &balances); err != nil {
+			l.Debug(":warning: Failed to decode balance of %s with error: %s", address, err.Error())
+			continue
+		}
+		c.updateKeyBalance(address, balances.Balances)
+	}
+}
+
+// keyBalanceLoop refreshes the key balance gauges every interval.
+func keyBalanceLoop(c *Context, l *Logger, interval time.Duration) {
+	for {
+		updateKeyBalances(c, l)
+		time.Sleep(interval)
+	}
+}
@@ -0,0 +1,164 @@
+package yoda
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
+)
+
+// Reasons reports are dropped for, used as the label of the dropped reports metric.
+const (
+	dropReasonNotReporter      = "not_reporter"
+	dropReasonExpired          = "request_expired"
+	dropReasonTooLargeData     = "too_large_data"
+	dropReasonRequestNotFound  = "request_not_found"
+	dropReasonNotRequested     = "validator_not_requested"
+	dropReasonInvalidSize      = "invalid_report_size"
+	dropReasonRawRequestAbsent = "raw_request_not_found"
+	dropReasonOther            = "other"
+)
+
+// dropReason maps an error returned by oracletypes.CheckReport to one of the fixed drop reasons.
+func dropReason(err error) string {
+	switch {
+	case errors.Is(err, oracletypes.ErrReporterNotAuthorized):
+		return dropReasonNotReporter
+	case errors.Is(err, oracletypes.ErrRequestAlreadyExpired):
+		return dropReasonExpired
+	case errors.Is(err, oracletypes.ErrTooLargeRawReportData):
+		return dropReasonTooLargeData
+	case errors.Is(err, oracletypes.ErrRequestNotFound):
+		return dropReasonRequestNotFound
+	case errors.Is(err, oracletypes.ErrValidatorNotRequested):
+		return dropReasonNotRequested
+	case errors.Is(err, oracletypes.ErrInvalidReportSize):
+		return dropReasonInvalidSize
+	case errors.Is(err, oracletypes.ErrRawRequestNotFound):
+		return dropReasonRawRequestAbsent
+	default:
+		return dropReasonOther
+	}
+}
+
+// queriedReportState implements oracletypes.ReportState with chain state queried before submitting a batch of
+// reports. All reports in a batch are sent by the same reporter on behalf of the same validator.
+type queriedReportState struct {
+	isReporter  bool
+	lastExpired oracletypes.RequestID
+	maxDataSize uint64
+	requests    map[oracletypes.RequestID]oracletypes.Request
+}
+
+func (s *queriedReportState) IsReporter(validator sdk.ValAddress, reporter sdk.AccAddress) bool {
+	return s.isReporter
+}
+
+func (s *queriedReportState) GetRequestLastExpired() oracletypes.RequestID {
+	return s.lastExpired
+}
+
+func (s *queriedReportState) GetRequest(id oracletypes.RequestID) (oracletypes.Request, error) {
+	req, ok := s.requests[id]
+	if !ok {
+		return oracletypes.Request{}, oracletypes.ErrRequestNotFound
+	}
+	return req, nil
+}
+
+func (s *queriedReportState) GetMaxDataSize() uint64 {
+	return s.maxDataSize
+}
+
+// queryReportState fetches the chain state needed to check reports of the given requests sent by the reporter.
+func queryReportState(
+	c *Context, l *Logger, reporter sdk.AccAddress, ids []oracletypes.RequestID,
+) (*queriedReportState, error) {
+	state := &queriedReportState{requests: make(map[oracletypes.RequestID]oracletypes.Request)}
+
+	res, err := abciQuery(c, l, "/oracle.v1.Query/IsReporter", cdc.MustMarshal(&oracletypes.QueryIsReporterRequest{
+		ValidatorAddress: c.validator.String(),
+		ReporterAddress:  reporter.String(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	if res.Response.Code != 0 {
+		return nil, fmt.Errorf("query returned nonzero code %d: %s", res.Response.Code, res.Response.Log)
+	}
+	var isReporter oracletypes.QueryIsReporterResponse
+	if err := cdc.Unmarshal(res.Response.Value, &isReporter); err != nil {
+		return nil, err
+	}
+	state.isReporter = isReporter.IsReporter
+
+	res, err = abciQuery(
+		c, l, fmt.Sprintf("/store/%s/key", oracletypes.StoreKey), oracletypes.RequestLastExpiredStoreKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Response.Value) != 0 {
+		var lastExpired gogotypes.Int64Value
+		if err := cdc.UnmarshalLengthPrefixed(res.Response.Value, &lastExpired); err != nil {
+			return nil, err
+		}
+		state.lastExpired = oracletypes.RequestID(lastExpired.GetValue())
+	}
+
+	state.maxDataSize, err = queryMaxDataSize(c, l)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		req, err := GetRequest(c, l, id)
+		if err != nil {
+			// Missing requests are reported by oracletypes.CheckReport.
+			continue
+		}
+		state.requests[id] = req
+	}
+	return state, nil
+}
+
+// checkReports runs the same checks as the chain against the current chain state and returns the reports that can
+// still succeed. Raw reports with too large data are replaced by failed raw reports, other invalid reports are dropped.
+// If the state cannot be queried, all reports are returned as is.
+func checkReports(c *Context, l *Logger, keyIndex int64, reports []ReportMsgWithKey) []ReportMsgWithKey {
+	ids := make([]oracletypes.RequestID, len(reports))
+	for i, report := range reports {
+		ids[i] = report.msg.RequestID
+	}
+
+	state, err := queryReportState(c, l, c.keys[keyIndex].GetAddress(), ids)
+	if err != nil {
+		l.Info(":warning: Skipping report pre-validation, failed to query chain state: %s", err.Error())
+		return reports
+	}
+
+	valid := make([]ReportMsgWithKey, 0, len(reports))
+	for _, report := range reports {
+		rl := l.With("rid", report.msg.RequestID)
+		for i, rawReport := range report.msg.RawReports {
+			if uint64(len(rawReport.Data)) > state.maxDataSize {
+				rl.Info(
+					":scissors: Replacing raw report of external ID %d with %d bytes of data (max %d)",
+					rawReport.ExternalID, len(rawReport.Data), state.maxDataSize,
+				)
+				report.msg.RawReports[i] = oracletypes.NewRawReport(rawReport.ExternalID, 255, nil)
+				c.updateTrimmedCount(1)
+			}
+		}
+		if err := oracletypes.CheckReport(state, report.msg); err != nil {
+			rl.Error(":wastebasket: Dropping report that cannot succeed: %s", c, err.Error())
+			c.updateDroppedCount(dropReason(err), 1)
+			continue
+		}
+		valid = append(valid, report)
+	}
+	return valid
+}
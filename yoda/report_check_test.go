@@ -0,0 +1,17 @@
+package yoda
+
+import (
+	"errors"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
+)
+
+func TestDropReason(t *testing.T) {
+	require.Equal(t, dropReasonNotReporter, dropReason(oracletypes.ErrReporterNotAuthorized))
+	require.Equal(t, dropReasonRequestNotFound, dropReason(sdkerrors.Wrapf(oracletypes.ErrRequestNotFound, "id: %d", 42)))
+	require.Equal(t, dropReasonOther, dropReason(errors.New("decoding bech32 failed: invalid checksum")))
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -12,41 +13,48 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/log"
-	httpclient "github.com/tendermint/tendermint/rpc/client/http"
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/GeoDB-Limited/odin-core/pkg/filecache"
 
 	oracletypes "github.com/GeoDB-Limited/odin-core/x/oracle/types"
 	"github.com/GeoDB-Limited/odin-core/yoda/executor"
+	"github.com/GeoDB-Limited/odin-core/yoda/signer"
 )
 
 const (
 	TxQuery = "tm.event = 'Tx' AND request.id EXISTS"
+	// BlockQuery is subscribed to alongside TxQuery to know that the subscription still delivers events
+	BlockQuery = "tm.event = 'NewBlock'"
 	// EventChannelCapacity is a buffer size of channel between node and this program
 	EventChannelCapacity = 2000
 )
 
 func runImpl(c *Context, l *Logger) error {
-	l.Info(":rocket: Starting WebSocket subscriber")
-	err := c.client.Start()
-	if err != nil {
-		return err
-	}
-	defer c.client.Stop()
+	l.Info(":stethoscope: Checking health of RPC endpoints")
+	c.endpoints.checkHealth(l)
+	go c.endpoints.healthCheckLoop(l, c.healthInterval)
 
-	ctx, cxl := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cxl()
-
-	l.Info(":ear: Subscribing to events with query: %s...", TxQuery)
-	eventChan, err := c.client.Subscribe(ctx, "", TxQuery, EventChannelCapacity)
+	subscribed, eventChans, err := c.endpoints.subscribe(l, TxQuery, BlockQuery)
 	if err != nil {
 		return err
 	}
+	eventChan, blockChan := eventChans[0], eventChans[1]
+	// Give the new subscription the stale timeout to deliver its first block.
+	c.markAlive()
+	defer func() {
+		if subscribed != nil {
+			c.endpoints.unsubscribe(l, subscribed)
+		}
+	}()
+
+	healthTicker := time.NewTicker(c.healthInterval)
+	defer healthTicker.Stop()
 
 	if c.metricsEnabled {
 		l.Info(":eyes: Starting Prometheus listener")
 		go metricsListen(cfg.MetricsListenAddr, c)
+		go keyBalanceLoop(c, l, c.healthInterval)
 	}
 
 	availiableKeys := make([]bool, len(c.keys))
@@ -55,11 +63,12 @@ func runImpl(c *Context, l *Logger) error {
 		availiableKeys[i] = true
 		waitingMsgs[i] = []ReportMsgWithKey{}
 	}
+	atomic.StoreInt64(&c.availableKeyCount, int64(len(c.keys)))
 
 	bz := cdc.MustMarshal(&oracletypes.QueryPendingRequestsRequest{
 		ValidatorAddress: c.validator.String(),
 	})
-	resBz, err := c.client.ABCIQuery(context.Background(), "/oracle.v1.Query/PendingRequests", bz)
+	resBz, err := c.rpcClient().ABCIQuery(context.Background(), "/oracle.v1.Query/PendingRequests", bz)
 	if err != nil {
 		l.Error(":exploding_head: Failed to get pending requests with error: %s", c, err.Error())
 	}
@@ -74,8 +83,45 @@ func runImpl(c *Context, l *Logger) error {
 
 	for {
 		select {
-		case ev := <-eventChan:
-			go handleTransaction(c, l, ev.Data.(tmtypes.EventDataTx).TxResult)
+		case ev, ok := <-eventChan:
+			if !ok {
+				l.Info(":warning: Event subscription on %s was closed", subscribed.uri)
+				eventChan = nil
+				continue
+			}
+			txResult := ev.Data.(tmtypes.EventDataTx).TxResult
+			atomic.StoreInt64(&c.lastEventHeight, txResult.Height)
+			c.markAlive()
+			go handleTransaction(c, l, txResult)
+		case _, ok := <-blockChan:
+			if !ok {
+				l.Info(":warning: Block subscription on %s was closed", subscribed.uri)
+				eventChan, blockChan = nil, nil
+				continue
+			}
+			c.markAlive()
+		case <-healthTicker.C:
+			// A subscription that stopped delivering blocks is stale even if its endpoint answers status queries.
+			stale := c.checkLive() != nil
+			if eventChan != nil && !stale && c.endpoints.isHealthy(subscribed) {
+				continue
+			}
+			// Fail over to the healthiest endpoint, or retry if the subscription is gone or stale.
+			best := c.endpoints.best()
+			if eventChan != nil && !stale && (best == subscribed || !c.endpoints.isHealthy(best)) {
+				continue
+			}
+			if subscribed != nil {
+				l.Info(":twisted_rightwards_arrows: Moving event subscription away from %s", subscribed.uri)
+				c.endpoints.unsubscribe(l, subscribed)
+			}
+			subscribed, eventChans, err = c.endpoints.subscribe(l, TxQuery, BlockQuery)
+			if err != nil {
+				l.Error(":exploding_head: Failed to subscribe with error: %s", c, err.Error())
+				subscribed, eventChan, blockChan = nil, nil, nil
+			} else {
+				eventChan, blockChan = eventChans[0], eventChans[1]
+			}
 		case keyIndex := <-c.freeKeys:
 			if len(waitingMsgs[keyIndex]) != 0 {
 				if uint64(len(waitingMsgs[keyIndex])) > c.maxReport {
@@ -87,11 +133,13 @@ func runImpl(c *Context, l *Logger) error {
 				}
 			} else {
 				availiableKeys[keyIndex] = true
+				atomic.AddInt64(&c.availableKeyCount, 1)
 			}
 		case pm := <-c.pendingMsgs:
 			c.updatePendingGauge(1)
 			if availiableKeys[pm.keyIndex] {
 				availiableKeys[pm.keyIndex] = false
+				atomic.AddInt64(&c.availableKeyCount, -1)
 				go SubmitReport(c, l, pm.keyIndex, []ReportMsgWithKey{pm})
 			} else {
 				waitingMsgs[pm.keyIndex] = append(waitingMsgs[pm.keyIndex], pm)
@@ -100,6 +148,25 @@ func runImpl(c *Context, l *Logger) error {
 	}
 }
 
+// setupSigner sets the signer of the context to the remote signer if one is configured, or to the local keyring
+// otherwise. The returned function releases the signer.
+func setupSigner(c *Context) (func(), error) {
+	c.signer = kb
+	if cfg.Signer == "" {
+		return func() {}, nil
+	}
+	tlsConfig, err := signer.LoadTLSConfig(cfg.SignerCA, cfg.SignerCert, cfg.SignerKey, false)
+	if err != nil {
+		return nil, err
+	}
+	remote, err := signer.NewRemoteSigner(cfg.Signer, tlsConfig, time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	c.signer = remote
+	return func() { remote.Close() }, nil
+}
+
 func runCmd(c *Context) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "run",
@@ -110,7 +177,12 @@ func runCmd(c *Context) *cobra.Command {
 			if cfg.ChainID == "" {
 				return errors.New("Chain ID must not be empty")
 			}
-			keys, err := kb.List()
+			closeSigner, err := setupSigner(c)
+			if err != nil {
+				return err
+			}
+			defer closeSigner()
+			keys, err := c.signer.List()
 			if err != nil {
 				return err
 			}
@@ -128,6 +200,14 @@ func runCmd(c *Context) *cobra.Command {
 			}
 
 			c.gasPrices = cfg.GasPrices
+			c.gasAdjustment = cfg.GasAdjustment
+			c.maxGas = cfg.MaxGas
+			if cfg.FeeGranter != "" {
+				c.feeGranter, err = sdk.AccAddressFromBech32(cfg.FeeGranter)
+				if err != nil {
+					return err
+				}
+			}
 
 			allowLevel, err := log.AllowLevel(cfg.LogLevel)
 			if err != nil {
@@ -138,8 +218,20 @@ func runCmd(c *Context) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			l.Info(":star: Creating HTTP client with node URI: %s", cfg.NodeURI)
-			c.client, err = httpclient.New(cfg.NodeURI, "/websocket")
+			l.Info(":star: Creating HTTP clients with node URIs: %s", cfg.NodeURI)
+			c.healthInterval, err = time.ParseDuration(cfg.HealthCheckInterval)
+			if err != nil {
+				return err
+			}
+			c.staleTimeout, err = time.ParseDuration(cfg.StaleTimeout)
+			if err != nil {
+				return err
+			}
+			rpcTimeout, err := time.ParseDuration(cfg.RPCTimeout)
+			if err != nil {
+				return err
+			}
+			c.endpoints, err = newEndpointPool(parseNodeURIs(cfg.NodeURI), cfg.MaxBlockLag, rpcTimeout)
 			if err != nil {
 				return err
 			}
@@ -159,29 +251,68 @@ func runCmd(c *Context) *cobra.Command {
 			c.keyRoundRobinIndex = -1
 			c.dataSourceCache = new(sync.Map)
 			c.pendingRequests = make(map[oracletypes.RequestID]bool)
+			execCacheTTL, err := time.ParseDuration(cfg.ExecCacheTTL)
+			if err != nil {
+				return err
+			}
+			execCacheSkip, err := parseDataSourceIDs(cfg.ExecCacheSkip)
+			if err != nil {
+				return err
+			}
+			c.execCache = newExecCache(execCacheTTL, execCacheSkip)
 			c.metricsEnabled = cfg.MetricsListenAddr != ""
+			c.droppedCounts = make(map[string]int64)
+			c.txFailures = make(map[txFailure]int64)
+			c.keyBalances = make(map[string]sdk.Coins)
+			c.execLatency, c.inclusionDelay = newHistograms()
 			return runImpl(c, l)
 		},
 	}
 	cmd.Flags().String(flags.FlagChainID, "", "chain ID of OdinChain network")
-	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "RPC url to OdinChain node")
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "comma-separated RPC urls to OdinChain nodes")
+	cmd.Flags().Int64(flagMaxBlockLag, 3, "The maximum number of blocks an RPC node may lag behind the others to be healthy")
+	cmd.Flags().String(flagHealthCheckInterval, "10s", "The duration of RPC node health check interval")
+	cmd.Flags().String(flagStaleTimeout, "2m", "The duration without a working event subscription after which /healthz fails")
+	cmd.Flags().String(flagRPCTimeout, "5s", "The duration after which health checks, subscriptions and broadcasts to an RPC node time out")
 	cmd.Flags().String(flagValidator, "", "validator address")
 	cmd.Flags().String(flagExecutor, "", "executor name and url for executing the data source script")
 	cmd.Flags().String(flags.FlagGasPrices, "", "gas prices for report transaction")
+	cmd.Flags().String(flagFeeGranter, "", "account address that pays report fees through a fee grant")
+	cmd.Flags().Float64(flagGasAdjustment, 1.3, "multiplier of simulated report transaction gas, 0 to use static estimation only")
+	cmd.Flags().Uint64(flagMaxGas, 0, "The maximum gas limit of a report transaction, 0 for no limit")
 	cmd.Flags().String(flagLogLevel, "info", "set the logger level")
 	cmd.Flags().String(flagBroadcastTimeout, "5m", "The time that Yoda will wait for tx commit")
 	cmd.Flags().String(flagRPCPollInterval, "1s", "The duration of rpc poll interval")
 	cmd.Flags().Uint64(flagMaxTry, 5, "The maximum number of tries to submit a report transaction")
 	cmd.Flags().Uint64(flagMaxReport, 10, "The maximum number of reports in one transaction")
+	cmd.Flags().String(flagExecCacheTTL, "0s", "The duration data source results are reused for identical calls (0 disables)")
+	cmd.Flags().String(flagExecCacheSkip, "", "Comma-separated data source IDs that are never cached")
+	cmd.Flags().String(flagSigner, "", "address of the remote signer, empty to sign with the local keyring")
+	cmd.Flags().String(flagSignerCA, "", "CA certificate file that signed the remote signer certificate")
+	cmd.Flags().String(flagSignerCert, "", "client certificate file used to authenticate to the remote signer")
+	cmd.Flags().String(flagSignerKey, "", "client private key file used to authenticate to the remote signer")
 	viper.BindPFlag(flags.FlagChainID, cmd.Flags().Lookup(flags.FlagChainID))
 	viper.BindPFlag(flags.FlagNode, cmd.Flags().Lookup(flags.FlagNode))
+	viper.BindPFlag(flagMaxBlockLag, cmd.Flags().Lookup(flagMaxBlockLag))
+	viper.BindPFlag(flagHealthCheckInterval, cmd.Flags().Lookup(flagHealthCheckInterval))
+	viper.BindPFlag(flagStaleTimeout, cmd.Flags().Lookup(flagStaleTimeout))
+	viper.BindPFlag(flagRPCTimeout, cmd.Flags().Lookup(flagRPCTimeout))
 	viper.BindPFlag(flagValidator, cmd.Flags().Lookup(flagValidator))
 	viper.BindPFlag(flags.FlagGasPrices, cmd.Flags().Lookup(flags.FlagGasPrices))
+	viper.BindPFlag(flagFeeGranter, cmd.Flags().Lookup(flagFeeGranter))
+	viper.BindPFlag(flagGasAdjustment, cmd.Flags().Lookup(flagGasAdjustment))
+	viper.BindPFlag(flagMaxGas, cmd.Flags().Lookup(flagMaxGas))
 	viper.BindPFlag(flagLogLevel, cmd.Flags().Lookup(flagLogLevel))
 	viper.BindPFlag(flagExecutor, cmd.Flags().Lookup(flagExecutor))
 	viper.BindPFlag(flagBroadcastTimeout, cmd.Flags().Lookup(flagBroadcastTimeout))
 	viper.BindPFlag(flagRPCPollInterval, cmd.Flags().Lookup(flagRPCPollInterval))
 	viper.BindPFlag(flagMaxTry, cmd.Flags().Lookup(flagMaxTry))
 	viper.BindPFlag(flagMaxReport, cmd.Flags().Lookup(flagMaxReport))
+	viper.BindPFlag(flagExecCacheTTL, cmd.Flags().Lookup(flagExecCacheTTL))
+	viper.BindPFlag(flagExecCacheSkip, cmd.Flags().Lookup(flagExecCacheSkip))
+	viper.BindPFlag(flagSigner, cmd.Flags().Lookup(flagSigner))
+	viper.BindPFlag(flagSignerCA, cmd.Flags().Lookup(flagSignerCA))
+	viper.BindPFlag(flagSignerCert, cmd.Flags().Lookup(flagSignerCert))
+	viper.BindPFlag(flagSignerKey, cmd.Flags().Lookup(flagSignerKey))
 	return cmd
 }
@@ -0,0 +1,106 @@
+package signer
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	ErrInvalidPubKey    = errors.New("signer returned invalid public key")
+	ErrPubKeyMismatch   = errors.New("signer returned public key of another key")
+	ErrInvalidSignature = errors.New("signer returned invalid signature")
+)
+
+// RemoteSigner signs with keys held by a signer server. The public keys of the remote keys are kept in an
+// in-memory keyring so that they can be listed as offline keys, and every returned signature is verified.
+type RemoteSigner struct {
+	conn    *grpc.ClientConn
+	client  SignerClient
+	timeout time.Duration
+	keys    keyring.Keyring // Replaced on every List, guarded by mtx.
+	mtx     sync.RWMutex
+}
+
+var _ Signer = (*RemoteSigner)(nil)
+
+// NewRemoteSigner connects to the signer server at addr using the given mutual TLS configuration.
+// Each call to the server fails after timeout.
+func NewRemoteSigner(addr string, tlsConfig *tls.Config, timeout time.Duration) (*RemoteSigner, error) {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if err != nil {
+		return nil, err
+	}
+	return &RemoteSigner{
+		conn:    conn,
+		client:  NewSignerClient(conn),
+		timeout: timeout,
+		keys:    keyring.NewInMemory(),
+	}, nil
+}
+
+// List fetches the keys of the signer server, replacing the keys known from previous calls.
+func (s *RemoteSigner) List() ([]keyring.Info, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	res, err := s.client.Keys(ctx, &KeysRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := keyring.NewInMemory()
+	for _, key := range res.Keys {
+		if len(key.PubKey) != secp256k1.PubKeySize {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidPubKey, key.Name)
+		}
+		if _, err := keys.SavePubKey(key.Name, &secp256k1.PubKey{Key: key.PubKey}, hd.Secp256k1Type); err != nil {
+			return nil, err
+		}
+	}
+
+	s.mtx.Lock()
+	s.keys = keys
+	s.mtx.Unlock()
+	return keys.List()
+}
+
+// Sign asks the signer server to sign msg with the named key. The key must have been listed before.
+func (s *RemoteSigner) Sign(name string, msg []byte) ([]byte, cryptotypes.PubKey, error) {
+	s.mtx.RLock()
+	info, err := s.keys.Key(name)
+	s.mtx.RUnlock()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	res, err := s.client.Sign(ctx, &SignRequest{Name: name, Msg: msg})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pubKey := &secp256k1.PubKey{Key: res.PubKey}
+	if !pubKey.Equals(info.GetPubKey()) {
+		return nil, nil, ErrPubKeyMismatch
+	}
+	if !pubKey.VerifySignature(msg, res.Signature) {
+		return nil, nil, ErrInvalidSignature
+	}
+	return res.Signature, pubKey, nil
+}
+
+// Close closes the connection to the signer server.
+func (s *RemoteSigner) Close() error {
+	return s.conn.Close()
+}
@@ -0,0 +1,55 @@
+package signer
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server implements the signer service on top of a keyring. Only secp256k1 keys are exposed.
+type Server struct {
+	kb keyring.Keyring
+}
+
+var _ SignerServer = (*Server)(nil)
+
+// NewServer creates a new Server instance signing with keys of the given keyring.
+func NewServer(kb keyring.Keyring) *Server {
+	return &Server{kb: kb}
+}
+
+// Keys implements SignerServer.
+func (s *Server) Keys(ctx context.Context, req *KeysRequest) (*KeysResponse, error) {
+	infos, err := s.kb.List()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	keys := make([]*Key, 0, len(infos))
+	for _, info := range infos {
+		pubKey, ok := info.GetPubKey().(*secp256k1.PubKey)
+		if !ok {
+			continue
+		}
+		keys = append(keys, &Key{Name: info.GetName(), PubKey: pubKey.Bytes()})
+	}
+	return &KeysResponse{Keys: keys}, nil
+}
+
+// Sign implements SignerServer.
+func (s *Server) Sign(ctx context.Context, req *SignRequest) (*SignResponse, error) {
+	info, err := s.kb.Key(req.Name)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if _, ok := info.GetPubKey().(*secp256k1.PubKey); !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "key %s is not a secp256k1 key", req.Name)
+	}
+	sig, pubKey, err := s.kb.Sign(req.Name, req.Msg)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &SignResponse{Signature: sig, PubKey: pubKey.Bytes()}, nil
+}
@@ -0,0 +1,17 @@
+package signer
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// Signer signs report transactions and request verifications on behalf of reporter keys. The local
+// keyring.Keyring implements it directly, RemoteSigner forwards signing to a signer server over gRPC.
+type Signer interface {
+	// List returns all keys available for signing.
+	List() ([]keyring.Info, error)
+	// Sign signs msg with the named key and returns the signature along with the key's public key.
+	Sign(name string, msg []byte) ([]byte, cryptotypes.PubKey, error)
+}
+
+var _ Signer = keyring.Keyring(nil)
@@ -0,0 +1,148 @@
+package signer_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/GeoDB-Limited/odin-core/yoda/signer"
+)
+
+type certAuthority struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func writePEM(t *testing.T, path, blockType string, der []byte) {
+	require.NoError(t, ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600))
+}
+
+func newCA(t *testing.T, dir string) certAuthority {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "signer-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	writePEM(t, filepath.Join(dir, "ca.pem"), "CERTIFICATE", der)
+	return certAuthority{cert: cert, key: key}
+}
+
+func (ca certAuthority) issue(t *testing.T, dir, name string, serial int64) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	writePEM(t, filepath.Join(dir, name+".pem"), "CERTIFICATE", der)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	writePEM(t, filepath.Join(dir, name+"-key.pem"), "EC PRIVATE KEY", keyDer)
+}
+
+func loadTLSConfig(t *testing.T, dir, name string, server bool) *tls.Config {
+	config, err := signer.LoadTLSConfig(
+		filepath.Join(dir, "ca.pem"), filepath.Join(dir, name+".pem"), filepath.Join(dir, name+"-key.pem"), server,
+	)
+	require.NoError(t, err)
+	return config
+}
+
+func startServer(t *testing.T, kb keyring.Keyring, config *tls.Config) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer(grpc.Creds(credentials.NewTLS(config)))
+	signer.RegisterSignerServer(srv, signer.NewServer(kb))
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String()
+}
+
+func TestRemoteSigner(t *testing.T) {
+	dir := t.TempDir()
+	ca := newCA(t, dir)
+	ca.issue(t, dir, "server", 2)
+	ca.issue(t, dir, "client", 3)
+
+	kb := keyring.NewInMemory()
+	local, _, err := kb.NewMnemonic("reporter", keyring.English, "", "", hd.Secp256k1)
+	require.NoError(t, err)
+	addr := startServer(t, kb, loadTLSConfig(t, dir, "server", true))
+
+	remote, err := signer.NewRemoteSigner(addr, loadTLSConfig(t, dir, "client", false), 5*time.Second)
+	require.NoError(t, err)
+	defer remote.Close()
+
+	keys, err := remote.List()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	require.Equal(t, "reporter", keys[0].GetName())
+	require.Equal(t, local.GetAddress(), keys[0].GetAddress())
+
+	msg := []byte("report")
+	sig, pubKey, err := remote.Sign("reporter", msg)
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(local.GetPubKey()))
+	require.True(t, local.GetPubKey().VerifySignature(msg, sig))
+
+	_, _, err = remote.Sign("unknown", msg)
+	require.Error(t, err)
+
+	// Keys the server no longer holds are dropped on the next refresh.
+	require.NoError(t, kb.Delete("reporter"))
+	keys, err = remote.List()
+	require.NoError(t, err)
+	require.Empty(t, keys)
+	_, _, err = remote.Sign("reporter", msg)
+	require.Error(t, err)
+}
+
+func TestRemoteSignerRequiresClientCert(t *testing.T) {
+	dir := t.TempDir()
+	ca := newCA(t, dir)
+	ca.issue(t, dir, "server", 2)
+	addr := startServer(t, keyring.NewInMemory(), loadTLSConfig(t, dir, "server", true))
+
+	// The client trusts the server but does not present a certificate.
+	config := loadTLSConfig(t, dir, "server", false)
+	config.Certificates = nil
+	remote, err := signer.NewRemoteSigner(addr, config, 5*time.Second)
+	require.NoError(t, err)
+	defer remote.Close()
+
+	_, err = remote.List()
+	require.Error(t, err)
+}